@@ -0,0 +1,268 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aof replays redis append only files into an in-memory keyspace
+// and emits the keys through the same decode hooks as a rdb file, so
+// statistics of aof backups can be counted like rdb files.
+package aof
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/dongmx/rdb"
+	"github.com/dongmx/rdb/nopdecoder"
+)
+
+// Replayer replays aof commands and rdb files into an in-memory keyspace
+type Replayer struct {
+	ctx    context.Context
+	dbs    map[int]map[string]*value
+	db     int
+	rdbVer int
+	aux    [][2][]byte
+
+	// now in milliseconds is the time of the latest timestamp annotation,
+	// used for relative expire commands
+	now int64
+
+	skipped   map[string]uint64
+	truncated bool
+
+	// key being loaded from a rdb file
+	loading *value
+
+	nopdecoder.NopDecoder
+}
+
+// NewReplayer new an aof replayer with an empty keyspace
+func NewReplayer() *Replayer {
+	return NewReplayerContext(context.Background())
+}
+
+// NewReplayerContext is NewReplayer stopping loading when ctx is done, ctx
+// is checked between commands and its error is returned by the loads
+func NewReplayerContext(ctx context.Context) *Replayer {
+	return &Replayer{
+		ctx:     ctx,
+		dbs:     map[int]map[string]*value{},
+		skipped: map[string]uint64{},
+	}
+}
+
+// Skipped get number of commands skipped by name, they are either not
+// supported or not recognized
+func (r *Replayer) Skipped() map[string]uint64 {
+	return r.skipped
+}
+
+// Truncated reports whether the last command of an aof file was truncated
+func (r *Replayer) Truncated() bool {
+	return r.truncated
+}
+
+// Load replays an aof file, which may start with a rdb preamble
+func (r *Replayer) Load(in io.Reader) error {
+	br := bufio.NewReader(in)
+	magic, err := br.Peek(5)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if bytes.Equal(magic, []byte("REDIS")) {
+		if err := r.loadRDB(br); err != nil {
+			return err
+		}
+	}
+
+	for {
+		if err := r.ctx.Err(); err != nil {
+			return err
+		}
+		args, err := readCommand(br)
+		if err == io.EOF {
+			return nil
+		}
+		if err == io.ErrUnexpectedEOF {
+			// same as aof-load-truncated yes
+			r.truncated = true
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		r.apply(args)
+	}
+}
+
+// LoadRDB loads a rdb file into the keyspace, e.g. the base file of a
+// multi part aof
+func (r *Replayer) LoadRDB(in io.Reader) error {
+	return r.loadRDB(bufio.NewReader(in))
+}
+
+func (r *Replayer) loadRDB(br *bufio.Reader) error {
+	// the checksum following the EOF opcode is read and verified by rdb.Decode
+	return rdb.DecodeContext(r.ctx, br, r)
+}
+
+// Emit calls decode hooks of d for every key in the keyspace, as if the
+// keyspace was saved to a rdb file. EndRDB of d is called at last.
+func (r *Replayer) Emit(d rdb.Decoder) {
+	ver := r.rdbVer
+	if ver == 0 {
+		// the version of redis 7.0, whose listpack and quicklist encodings
+		// are the ones emitted
+		ver = 11
+	}
+	d.StartRDB(ver)
+	for _, kv := range r.aux {
+		d.Aux(kv[0], kv[1])
+	}
+
+	dbs := []int{}
+	for db := range r.dbs {
+		dbs = append(dbs, db)
+	}
+	sort.Ints(dbs)
+	for _, db := range dbs {
+		keys := r.dbs[db]
+		if len(keys) == 0 {
+			continue
+		}
+		d.StartDatabase(db)
+		expires := 0
+		for _, v := range keys {
+			if v.expiry > 0 {
+				expires++
+			}
+		}
+		d.ResizeDatabase(uint32(len(keys)), uint32(expires))
+		for key, v := range keys {
+			v.emit([]byte(key), d)
+		}
+		d.EndDatabase(db)
+	}
+	d.EndRDB()
+}
+
+func (r *Replayer) keys() map[string]*value {
+	keys, ok := r.dbs[r.db]
+	if !ok {
+		keys = map[string]*value{}
+		r.dbs[r.db] = keys
+	}
+	return keys
+}
+
+// nowMs get current time of the replay in milliseconds
+func (r *Replayer) nowMs() int64 {
+	if r.now > 0 {
+		return r.now
+	}
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// decode hooks to load a rdb file
+
+func (r *Replayer) StartRDB(ver int) {
+	r.rdbVer = ver
+}
+
+func (r *Replayer) Aux(key, value []byte) {
+	r.aux = append(r.aux, [2][]byte{key, value})
+	if string(key) == "ctime" {
+		if n, err := strconv.ParseInt(string(value), 10, 64); err == nil {
+			r.now = n * 1000
+		}
+	}
+}
+
+func (r *Replayer) StartDatabase(n int) {
+	r.db = n
+}
+
+func (r *Replayer) Set(key, val []byte, expiry int64, info *rdb.Info) {
+	r.keys()[string(key)] = &value{kind: kindString, str: val, expiry: expiry}
+}
+
+func (r *Replayer) StartHash(key []byte, length, expiry int64, info *rdb.Info) {
+	r.loading = &value{kind: kindHash, hash: map[string][]byte{}, expiry: expiry}
+	r.keys()[string(key)] = r.loading
+}
+
+func (r *Replayer) Hset(key, field, val []byte) {
+	r.loading.hash[string(field)] = val
+}
+
+func (r *Replayer) StartSet(key []byte, cardinality, expiry int64, info *rdb.Info) {
+	r.loading = &value{kind: kindSet, set: map[string]struct{}{}, expiry: expiry}
+	r.keys()[string(key)] = r.loading
+}
+
+func (r *Replayer) Sadd(key, member []byte) {
+	r.loading.set[string(member)] = struct{}{}
+}
+
+func (r *Replayer) StartList(key []byte, length, expiry int64, info *rdb.Info) {
+	r.loading = &value{kind: kindList, expiry: expiry}
+	r.keys()[string(key)] = r.loading
+}
+
+func (r *Replayer) Rpush(key, val []byte) {
+	r.loading.list = append(r.loading.list, val)
+}
+
+func (r *Replayer) StartZSet(key []byte, cardinality, expiry int64, info *rdb.Info) {
+	r.loading = &value{kind: kindZSet, zset: map[string]float64{}, expiry: expiry}
+	r.keys()[string(key)] = r.loading
+}
+
+func (r *Replayer) Zadd(key []byte, score float64, member []byte) {
+	r.loading.zset[string(member)] = score
+}
+
+func (r *Replayer) StartStream(key []byte, cardinality, expiry int64, info *rdb.Info) {
+	r.loading = &value{
+		kind:   kindStream,
+		stream: &stream{cardinality: cardinality, info: info},
+		expiry: expiry,
+	}
+	r.keys()[string(key)] = r.loading
+}
+
+func (r *Replayer) Xadd(key, id, listpack []byte) {
+	s := r.loading.stream
+	s.ids = append(s.ids, id)
+	s.listpacks = append(s.listpacks, listpack)
+}
+
+func (r *Replayer) EndStream(key []byte, items uint64, lastEntryID string, cgroupsData rdb.StreamGroups) {
+	s := r.loading.stream
+	s.items = items
+	s.lastEntryID = lastEntryID
+	s.cgroups = cgroupsData
+}
+
+func (r *Replayer) Module(key []byte, name string, size uint64, expiry int64, info *rdb.Info) {
+	r.keys()[string(key)] = &value{
+		kind:   kindModule,
+		module: &module{name: name, size: size, info: info},
+		expiry: expiry,
+	}
+}
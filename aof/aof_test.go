@@ -0,0 +1,172 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+// resp encodes commands in RESP multi bulk format
+func resp(cmds ...string) []byte {
+	buf := &bytes.Buffer{}
+	for _, cmd := range cmds {
+		args := strings.Split(cmd, " ")
+		fmt.Fprintf(buf, "*%d\r\n", len(args))
+		for _, arg := range args {
+			fmt.Fprintf(buf, "$%d\r\n%s\r\n", len(arg), arg)
+		}
+	}
+	return buf.Bytes()
+}
+
+func emitEntries(r *Replayer) map[string]*decoder.Entry {
+	d := decoder.NewDecoder()
+	r.Emit(d)
+	entries := map[string]*decoder.Entry{}
+	for e := range d.Entries {
+		entries[fmt.Sprintf("%d:%s", e.DB, e.Key)] = e
+	}
+	return entries
+}
+
+func TestReplay(t *testing.T) {
+	aof := resp(
+		"SELECT 0",
+		"SET s1 hello",
+		"SET s2 v PXAT 4102444800000",
+		"INCR counter",
+		"INCRBY counter 10",
+		"APPEND s1 world",
+		"HSET h f1 v1 f2 v2",
+		"HDEL h f1",
+		"SADD set 1 2 3",
+		"SREM set 1",
+		"ZADD z 1 a 2 b 3 c",
+		"ZREMRANGEBYSCORE z (1 2",
+		"RPUSH l a b c d",
+		"LPOP l",
+		"LREM l -1 c",
+		"SET gone v",
+		"DEL gone",
+		"SADD empty x",
+		"SREM empty x",
+		"SELECT 3",
+		"SET s3 v",
+		"PEXPIREAT s3 4102444800000",
+		"XADD stream * f v",
+	)
+	aof = append(aof, []byte("*2\r\n$3\r\nSET\r\n")...) // truncated
+
+	r := NewReplayer()
+	assert.NoError(t, r.Load(bytes.NewReader(aof)))
+	assert.True(t, r.Truncated())
+	assert.Equal(t, map[string]uint64{"XADD": 1}, r.Skipped())
+
+	entries := emitEntries(r)
+	assert.Len(t, entries, 8)
+
+	assert.Equal(t, "string", entries["0:s1"].Type)
+	assert.Equal(t, uint64(len("helloworld")), entries["0:s1"].NumOfElem)
+	assert.Equal(t, int64(4102444800000), entries["0:s2"].Expiry)
+	assert.Equal(t, "string", entries["0:counter"].Type)
+	assert.Equal(t, uint64(1), entries["0:h"].NumOfElem)
+	assert.Equal(t, uint64(2), entries["0:set"].NumOfElem)
+	assert.Equal(t, uint64(2), entries["0:z"].NumOfElem)
+	assert.Equal(t, "list", entries["0:l"].Type)
+	assert.Equal(t, uint64(2), entries["0:l"].NumOfElem)
+	assert.Equal(t, int64(4102444800000), entries["3:s3"].Expiry)
+	assert.Nil(t, entries["0:gone"])
+	assert.Nil(t, entries["0:empty"])
+}
+
+func TestReplayRDBPreamble(t *testing.T) {
+	file := []byte("REDIS0009")
+	file = append(file, 0xFE, 0x00)
+	file = append(file, 0x00, 1, 'a', 1, '1')
+	file = append(file, 0xFF)
+	file = append(file, make([]byte, 8)...)
+	file = append(file, resp("SELECT 0", "SET b 2", "DEL a")...)
+
+	r := NewReplayer()
+	assert.NoError(t, r.Load(bytes.NewReader(file)))
+
+	entries := emitEntries(r)
+	assert.Len(t, entries, 1)
+	assert.NotNil(t, entries["0:b"])
+}
+
+// versionDecoder records the rdb version Emit starts with
+type versionDecoder struct {
+	*decoder.Decoder
+	ver int
+}
+
+func (d *versionDecoder) StartRDB(ver int) {
+	d.ver = ver
+	d.Decoder.StartRDB(ver)
+}
+
+func TestReplayBounds(t *testing.T) {
+	r := NewReplayer()
+	err := r.Load(bytes.NewReader([]byte("*2\r\n$3\r\nSET\r\n$4294967296\r\n")))
+	assert.EqualError(t, err, "aof: bulk length 4294967296 exceeds 536870912")
+
+	r = NewReplayer()
+	assert.NoError(t, r.Load(bytes.NewReader(resp("SET a 1"))))
+	d := &versionDecoder{Decoder: decoder.NewDecoder()}
+	r.Emit(d)
+	assert.Equal(t, 11, d.ver)
+}
+
+func TestReplayCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := NewReplayerContext(ctx)
+	assert.Equal(t, context.Canceled, r.Load(bytes.NewReader(resp("SET a 1"))))
+	assert.Len(t, emitEntries(r), 0)
+}
+
+func TestReadManifest(t *testing.T) {
+	manifest := "file appendonly.aof.2.incr.aof seq 2 type i\n" +
+		"file appendonly.aof.1.base.rdb seq 1 type b\n" +
+		"file appendonly.aof.0.base.rdb seq 0 type h\n" +
+		"file \"append only.aof.1.incr.aof\" seq 1 type i\n"
+
+	files, err := ReadManifest(strings.NewReader(manifest))
+	assert.NoError(t, err)
+	names := []string{}
+	for _, f := range files {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{
+		"appendonly.aof.1.base.rdb",
+		"append only.aof.1.incr.aof",
+		"appendonly.aof.2.incr.aof",
+	}, names)
+}
+
+func TestListRem(t *testing.T) {
+	list := [][]byte{[]byte("a"), []byte("b"), []byte("a"), []byte("a")}
+	assert.Len(t, listRem(list, 0, []byte("a")), 1)
+	assert.Equal(t, [][]byte{[]byte("b"), []byte("a"), []byte("a")}, listRem(list, 1, []byte("a")))
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b")}, listRem(list, -2, []byte("a")))
+}
@@ -0,0 +1,764 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aof
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// apply replays a command on the keyspace. Commands that are not supported
+// or have bad arguments are counted as skipped.
+func (r *Replayer) apply(args [][]byte) {
+	name := strings.ToUpper(string(args[0]))
+	if !r.exec(name, args[1:]) {
+		r.skipped[name]++
+	}
+}
+
+// exec a command, returns false if it is not supported or has bad arguments
+func (r *Replayer) exec(name string, args [][]byte) bool {
+	switch name {
+	case "#":
+		// timestamp annotation of redis 7, "TS:<unix seconds>"
+		if ts := string(args[0]); strings.HasPrefix(ts, "TS:") {
+			if n, err := strconv.ParseInt(ts[3:], 10, 64); err == nil {
+				r.now = n * 1000
+			}
+		}
+		return true
+	case "MULTI", "EXEC", "PING":
+		return true
+	case "SELECT":
+		db, ok := argInt(args, 0)
+		if !ok {
+			return false
+		}
+		r.db = int(db)
+		return true
+	case "FLUSHDB":
+		delete(r.dbs, r.db)
+		return true
+	case "FLUSHALL":
+		r.dbs = map[int]map[string]*value{}
+		return true
+	case "SWAPDB":
+		a, ok1 := argInt(args, 0)
+		b, ok2 := argInt(args, 1)
+		if !ok1 || !ok2 {
+			return false
+		}
+		r.dbs[int(a)], r.dbs[int(b)] = r.dbs[int(b)], r.dbs[int(a)]
+		return true
+	}
+
+	if len(args) == 0 {
+		return false
+	}
+	keys := r.keys()
+	key := string(args[0])
+
+	switch name {
+	// keyspace
+	case "DEL", "UNLINK":
+		for _, k := range args {
+			delete(keys, string(k))
+		}
+	case "RENAME", "RENAMENX":
+		if len(args) != 2 {
+			return false
+		}
+		v, ok := keys[key]
+		if !ok {
+			return true
+		}
+		if _, exists := keys[string(args[1])]; exists && name == "RENAMENX" {
+			return true
+		}
+		delete(keys, key)
+		keys[string(args[1])] = v
+	case "MOVE":
+		db, ok := argInt(args, 1)
+		if !ok {
+			return false
+		}
+		v, exists := keys[key]
+		if !exists {
+			return true
+		}
+		dst, ok := r.dbs[int(db)]
+		if !ok {
+			dst = map[string]*value{}
+			r.dbs[int(db)] = dst
+		}
+		if _, exists := dst[key]; !exists {
+			delete(keys, key)
+			dst[key] = v
+		}
+	case "EXPIRE", "PEXPIRE", "EXPIREAT", "PEXPIREAT":
+		t, ok := argInt(args, 1)
+		if !ok {
+			return false
+		}
+		switch name {
+		case "EXPIRE":
+			t = r.nowMs() + t*1000
+		case "PEXPIRE":
+			t = r.nowMs() + t
+		case "EXPIREAT":
+			t *= 1000
+		}
+		if v, ok := keys[key]; ok {
+			v.expiry = t
+		}
+	case "PERSIST":
+		if v, ok := keys[key]; ok {
+			v.expiry = 0
+		}
+
+	// strings
+	case "SET":
+		if len(args) < 2 {
+			return false
+		}
+		old := keys[key]
+		v := &value{kind: kindString, str: args[1]}
+		for i := 2; i < len(args); i++ {
+			switch strings.ToUpper(string(args[i])) {
+			case "NX":
+				if old != nil {
+					return true
+				}
+			case "XX":
+				if old == nil {
+					return true
+				}
+			case "KEEPTTL":
+				if old != nil {
+					v.expiry = old.expiry
+				}
+			case "EX", "PX", "EXAT", "PXAT":
+				t, ok := argInt(args, i+1)
+				if !ok {
+					return false
+				}
+				switch strings.ToUpper(string(args[i])) {
+				case "EX":
+					t = r.nowMs() + t*1000
+				case "PX":
+					t = r.nowMs() + t
+				case "EXAT":
+					t *= 1000
+				}
+				v.expiry = t
+				i++
+			}
+		}
+		keys[key] = v
+	case "SETNX", "MSETNX":
+		for i := 0; i+1 < len(args); i += 2 {
+			if _, ok := keys[string(args[i])]; ok {
+				return true
+			}
+		}
+		fallthrough
+	case "MSET":
+		if len(args)%2 != 0 {
+			return false
+		}
+		for i := 0; i < len(args); i += 2 {
+			keys[string(args[i])] = &value{kind: kindString, str: args[i+1]}
+		}
+	case "SETEX", "PSETEX":
+		t, ok := argInt(args, 1)
+		if !ok || len(args) != 3 {
+			return false
+		}
+		if name == "SETEX" {
+			t *= 1000
+		}
+		keys[key] = &value{kind: kindString, str: args[2], expiry: r.nowMs() + t}
+	case "GETSET":
+		if len(args) != 2 {
+			return false
+		}
+		keys[key] = &value{kind: kindString, str: args[1]}
+	case "GETDEL":
+		delete(keys, key)
+	case "APPEND":
+		if len(args) != 2 {
+			return false
+		}
+		v := r.lookup(key, kindString)
+		if v == nil {
+			return false
+		}
+		v.str = append(append([]byte{}, v.str...), args[1]...)
+	case "SETRANGE":
+		offset, ok := argInt(args, 1)
+		if !ok || len(args) != 3 || offset < 0 {
+			return false
+		}
+		v := r.lookup(key, kindString)
+		if v == nil {
+			return false
+		}
+		end := int(offset) + len(args[2])
+		str := make([]byte, end)
+		if len(v.str) > end {
+			str = make([]byte, len(v.str))
+		}
+		copy(str, v.str)
+		copy(str[offset:], args[2])
+		v.str = str
+	case "INCR", "DECR", "INCRBY", "DECRBY", "INCRBYFLOAT":
+		v := r.lookup(key, kindString)
+		if v == nil {
+			return false
+		}
+		if name == "INCRBYFLOAT" {
+			delta, err := strconv.ParseFloat(string(argAt(args, 1)), 64)
+			n, err2 := strconv.ParseFloat(string(v.str), 64)
+			if err != nil || (err2 != nil && len(v.str) > 0) {
+				return false
+			}
+			v.str = []byte(strconv.FormatFloat(n+delta, 'f', -1, 64))
+			return true
+		}
+		delta := int64(1)
+		if name == "INCRBY" || name == "DECRBY" {
+			var ok bool
+			if delta, ok = argInt(args, 1); !ok {
+				return false
+			}
+		}
+		if name == "DECR" || name == "DECRBY" {
+			delta = -delta
+		}
+		n, err := strconv.ParseInt(string(v.str), 10, 64)
+		if err != nil && len(v.str) > 0 {
+			return false
+		}
+		v.str = []byte(strconv.FormatInt(n+delta, 10))
+
+	// hashes
+	case "HSET", "HMSET":
+		if len(args) < 3 || len(args)%2 != 1 {
+			return false
+		}
+		v := r.lookup(key, kindHash)
+		if v == nil {
+			return false
+		}
+		for i := 1; i < len(args); i += 2 {
+			v.hash[string(args[i])] = args[i+1]
+		}
+	case "HSETNX":
+		if len(args) != 3 {
+			return false
+		}
+		v := r.lookup(key, kindHash)
+		if v == nil {
+			return false
+		}
+		if _, ok := v.hash[string(args[1])]; !ok {
+			v.hash[string(args[1])] = args[2]
+		}
+	case "HDEL":
+		if v, ok := keys[key]; ok && v.kind == kindHash {
+			for _, field := range args[1:] {
+				delete(v.hash, string(field))
+			}
+		}
+	case "HINCRBY", "HINCRBYFLOAT":
+		if len(args) != 3 {
+			return false
+		}
+		v := r.lookup(key, kindHash)
+		if v == nil {
+			return false
+		}
+		field := string(args[1])
+		if name == "HINCRBYFLOAT" {
+			delta, err := strconv.ParseFloat(string(args[2]), 64)
+			n, _ := strconv.ParseFloat(string(v.hash[field]), 64)
+			if err != nil {
+				return false
+			}
+			v.hash[field] = []byte(strconv.FormatFloat(n+delta, 'f', -1, 64))
+			return true
+		}
+		delta, ok := argInt(args, 2)
+		n, _ := strconv.ParseInt(string(v.hash[field]), 10, 64)
+		if !ok {
+			return false
+		}
+		v.hash[field] = []byte(strconv.FormatInt(n+delta, 10))
+
+	// sets
+	case "SADD":
+		v := r.lookup(key, kindSet)
+		if v == nil {
+			return false
+		}
+		for _, member := range args[1:] {
+			v.set[string(member)] = struct{}{}
+		}
+	case "SREM":
+		if v, ok := keys[key]; ok && v.kind == kindSet {
+			for _, member := range args[1:] {
+				delete(v.set, string(member))
+			}
+		}
+	case "SMOVE":
+		if len(args) != 3 {
+			return false
+		}
+		src, ok := keys[key]
+		if !ok || src.kind != kindSet {
+			return true
+		}
+		if _, ok := src.set[string(args[2])]; !ok {
+			return true
+		}
+		dst := r.lookup(string(args[1]), kindSet)
+		if dst == nil {
+			return false
+		}
+		delete(src.set, string(args[2]))
+		dst.set[string(args[2])] = struct{}{}
+	case "SUNIONSTORE", "SINTERSTORE", "SDIFFSTORE":
+		set := map[string]struct{}{}
+		for i, k := range args[1:] {
+			src := map[string]struct{}{}
+			if v, ok := keys[string(k)]; ok && v.kind == kindSet {
+				src = v.set
+			}
+			switch {
+			case i == 0 || name == "SUNIONSTORE":
+				for member := range src {
+					set[member] = struct{}{}
+				}
+			case name == "SINTERSTORE":
+				for member := range set {
+					if _, ok := src[member]; !ok {
+						delete(set, member)
+					}
+				}
+			default:
+				for member := range src {
+					delete(set, member)
+				}
+			}
+		}
+		keys[key] = &value{kind: kindSet, set: set}
+
+	// sorted sets
+	case "ZADD":
+		return r.zadd(key, args[1:])
+	case "ZINCRBY":
+		delta, err := strconv.ParseFloat(string(argAt(args, 1)), 64)
+		if err != nil || len(args) != 3 {
+			return false
+		}
+		v := r.lookup(key, kindZSet)
+		if v == nil {
+			return false
+		}
+		v.zset[string(args[2])] += delta
+	case "ZREM":
+		if v, ok := keys[key]; ok && v.kind == kindZSet {
+			for _, member := range args[1:] {
+				delete(v.zset, string(member))
+			}
+		}
+	case "ZREMRANGEBYRANK", "ZPOPMIN", "ZPOPMAX":
+		v, ok := keys[key]
+		if !ok || v.kind != kindZSet {
+			return true
+		}
+		members := sortedZSet(v.zset)
+		start, stop := int64(0), int64(0)
+		switch name {
+		case "ZREMRANGEBYRANK":
+			var ok1, ok2 bool
+			start, ok1 = argInt(args, 1)
+			stop, ok2 = argInt(args, 2)
+			if !ok1 || !ok2 {
+				return false
+			}
+		case "ZPOPMIN", "ZPOPMAX":
+			count := int64(1)
+			if len(args) > 1 {
+				if count, ok = argInt(args, 1); !ok {
+					return false
+				}
+			}
+			start, stop = 0, count-1
+			if name == "ZPOPMAX" {
+				start, stop = -count, -1
+			}
+		}
+		from, to := rangeIndex(start, stop, len(members))
+		for _, member := range members[from:to] {
+			delete(v.zset, member)
+		}
+	case "ZREMRANGEBYSCORE":
+		v, ok := keys[key]
+		if !ok || v.kind != kindZSet {
+			return true
+		}
+		min, minEx, ok1 := parseScoreBound(argAt(args, 1))
+		max, maxEx, ok2 := parseScoreBound(argAt(args, 2))
+		if !ok1 || !ok2 {
+			return false
+		}
+		for member, score := range v.zset {
+			if (score > min || !minEx && score == min) && (score < max || !maxEx && score == max) {
+				delete(v.zset, member)
+			}
+		}
+	case "ZREMRANGEBYLEX":
+		v, ok := keys[key]
+		if !ok || v.kind != kindZSet {
+			return true
+		}
+		min, max := argAt(args, 1), argAt(args, 2)
+		if len(min) == 0 || len(max) == 0 {
+			return false
+		}
+		for member := range v.zset {
+			if inLexRange([]byte(member), min, max) {
+				delete(v.zset, member)
+			}
+		}
+
+	// lists
+	case "RPUSH", "LPUSH", "RPUSHX", "LPUSHX":
+		if _, ok := keys[key]; !ok && strings.HasSuffix(name, "X") {
+			return true
+		}
+		v := r.lookup(key, kindList)
+		if v == nil {
+			return false
+		}
+		for _, elem := range args[1:] {
+			if name[0] == 'R' {
+				v.list = append(v.list, elem)
+			} else {
+				v.list = append([][]byte{elem}, v.list...)
+			}
+		}
+	case "RPOP", "LPOP":
+		v, ok := keys[key]
+		if !ok || v.kind != kindList {
+			return true
+		}
+		count := int64(1)
+		if len(args) > 1 {
+			if count, ok = argInt(args, 1); !ok {
+				return false
+			}
+		}
+		if count > int64(len(v.list)) {
+			count = int64(len(v.list))
+		}
+		if name == "RPOP" {
+			v.list = v.list[:int64(len(v.list))-count]
+		} else {
+			v.list = v.list[count:]
+		}
+	case "LSET":
+		index, ok := argInt(args, 1)
+		v, exists := keys[key]
+		if !ok || len(args) != 3 || !exists || v.kind != kindList {
+			return false
+		}
+		if index < 0 {
+			index += int64(len(v.list))
+		}
+		if index < 0 || index >= int64(len(v.list)) {
+			return false
+		}
+		v.list[index] = args[2]
+	case "LTRIM":
+		start, ok1 := argInt(args, 1)
+		stop, ok2 := argInt(args, 2)
+		if !ok1 || !ok2 {
+			return false
+		}
+		if v, ok := keys[key]; ok && v.kind == kindList {
+			from, to := rangeIndex(start, stop, len(v.list))
+			v.list = v.list[from:to]
+		}
+	case "LREM":
+		count, ok := argInt(args, 1)
+		if !ok || len(args) != 3 {
+			return false
+		}
+		v, exists := keys[key]
+		if !exists || v.kind != kindList {
+			return true
+		}
+		v.list = listRem(v.list, count, args[2])
+	case "LINSERT":
+		if len(args) != 4 {
+			return false
+		}
+		v, exists := keys[key]
+		if !exists || v.kind != kindList {
+			return true
+		}
+		after := strings.ToUpper(string(args[1])) == "AFTER"
+		for i, elem := range v.list {
+			if bytes.Equal(elem, args[2]) {
+				if after {
+					i++
+				}
+				v.list = append(v.list[:i], append([][]byte{args[3]}, v.list[i:]...)...)
+				break
+			}
+		}
+	case "RPOPLPUSH", "LMOVE":
+		if len(args) < 2 {
+			return false
+		}
+		from, to := "RIGHT", "LEFT"
+		if name == "LMOVE" {
+			if len(args) != 4 {
+				return false
+			}
+			from, to = strings.ToUpper(string(args[2])), strings.ToUpper(string(args[3]))
+		}
+		src, ok := keys[key]
+		if !ok || src.kind != kindList || len(src.list) == 0 {
+			return true
+		}
+		var elem []byte
+		if from == "LEFT" {
+			elem, src.list = src.list[0], src.list[1:]
+		} else {
+			elem, src.list = src.list[len(src.list)-1], src.list[:len(src.list)-1]
+		}
+		dst := r.lookup(string(args[1]), kindList)
+		if dst == nil {
+			return false
+		}
+		if to == "LEFT" {
+			dst.list = append([][]byte{elem}, dst.list...)
+		} else {
+			dst.list = append(dst.list, elem)
+		}
+		r.removeEmpty(key)
+
+	default:
+		return false
+	}
+
+	r.removeEmpty(key)
+	return true
+}
+
+// lookup get value of key with the kind, a new value is created if the key
+// does not exist, nil if it exists with another kind
+func (r *Replayer) lookup(key string, k kind) *value {
+	keys := r.keys()
+	if v, ok := keys[key]; ok {
+		if v.kind != k {
+			return nil
+		}
+		return v
+	}
+	v := &value{kind: k}
+	switch k {
+	case kindHash:
+		v.hash = map[string][]byte{}
+	case kindSet:
+		v.set = map[string]struct{}{}
+	case kindZSet:
+		v.zset = map[string]float64{}
+	}
+	keys[key] = v
+	return v
+}
+
+// removeEmpty deletes key if it is an empty collection, like redis does
+func (r *Replayer) removeEmpty(key string) {
+	keys := r.keys()
+	if v, ok := keys[key]; ok && v.kind != kindString && v.kind < kindStream && v.len() == 0 {
+		delete(keys, key)
+	}
+}
+
+func (r *Replayer) zadd(key string, args [][]byte) bool {
+	nx, xx, gt, lt, incr := false, false, false, false, false
+	for len(args) > 0 {
+		opt := strings.ToUpper(string(args[0]))
+		switch opt {
+		case "NX":
+			nx = true
+		case "XX":
+			xx = true
+		case "GT":
+			gt = true
+		case "LT":
+			lt = true
+		case "INCR":
+			incr = true
+		case "CH":
+		default:
+			opt = ""
+		}
+		if opt == "" {
+			break
+		}
+		args = args[1:]
+	}
+	if len(args) == 0 || len(args)%2 != 0 {
+		return false
+	}
+	v := r.lookup(key, kindZSet)
+	if v == nil {
+		return false
+	}
+	for i := 0; i < len(args); i += 2 {
+		score, ok := parseScore(args[i])
+		if !ok {
+			return false
+		}
+		member := string(args[i+1])
+		old, exists := v.zset[member]
+		if exists && nx || !exists && xx {
+			continue
+		}
+		if incr && exists {
+			score += old
+		}
+		if exists && (gt && score <= old || lt && score >= old) {
+			continue
+		}
+		v.zset[member] = score
+	}
+	r.removeEmpty(key)
+	return true
+}
+
+func argAt(args [][]byte, i int) []byte {
+	if i >= len(args) {
+		return nil
+	}
+	return args[i]
+}
+
+func argInt(args [][]byte, i int) (int64, bool) {
+	n, err := strconv.ParseInt(string(argAt(args, i)), 10, 64)
+	return n, err == nil
+}
+
+func parseScore(b []byte) (float64, bool) {
+	switch strings.ToLower(string(b)) {
+	case "inf", "+inf":
+		return math.Inf(1), true
+	case "-inf":
+		return math.Inf(-1), true
+	}
+	score, err := strconv.ParseFloat(string(b), 64)
+	return score, err == nil
+}
+
+// parseScoreBound parses a ZRANGEBYSCORE bound like "(1.5"
+func parseScoreBound(b []byte) (float64, bool, bool) {
+	exclusive := len(b) > 0 && b[0] == '('
+	if exclusive {
+		b = b[1:]
+	}
+	score, ok := parseScore(b)
+	return score, exclusive, ok
+}
+
+// inLexRange checks member against ZRANGEBYLEX bounds like "[a" "(b" "-" "+"
+func inLexRange(member, min, max []byte) bool {
+	switch {
+	case min[0] == '+':
+		return false
+	case min[0] == '[' && bytes.Compare(member, min[1:]) < 0:
+		return false
+	case min[0] == '(' && bytes.Compare(member, min[1:]) <= 0:
+		return false
+	}
+	switch {
+	case max[0] == '-':
+		return false
+	case max[0] == '[' && bytes.Compare(member, max[1:]) > 0:
+		return false
+	case max[0] == '(' && bytes.Compare(member, max[1:]) >= 0:
+		return false
+	}
+	return true
+}
+
+// rangeIndex converts redis start and stop indexes, which may be negative,
+// to a slice range of a sequence of length n
+func rangeIndex(start, stop int64, n int) (int, int) {
+	l := int64(n)
+	if start < 0 {
+		start += l
+	}
+	if stop < 0 {
+		stop += l
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= l {
+		stop = l - 1
+	}
+	if start > stop || start >= l {
+		return 0, 0
+	}
+	return int(start), int(stop + 1)
+}
+
+// listRem removes count occurrences of elem, from the tail if count is
+// negative, all occurrences if count is 0
+func listRem(list [][]byte, count int64, elem []byte) [][]byte {
+	limit := count
+	if limit < 0 {
+		limit = -limit
+	}
+	removed := map[int]bool{}
+	for i := range list {
+		j := i
+		if count < 0 {
+			j = len(list) - 1 - i
+		}
+		if limit > 0 && int64(len(removed)) == limit {
+			break
+		}
+		if bytes.Equal(list[j], elem) {
+			removed[j] = true
+		}
+	}
+
+	res := make([][]byte, 0, len(list)-len(removed))
+	for i, e := range list {
+		if !removed[i] {
+			res = append(res, e)
+		}
+	}
+	return res
+}
@@ -0,0 +1,237 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aof
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/dongmx/rdb"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+type kind int
+
+const (
+	kindString kind = iota
+	kindList
+	kindHash
+	kindSet
+	kindZSet
+	kindStream
+	kindModule
+)
+
+// encoding thresholds of redis 7 default config
+const (
+	listpackMaxEntries = 128
+	listpackMaxValue   = 64
+	intsetMaxEntries   = 512
+	listpackNodeSize   = 8 * 1024 // list-max-listpack-size -2
+)
+
+// value of a key in the replayed keyspace
+type value struct {
+	kind   kind
+	expiry int64 // absolute unix time in milliseconds, 0 if not set
+
+	str  []byte
+	list [][]byte
+	hash map[string][]byte
+	set  map[string]struct{}
+	zset map[string]float64
+
+	// streams and module values are only loaded from a rdb preamble or base
+	// file, they are kept as is to be emitted again
+	stream *stream
+	module *module
+}
+
+type stream struct {
+	cardinality int64
+	info        *rdb.Info
+	ids         [][]byte
+	listpacks   [][]byte
+	items       uint64
+	lastEntryID string
+	cgroups     rdb.StreamGroups
+}
+
+type module struct {
+	name string
+	size uint64
+	info *rdb.Info
+}
+
+func (v *value) len() int {
+	switch v.kind {
+	case kindList:
+		return len(v.list)
+	case kindHash:
+		return len(v.hash)
+	case kindSet:
+		return len(v.set)
+	case kindZSet:
+		return len(v.zset)
+	}
+	return 1
+}
+
+// emit calls decode hooks of d for the value, the encoding is chosen as
+// redis 7 with default config would do
+func (v *value) emit(key []byte, d rdb.Decoder) {
+	info := &rdb.Info{}
+	m := decoder.MemProfiler{}
+
+	switch v.kind {
+	case kindString:
+		info.Encoding = "string"
+		d.Set(key, v.str, v.expiry, info)
+
+	case kindList:
+		info.Encoding = "quicklist2"
+		info.Zips = 1
+		size := uint64(0)
+		for _, elem := range v.list {
+			size += m.ListpackEntryOverhead(elem)
+			if size > listpackNodeSize {
+				info.Zips++
+				size = m.ListpackEntryOverhead(elem)
+			}
+		}
+		d.StartList(key, int64(len(v.list)), v.expiry, info)
+		for _, elem := range v.list {
+			d.Rpush(key, elem)
+		}
+		d.EndList(key)
+
+	case kindHash:
+		info.Encoding = "hashtable"
+		if len(v.hash) <= listpackMaxEntries {
+			info.Encoding = "listpack"
+			info.SizeOfValue = int(m.ListpackHeaderOverhead())
+			for field, val := range v.hash {
+				if len(field) > listpackMaxValue || len(val) > listpackMaxValue {
+					info.Encoding = "hashtable"
+					info.SizeOfValue = 0
+					break
+				}
+				info.SizeOfValue += int(m.ListpackEntryOverhead([]byte(field)) + m.ListpackEntryOverhead(val))
+			}
+		}
+		d.StartHash(key, int64(len(v.hash)), v.expiry, info)
+		for field, val := range v.hash {
+			d.Hset(key, []byte(field), val)
+		}
+		d.EndHash(key)
+
+	case kindSet:
+		info.Encoding, info.SizeOfValue = setEncoding(v.set)
+		d.StartSet(key, int64(len(v.set)), v.expiry, info)
+		for member := range v.set {
+			d.Sadd(key, []byte(member))
+		}
+		d.EndSet(key)
+
+	case kindZSet:
+		info.Encoding = "skiplist"
+		if len(v.zset) <= listpackMaxEntries {
+			info.Encoding = "listpack"
+			info.SizeOfValue = int(m.ListpackHeaderOverhead())
+			for member, score := range v.zset {
+				if len(member) > listpackMaxValue {
+					info.Encoding = "skiplist"
+					info.SizeOfValue = 0
+					break
+				}
+				info.SizeOfValue += int(m.ListpackEntryOverhead([]byte(member)))
+				info.SizeOfValue += int(m.ListpackEntryOverhead([]byte(formatScore(score))))
+			}
+		}
+		d.StartZSet(key, int64(len(v.zset)), v.expiry, info)
+		for member, score := range v.zset {
+			d.Zadd(key, score, []byte(member))
+		}
+		d.EndZSet(key)
+
+	case kindStream:
+		s := v.stream
+		d.StartStream(key, s.cardinality, v.expiry, s.info)
+		for i := range s.ids {
+			d.Xadd(key, s.ids[i], s.listpacks[i])
+		}
+		d.EndStream(key, s.items, s.lastEntryID, s.cgroups)
+
+	case kindModule:
+		d.Module(key, v.module.name, v.module.size, v.expiry, v.module.info)
+	}
+}
+
+// setEncoding get encoding and serialized size of a set, size is 0 for
+// hashtable encoding
+func setEncoding(set map[string]struct{}) (string, int) {
+	if len(set) <= intsetMaxEntries {
+		width := 2
+		isInts := true
+		for member := range set {
+			n, err := strconv.ParseInt(member, 10, 64)
+			if err != nil || strconv.FormatInt(n, 10) != member {
+				isInts = false
+				break
+			}
+			if n < -1<<31 || n > 1<<31-1 {
+				width = 8
+			} else if (n < -1<<15 || n > 1<<15-1) && width < 4 {
+				width = 4
+			}
+		}
+		if isInts {
+			return "intset", 8 + width*len(set)
+		}
+	}
+
+	if len(set) <= listpackMaxEntries {
+		m := decoder.MemProfiler{}
+		size := int(m.ListpackHeaderOverhead())
+		for member := range set {
+			if len(member) > listpackMaxValue {
+				return "hashtable", 0
+			}
+			size += int(m.ListpackEntryOverhead([]byte(member)))
+		}
+		return "listpack", size
+	}
+	return "hashtable", 0
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'g', 17, 64)
+}
+
+// sortedZSet get members of a sorted set ordered by score, then by member
+func sortedZSet(zset map[string]float64) []string {
+	members := make([]string, 0, len(zset))
+	for member := range zset {
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		si, sj := zset[members[i]], zset[members[j]]
+		if si != sj {
+			return si < sj
+		}
+		return members[i] < members[j]
+	})
+	return members
+}
@@ -0,0 +1,142 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aof
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// types of files in a manifest
+const (
+	FileTypeBase    = "b"
+	FileTypeHistory = "h"
+	FileTypeIncr    = "i"
+)
+
+// ManifestFile is a file listed in the manifest of a multi part aof
+type ManifestFile struct {
+	Name string
+	Seq  int64
+	Type string
+}
+
+// ReadManifest parses the manifest of a multi part aof written by redis 7,
+// it returns the base file followed by incr files in the order of loading.
+// History files are not loaded by redis and are left out.
+func ReadManifest(r io.Reader) ([]*ManifestFile, error) {
+	var base *ManifestFile
+	incrs := []*ManifestFile{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := splitManifestLine(line)
+		if len(fields)%2 != 0 {
+			return nil, fmt.Errorf("aof: bad manifest line %q", line)
+		}
+		f := &ManifestFile{}
+		for i := 0; i < len(fields); i += 2 {
+			switch fields[i] {
+			case "file":
+				f.Name = fields[i+1]
+			case "seq":
+				seq, err := strconv.ParseInt(fields[i+1], 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("aof: bad manifest seq %q", line)
+				}
+				f.Seq = seq
+			case "type":
+				f.Type = fields[i+1]
+			}
+		}
+		if f.Name == "" {
+			return nil, fmt.Errorf("aof: no file in manifest line %q", line)
+		}
+		switch f.Type {
+		case FileTypeBase:
+			if base != nil {
+				return nil, fmt.Errorf("aof: more than one base file in manifest")
+			}
+			base = f
+		case FileTypeIncr:
+			incrs = append(incrs, f)
+		case FileTypeHistory:
+		default:
+			return nil, fmt.Errorf("aof: unknown file type %q in manifest", f.Type)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(incrs, func(i, j int) bool {
+		return incrs[i].Seq < incrs[j].Seq
+	})
+	if base != nil {
+		return append([]*ManifestFile{base}, incrs...), nil
+	}
+	return incrs, nil
+}
+
+// splitManifestLine splits a line by spaces, file names with spaces are
+// quoted by redis
+func splitManifestLine(line string) []string {
+	fields := []string{}
+	for len(line) > 0 {
+		line = strings.TrimLeft(line, " ")
+		if line == "" {
+			break
+		}
+		if line[0] == '"' {
+			end := strings.IndexByte(line[1:], '"')
+			if end >= 0 {
+				fields = append(fields, line[1:end+1])
+				line = line[end+2:]
+				continue
+			}
+		}
+		end := strings.IndexByte(line, ' ')
+		if end < 0 {
+			end = len(line)
+		}
+		fields = append(fields, line[:end])
+		line = line[end:]
+	}
+	return fields
+}
+
+// FindManifest get path of the manifest file in an appendonlydir
+func FindManifest(dir string) (string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	for _, f := range files {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".manifest") {
+			return filepath.Join(dir, f.Name()), nil
+		}
+	}
+	return "", fmt.Errorf("aof: no manifest found in %s", dir)
+}
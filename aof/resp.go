@@ -0,0 +1,109 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aof
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const (
+	// maxBulkLen is the default proto-max-bulk-len of redis, the longest
+	// bulk string a command may have
+	maxBulkLen = 512 << 20
+	// maxPreallocArgs is the most arguments allocated ahead of reading them,
+	// a corrupt multi bulk count is not trusted for more
+	maxPreallocArgs = 1024
+)
+
+// readCommand reads a command in RESP multi bulk format from r, lines
+// starting with '#' are annotations and are returned as a command named "#".
+// io.EOF is returned at a clean end of the input, io.ErrUnexpectedEOF if the
+// last command is truncated.
+func readCommand(r *bufio.Reader) ([][]byte, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, fmt.Errorf("aof: unexpected empty line")
+	}
+	switch line[0] {
+	case '#':
+		return [][]byte{[]byte("#"), line[1:]}, nil
+	case '*':
+	default:
+		return nil, fmt.Errorf("aof: bad command line %q", line)
+	}
+
+	n, err := strconv.Atoi(string(line[1:]))
+	if err != nil || n < 1 {
+		return nil, fmt.Errorf("aof: bad multi bulk count %q", line)
+	}
+	args := make([][]byte, 0, minInt(n, maxPreallocArgs))
+	for i := 0; i < n; i++ {
+		line, err := readLine(r)
+		if err != nil {
+			return nil, truncated(err)
+		}
+		if len(line) == 0 || line[0] != '$' {
+			return nil, fmt.Errorf("aof: bad bulk line %q", line)
+		}
+		length, err := strconv.Atoi(string(line[1:]))
+		if err != nil || length < 0 {
+			return nil, fmt.Errorf("aof: bad bulk length %q", line)
+		}
+		if length > maxBulkLen {
+			return nil, fmt.Errorf("aof: bulk length %d exceeds %d", length, maxBulkLen)
+		}
+		arg := make([]byte, length+2)
+		if _, err := io.ReadFull(r, arg); err != nil {
+			return nil, truncated(err)
+		}
+		if !bytes.HasSuffix(arg, []byte("\r\n")) {
+			return nil, fmt.Errorf("aof: bulk string is not terminated by CRLF")
+		}
+		args = append(args, arg[:length])
+	}
+	return args, nil
+}
+
+func readLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadBytes('\n')
+	if err == io.EOF && len(line) > 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(line, "\r\n"), nil
+}
+
+func truncated(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,332 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decoder
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+)
+
+const (
+	// maxInspectLen is the most bytes of a value compressed, a longer value
+	// is taken to compress as well as its head
+	maxInspectLen = 64 << 10
+	// maxInspectValues is the most values of a hash inspected
+	maxInspectValues = 128
+)
+
+// magics are the leading bytes of compressed and serialized formats
+var magics = []struct {
+	class  string
+	prefix []byte
+}{
+	{"gzip", []byte{0x1f, 0x8b}},
+	{"zstd", []byte{0x28, 0xb5, 0x2f, 0xfd}},
+	{"lz4", []byte{0x04, 0x22, 0x4d, 0x18}},
+	{"snappy", []byte("\xff\x06\x00\x00sNaPpY")},
+	{"snappy", []byte("\x82SNAPPY\x00")},
+	{"java", []byte{0xac, 0xed, 0x00, 0x05}},
+}
+
+// phpSerialized matches the start of an array, object or string serialized
+// by PHP serialize()
+var phpSerialized = regexp.MustCompile(`^(?:[aOC]:\d+:[{"]|s:\d+:")`)
+
+// ContentCount counts inspected values of a class
+type ContentCount struct {
+	Num uint64
+	Len uint64
+	// Compressed is the length of the values compressed by flate
+	Compressed uint64
+	// Reformatted is the length of the values in a denser format, e.g.
+	// base64 decoded or json compacted, Len if there is none
+	Reformatted uint64
+}
+
+// Add counts the values of o in c
+func (c *ContentCount) Add(o *ContentCount) {
+	c.Num += o.Num
+	c.Len += o.Len
+	c.Compressed += o.Compressed
+	c.Reformatted += o.Reformatted
+}
+
+// ValueContent is what the value of a string, or the values of a hash, of
+// a key sampled for inspection are
+type ValueContent struct {
+	// Classes of the values, e.g. json or gzip
+	Classes map[string]*ContentCount
+	// Sample is the rate keys are inspected at, one in Sample of them
+	Sample uint64
+}
+
+// inspector samples keys whose values are inspected, the flate writer is
+// reused for every value. It compresses at the best level, lower levels of
+// compress/flate store values without matches uncompressed which zlib
+// clients would still entropy code.
+type inspector struct {
+	sample uint64
+	seq    uint64
+	buf    bytes.Buffer
+	w      *flate.Writer
+}
+
+// InspectValues makes the decoder classify and compress the values of one
+// in sample strings and hashes, as Content of their entries
+func (d *Decoder) InspectValues(sample int) {
+	if sample < 1 {
+		sample = 1
+	}
+	w, _ := flate.NewWriter(nil, flate.BestCompression)
+	d.inspect = &inspector{sample: uint64(sample), w: w}
+}
+
+// startContent sets Content of e if it is sampled
+func (d *Decoder) startContent(e *Entry) {
+	if d.inspect == nil {
+		return
+	}
+	d.inspect.seq++
+	if d.inspect.seq%d.inspect.sample != 0 {
+		return
+	}
+	e.Content = &ValueContent{Classes: map[string]*ContentCount{}, Sample: d.inspect.sample}
+}
+
+// inspectValue counts v in the Content of e, if it is sampled
+func (d *Decoder) inspectValue(e *Entry, v []byte) {
+	c := e.Content
+	if c == nil {
+		return
+	}
+	num := uint64(0)
+	for _, cc := range c.Classes {
+		num += cc.Num
+	}
+	if num >= maxInspectValues {
+		return
+	}
+	class := classifyValue(v)
+	cc, ok := c.Classes[class]
+	if !ok {
+		cc = &ContentCount{}
+		c.Classes[class] = cc
+	}
+	cc.Num++
+	cc.Len += uint64(len(v))
+	cc.Compressed += d.inspect.compressed(v)
+	cc.Reformatted += reformatted(class, v)
+}
+
+// compressed get the length of v compressed by flate, no more than its own
+func (in *inspector) compressed(v []byte) uint64 {
+	head := v
+	if len(head) > maxInspectLen {
+		head = head[:maxInspectLen]
+	}
+	in.buf.Reset()
+	in.w.Reset(&in.buf)
+	in.w.Write(head)
+	in.w.Close()
+	n := uint64(in.buf.Len())
+	if len(head) < len(v) {
+		n = n * uint64(len(v)) / uint64(len(head))
+	}
+	if n > uint64(len(v)) {
+		return uint64(len(v))
+	}
+	return n
+}
+
+// reformatted get the length of v of class in a denser format
+func reformatted(class string, v []byte) uint64 {
+	l := uint64(len(v))
+	switch class {
+	case "float":
+		// a double
+		if l > 8 {
+			return 8
+		}
+	case "hex":
+		return l / 2
+	case "base64":
+		return uint64(len(bytes.TrimRight(v, "="))) * 3 / 4
+	case "json":
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, v); err == nil {
+			return uint64(buf.Len())
+		}
+	}
+	return l
+}
+
+// classifyValue get the class of what v is: int, float, a compressed or
+// serialized format by its leading bytes, json, php, xml, hex, base64 or
+// text if it is text, protobuf if it parses as protobuf fields, or binary
+func classifyValue(v []byte) string {
+	if len(v) == 0 {
+		return "empty"
+	}
+	if isNumber(v) {
+		if len(v) <= 20 {
+			if _, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+				return "int"
+			}
+		}
+		if _, err := strconv.ParseFloat(string(v), 64); err == nil {
+			return "float"
+		}
+	}
+	for _, m := range magics {
+		if bytes.HasPrefix(v, m.prefix) {
+			return m.class
+		}
+	}
+	if isZlib(v) {
+		return "zlib"
+	}
+	if v[0] == 0x80 && len(v) > 2 && v[1] >= 2 && v[1] <= 5 && v[len(v)-1] == '.' {
+		return "pickle"
+	}
+	if !isText(v) {
+		if isProtobuf(v) {
+			return "protobuf"
+		}
+		return "binary"
+	}
+	t := bytes.TrimSpace(v)
+	switch {
+	case len(t) == 0:
+		return "text"
+	case (t[0] == '{' || t[0] == '[') && json.Valid(t):
+		return "json"
+	case phpSerialized.Match(t):
+		return "php"
+	case t[0] == '<':
+		return "xml"
+	case isHex(v):
+		return "hex"
+	case isBase64(v):
+		return "base64"
+	}
+	return "text"
+}
+
+func isNumber(v []byte) bool {
+	c := v[0]
+	return len(v) <= 32 && (c >= '0' && c <= '9' || c == '-' || c == '+' || c == '.')
+}
+
+// isZlib checks the zlib header of deflate with a 32K window and its check
+// bits
+func isZlib(v []byte) bool {
+	return len(v) > 2 && v[0] == 0x78 && binary.BigEndian.Uint16(v)%31 == 0
+}
+
+// isText reports whether v is utf-8 without control characters besides
+// whitespace
+func isText(v []byte) bool {
+	if !utf8.Valid(v) {
+		return false
+	}
+	for _, c := range v {
+		if c < 0x20 && c != '\t' && c != '\n' && c != '\r' || c == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+func isHex(v []byte) bool {
+	if len(v) < 16 || len(v)%2 != 0 {
+		return false
+	}
+	for _, c := range v {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// isBase64 checks v is of the standard or url alphabet with padding, with
+// both cases of letters unlike words
+func isBase64(v []byte) bool {
+	if len(v) < 16 || len(v)%4 != 0 {
+		return false
+	}
+	t := bytes.TrimRight(v, "=")
+	if len(v)-len(t) > 2 {
+		return false
+	}
+	upper, lower := false, false
+	for _, c := range t {
+		switch {
+		case c >= 'A' && c <= 'Z':
+			upper = true
+		case c >= 'a' && c <= 'z':
+			lower = true
+		case c >= '0' && c <= '9' || c == '+' || c == '/' || c == '-' || c == '_':
+		default:
+			return false
+		}
+	}
+	return upper && lower
+}
+
+// isProtobuf reports whether v parses as protobuf fields to its end
+func isProtobuf(v []byte) bool {
+	if len(v) < 2 {
+		return false
+	}
+	for len(v) > 0 {
+		key, n := binary.Uvarint(v)
+		if n <= 0 || key>>3 == 0 {
+			return false
+		}
+		v = v[n:]
+		switch key & 7 {
+		case 0:
+			if _, n = binary.Uvarint(v); n <= 0 {
+				return false
+			}
+			v = v[n:]
+		case 1:
+			if len(v) < 8 {
+				return false
+			}
+			v = v[8:]
+		case 2:
+			l, n := binary.Uvarint(v)
+			if n <= 0 || l > uint64(len(v)-n) {
+				return false
+			}
+			v = v[n+int(l):]
+		case 5:
+			if len(v) < 4 {
+				return false
+			}
+			v = v[4:]
+		default:
+			return false
+		}
+	}
+	return true
+}
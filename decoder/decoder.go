@@ -15,9 +15,13 @@
 package decoder
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+	"sync/atomic"
+	"time"
 
 	"github.com/dongmx/rdb"
 	"github.com/dongmx/rdb/nopdecoder"
@@ -31,20 +35,71 @@ type Entry struct {
 	NumOfElem          uint64
 	LenOfLargestElem   uint64
 	FieldOfLargestElem string
+	// Expiry is the absolute expire time in milliseconds, 0 if not set
+	Expiry int64
+	// TTL is milliseconds left before expiry relative to ctime of the rdb
+	TTL int64
+	// Idle is seconds since last access, -1 if the rdb has no lru info
+	Idle int64
+	// Freq is the logarithmic lfu counter, -1 if the rdb has no lfu info
+	Freq int
+	// DB is the number of the database the key is selected in
+	DB int
+	// Encoding of the value once loaded, as OBJECT ENCODING reports it
+	Encoding string
+	// Sizes of a hash, sorted set or set in other encodings, only if the
+	// decoder estimates encodings
+	Sizes *EncodingSizes `json:",omitempty"`
+	// Content of the value of a string or the values of a hash, only if the
+	// decoder inspects values and the key is sampled
+	Content *ValueContent `json:",omitempty"`
+}
+
+// DBSize is the size hint of a database from RDB_OPCODE_RESIZEDB
+type DBSize struct {
+	DBSize      uint32
+	ExpiresSize uint32
 }
 
 // Decoder decode rdb file
 type Decoder struct {
+	// keys is first for 64 bit alignment of atomic operations
+	keys    uint64
 	Entries chan *Entry
 	m       MemProfiler
 
 	usedMem int64
 	ctime   int64
-	count   int
-	rdbVer int
+	rdbVer  int
+
+	db      int
+	dbSizes map[int]*DBSize
+	// counted is the number of keys, and of keys with expiry, decoded in
+	// each database
+	counted map[int]*DBSize
+
+	// salvage is what salvage decoding skipped, nil if it is not used
+	salvage *rdb.SalvageReport
+	// checksumErr is the checksum mismatch of the rdb, nil if there is none
+	checksumErr *rdb.ChecksumError
+	// pinned is set if the memory model is not to be chosen by the rdb
+	pinned bool
 
 	currentInfo  *rdb.Info
 	currentEntry *Entry
+	// members is the number of members of the current sorted set added
+	members uint64
+	// estimate is set if collections are estimated in every encoding, by
+	// sizes of the current one
+	estimate bool
+	sizes    *sizesEstimate
+	// elements are heaps of the largest elements of the collections of each
+	// database, the ones of the current collection are also in keyElements
+	elements        map[int]*elementHeap
+	keyElements     []*Element
+	largestElements int
+	// inspect is set if values are inspected
+	inspect *inspector
 
 	nopdecoder.NopDecoder
 }
@@ -52,16 +107,70 @@ type Decoder struct {
 // NewDecoder new a rdb decoder
 func NewDecoder() *Decoder {
 	return &Decoder{
-		Entries: make(chan *Entry, 1024),
-		m:       MemProfiler{},
+		Entries:         make(chan *Entry, 1024),
+		m:               MemProfiler{},
+		dbSizes:         map[int]*DBSize{},
+		counted:         map[int]*DBSize{},
+		elements:        map[int]*elementHeap{},
+		largestElements: defaultLargestElements,
 	}
 }
 
 func (d *Decoder) sendEntry() {
-	d.Entries <- d.currentEntry
+	d.endElements()
+	d.emit(d.currentEntry)
 	d.currentEntry = nil
 }
 
+func (d *Decoder) emit(e *Entry) {
+	n, ok := d.counted[e.DB]
+	if !ok {
+		n = &DBSize{}
+		d.counted[e.DB] = n
+	}
+	n.DBSize++
+	if e.Expiry > 0 {
+		n.ExpiresSize++
+	}
+	atomic.AddUint64(&d.keys, 1)
+	d.Entries <- e
+}
+
+// GetKeys get number of keys decoded so far, it is safe to call while
+// decoding
+func (d *Decoder) GetKeys() uint64 {
+	return atomic.LoadUint64(&d.keys)
+}
+
+// ttl get milliseconds left before expiry, relative to ctime of the rdb if
+// it is known, or to now otherwise
+func (d *Decoder) ttl(expiry int64) int64 {
+	if expiry <= 0 {
+		return 0
+	}
+	now := d.ctime * 1000
+	if now == 0 {
+		now = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+	return expiry - now
+}
+
+// idle get lru idle seconds of a key, -1 if unknown
+func idle(info *rdb.Info) int64 {
+	if info == nil || !info.HasIdle {
+		return -1
+	}
+	return int64(info.Idle)
+}
+
+// freq get lfu counter of a key, -1 if unknown
+func freq(info *rdb.Info) int {
+	if info == nil || !info.HasFreq {
+		return -1
+	}
+	return info.Freq
+}
+
 func (d *Decoder) GetTimestamp() int64 {
 	return d.ctime
 }
@@ -70,8 +179,87 @@ func (d *Decoder) GetUsedMem() int64 {
 	return d.usedMem
 }
 
+// DecodeSalvage decodes the rdb from r in salvage mode, see
+// rdb.DecodeSalvage. The salvage report is complete when Entries is closed.
+func (d *Decoder) DecodeSalvage(ctx context.Context, r io.Reader) error {
+	d.salvage = &rdb.SalvageReport{}
+	return rdb.DecodeSalvage(ctx, r, d, d.salvage)
+}
+
+// GetSalvageReport get what salvage decoding skipped, nil if the rdb is not
+// decoded in salvage mode
+func (d *Decoder) GetSalvageReport() *rdb.SalvageReport {
+	return d.salvage
+}
+
+// ChecksumFailed records err if it is a checksum mismatch of the rdb, which
+// is only a warning as every key has been decoded. It reports whether err is
+// one, and is to be called before Entries is closed.
+func (d *Decoder) ChecksumFailed(err error) bool {
+	cerr, ok := err.(*rdb.ChecksumError)
+	if ok {
+		d.checksumErr = cerr
+	}
+	return ok
+}
+
+// GetChecksumError get the checksum mismatch of the rdb, nil if there is
+// none
+func (d *Decoder) GetChecksumError() *rdb.ChecksumError {
+	return d.checksumErr
+}
+
+// SetRedisVersion sets the memory model to that of a redis version instead
+// of the one chosen by the redis-ver aux field of the rdb
+func (d *Decoder) SetRedisVersion(version string) error {
+	model, err := MemModelFor(version)
+	if err != nil {
+		return err
+	}
+	d.m.model = model
+	d.pinned = true
+	return nil
+}
+
+// GetMemModel get the redis version of the memory model in use
+func (d *Decoder) GetMemModel() string {
+	return d.m.Model().Version
+}
+
+// GetDBSizes get size hints of databases, keyed by db number
+func (d *Decoder) GetDBSizes() map[int]*DBSize {
+	return d.dbSizes
+}
+
+// GetDictTablesOverhead get estimated memory of the main and expires dicts
+// of every database, which is not counted in the bytes of any key
+func (d *Decoder) GetDictTablesOverhead() uint64 {
+	total := uint64(0)
+	for _, n := range d.counted {
+		total += d.m.HashtableOverhead(uint64(n.DBSize))
+		if n.ExpiresSize > 0 {
+			total += d.m.HashtableOverhead(uint64(n.ExpiresSize))
+		}
+	}
+	return total
+}
+
 func (d *Decoder) StartRDB(ver int) {
 	d.rdbVer = ver
+	if !d.pinned {
+		d.m.model = memModelForRDB(ver)
+	}
+}
+
+func (d *Decoder) StartDatabase(n int) {
+	d.db = n
+}
+
+func (d *Decoder) ResizeDatabase(dbSize, expiresSize uint32) {
+	d.dbSizes[d.db] = &DBSize{
+		DBSize:      dbSize,
+		ExpiresSize: expiresSize,
+	}
 }
 
 func (d *Decoder) Aux(key, value []byte) {
@@ -94,6 +282,10 @@ func (d *Decoder) Aux(key, value []byte) {
 			d.usedMem = n
 		}
 
+	case "redis-ver":
+		if model, err := MemModelFor(string(value)); err == nil && !d.pinned {
+			d.m.model = model
+		}
 	}
 }
 
@@ -108,8 +300,14 @@ func (d *Decoder) StartStream(key []byte, cardinality, expiry int64, info *rdb.I
 		Key:              keyStr,
 		Bytes:            bytes,
 		Type:             "stream",
+		Encoding:         "stream",
 		NumOfElem:        0,
 		LenOfLargestElem: 0,
+		Expiry:           expiry,
+		TTL:              d.ttl(expiry),
+		Idle:             idle(info),
+		Freq:             freq(info),
+		DB:               d.db,
 	}
 }
 
@@ -140,15 +338,45 @@ func (d *Decoder) EndStream(key []byte, items uint64, lastEntryID string, cgroup
 func (d *Decoder) Set(key, value []byte, expiry int64, info *rdb.Info) {
 	keyStr := string(key)
 	bytes := d.m.TopLevelObjOverhead(key, expiry)
-	bytes += d.m.SizeofString(value)
+	bytes += d.m.SizeofStringValue(value)
 
 	e := &Entry{
 		Key:       keyStr,
 		Bytes:     bytes,
 		Type:      "string",
+		Encoding:  d.m.StringEncoding(value),
 		NumOfElem: d.m.ElemLen(value),
+		Expiry:    expiry,
+		TTL:       d.ttl(expiry),
+		Idle:      idle(info),
+		Freq:      freq(info),
+		DB:        d.db,
 	}
-	d.Entries <- e
+	d.startContent(e)
+	d.inspectValue(e, value)
+	d.emit(e)
+}
+
+// Module is called once for each module value, the size of it is estimated
+// by the serialized bytes since the in-memory layout is private to the module.
+func (d *Decoder) Module(key []byte, module string, size uint64, expiry int64, info *rdb.Info) {
+	keyStr := string(key)
+	bytes := d.m.TopLevelObjOverhead(key, expiry)
+	bytes += size
+
+	e := &Entry{
+		Key:       keyStr,
+		Bytes:     bytes,
+		Type:      "module:" + module,
+		Encoding:  "module",
+		NumOfElem: 1,
+		Expiry:    expiry,
+		TTL:       d.ttl(expiry),
+		Idle:      idle(info),
+		Freq:      freq(info),
+		DB:        d.db,
+	}
+	d.emit(e)
 }
 
 // StartHash is called at the beginning of a hash.
@@ -158,8 +386,11 @@ func (d *Decoder) StartHash(key []byte, length, expiry int64, info *rdb.Info) {
 
 	bytes := d.m.TopLevelObjOverhead(key, expiry)
 
-	if info.SizeOfValue > 0 {
-		bytes += uint64(info.SizeOfValue)
+	if d.m.ConvertsToListpack(info.Encoding) {
+		// entries are added by Hset
+		bytes += d.m.ListpackHeaderOverhead()
+	} else if info.SizeOfValue > 0 {
+		bytes += d.m.PackedOverhead(uint64(info.SizeOfValue))
 	} else if info.Encoding == "hashtable" {
 		bytes += d.m.HashtableOverhead(uint64(length))
 	} else {
@@ -167,12 +398,20 @@ func (d *Decoder) StartHash(key []byte, length, expiry int64, info *rdb.Info) {
 	}
 
 	d.currentInfo = info
+	d.startSizes(key, expiry, "hash")
 	d.currentEntry = &Entry{
 		Key:       keyStr,
 		Bytes:     bytes,
 		Type:      "hash",
+		Encoding:  d.m.Encoding("hash", info.Encoding),
 		NumOfElem: uint64(length),
+		Expiry:    expiry,
+		TTL:       d.ttl(expiry),
+		Idle:      idle(info),
+		Freq:      freq(info),
+		DB:        d.db,
 	}
+	d.startContent(d.currentEntry)
 }
 
 // Hset is called once for each field=value pair in a hash.
@@ -185,6 +424,9 @@ func (d *Decoder) Hset(key, field, value []byte) {
 		e.LenOfLargestElem = lenOfElem
 	}
 
+	if d.m.ConvertsToListpack(d.currentInfo.Encoding) {
+		e.Bytes += d.m.ListpackEntryOverhead(field) + d.m.ListpackEntryOverhead(value)
+	}
 	if d.currentInfo.Encoding == "hashtable" {
 		e.Bytes += d.m.SizeofString(field)
 		e.Bytes += d.m.SizeofString(value)
@@ -194,10 +436,14 @@ func (d *Decoder) Hset(key, field, value []byte) {
 			e.Bytes += 2 * d.m.RobjOverhead()
 		}
 	}
+	d.sizeHset(field, value)
+	d.countElement(field, uint64(len(field)+len(value)))
+	d.inspectValue(e, value)
 }
 
 // EndHash is called when there are no more fields in a hash.
 func (d *Decoder) EndHash(key []byte) {
+	d.endSizes("hash")
 	d.sendEntry()
 }
 
@@ -205,6 +451,11 @@ func (d *Decoder) EndHash(key []byte) {
 // Sadd will be called exactly cardinality times before EndSet.
 func (d *Decoder) StartSet(key []byte, cardinality, expiry int64, info *rdb.Info) {
 	d.StartHash(key, cardinality, expiry, info)
+	d.startSizes(key, expiry, "set")
+	d.currentEntry.Type = "set"
+	d.currentEntry.Encoding = d.m.Encoding("set", info.Encoding)
+	// members of sets are not inspected
+	d.currentEntry.Content = nil
 }
 
 // Sadd is called once for each member of a set.
@@ -218,17 +469,20 @@ func (d *Decoder) Sadd(key, member []byte) {
 
 	if d.currentInfo.Encoding == "hashtable" {
 		e.Bytes += d.m.SizeofString(member)
-		e.Bytes += d.m.HashtableEntryOverhead()
+		e.Bytes += d.m.SetEntryOverhead()
 
 		if d.rdbVer < 8 {
 			e.Bytes += d.m.RobjOverhead()
 		}
 	}
+	d.sizeSadd(member)
+	d.countElement(member, uint64(len(member)))
 }
 
 // EndSet is called when there are no more fields in a set.
 // Same as EndHash
 func (d *Decoder) EndSet(key []byte) {
+	d.endSizes("set")
 	d.sendEntry()
 }
 
@@ -247,7 +501,13 @@ func (d *Decoder) StartList(key []byte, length, expiry int64, info *rdb.Info) {
 		Key:       keyStr,
 		Bytes:     bytes,
 		Type:      "list",
+		Encoding:  d.m.Encoding("list", info.Encoding),
 		NumOfElem: 0,
+		Expiry:    expiry,
+		TTL:       d.ttl(expiry),
+		Idle:      idle(info),
+		Freq:      freq(info),
+		DB:        d.db,
 	}
 }
 
@@ -258,11 +518,15 @@ func (d *Decoder) Rpush(key, value []byte) {
 	e.NumOfElem++
 
 	switch d.currentInfo.Encoding {
-	case "quicklist":
-		e.Bytes += d.m.ZiplistEntryOverhead(value)
+	case "quicklist", "ziplist":
+		if d.m.ConvertsToListpack(d.currentInfo.Encoding) {
+			e.Bytes += d.m.ListpackEntryOverhead(value)
+		} else {
+			e.Bytes += d.m.ZiplistEntryOverhead(value)
+		}
 
-	case "ziplist":
-		e.Bytes += d.m.ZiplistEntryOverhead(value)
+	case "quicklist2":
+		e.Bytes += d.m.ListpackEntryOverhead(value)
 
 	case "linkedlist":
 		sizeInlist := uint64(0)
@@ -286,6 +550,7 @@ func (d *Decoder) Rpush(key, value []byte) {
 		e.FieldOfLargestElem = string(value)
 		e.LenOfLargestElem = lenOfElem
 	}
+	d.countElement(value, uint64(len(value)))
 }
 
 // EndList is called when there are no more values in a list.
@@ -295,10 +560,22 @@ func (d *Decoder) EndList(key []byte) {
 	switch d.currentInfo.Encoding {
 	case "quicklist":
 		e.Bytes += d.m.QuicklistOverhead(d.currentInfo.Zips)
-		e.Bytes += d.m.ZiplistHeaderOverhead() * d.currentInfo.Zips
+		if d.m.ConvertsToListpack(d.currentInfo.Encoding) {
+			e.Bytes += d.m.ListpackHeaderOverhead() * d.currentInfo.Zips
+		} else {
+			e.Bytes += d.m.ZiplistHeaderOverhead() * d.currentInfo.Zips
+		}
+
+	case "quicklist2":
+		e.Bytes += d.m.QuicklistOverhead(d.currentInfo.Zips)
+		e.Bytes += d.m.ListpackHeaderOverhead() * d.currentInfo.Zips
 
 	case "ziplist":
-		e.Bytes += d.m.ZiplistHeaderOverhead()
+		if d.m.ConvertsToListpack(d.currentInfo.Encoding) {
+			e.Bytes += d.m.ListpackHeaderOverhead()
+		} else {
+			e.Bytes += d.m.ZiplistHeaderOverhead()
+		}
 
 	case "linkedlist":
 		e.Bytes += d.m.LinkedlistOverhead()
@@ -317,9 +594,14 @@ func (d *Decoder) StartZSet(key []byte, cardinality, expiry int64, info *rdb.Inf
 
 	bytes := d.m.TopLevelObjOverhead(key, expiry)
 	d.currentInfo = info
-
-	if info.SizeOfValue > 0 {
-		bytes += uint64(info.SizeOfValue)
+	d.members = 0
+	d.startSizes(key, expiry, "sortedset")
+
+	if d.m.ConvertsToListpack(info.Encoding) {
+		// entries are added by Zadd
+		bytes += d.m.ListpackHeaderOverhead()
+	} else if info.SizeOfValue > 0 {
+		bytes += d.m.PackedOverhead(uint64(info.SizeOfValue))
 	} else if info.Encoding == "skiplist" {
 		bytes += d.m.SkiplistOverhead(uint64(cardinality))
 	} else {
@@ -330,7 +612,13 @@ func (d *Decoder) StartZSet(key []byte, cardinality, expiry int64, info *rdb.Inf
 		Key:       keyStr,
 		Bytes:     bytes,
 		Type:      "sortedset",
+		Encoding:  d.m.Encoding("sortedset", info.Encoding),
 		NumOfElem: uint64(cardinality),
+		Expiry:    expiry,
+		TTL:       d.ttl(expiry),
+		Idle:      idle(info),
+		Freq:      freq(info),
+		DB:        d.db,
 	}
 }
 
@@ -343,19 +631,27 @@ func (d *Decoder) Zadd(key []byte, score float64, member []byte) {
 		e.LenOfLargestElem = lenOfElem
 	}
 
+	if d.m.ConvertsToListpack(d.currentInfo.Encoding) {
+		e.Bytes += d.m.ListpackEntryOverhead(member)
+		e.Bytes += d.m.ListpackEntryOverhead([]byte(strconv.FormatFloat(score, 'g', 17, 64)))
+	}
 	if d.currentInfo.Encoding == "skiplist" {
 		e.Bytes += 8 // sizeof(score)
 		e.Bytes += d.m.SizeofString(member)
-		e.Bytes += d.m.SkiplistEntryOverhead()
+		e.Bytes += d.m.SkiplistEntryOverhead(d.members)
+		d.members++
 
 		if d.rdbVer < 8 {
 			e.Bytes += d.m.RobjOverhead()
 		}
 	}
+	d.sizeZadd(score, member)
+	d.countElement(member, uint64(len(member)))
 }
 
 // EndZSet is called when there are no more members in a sorted set.
 func (d *Decoder) EndZSet(key []byte) {
+	d.endSizes("sortedset")
 	d.sendEntry()
 }
 
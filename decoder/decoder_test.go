@@ -0,0 +1,519 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decoder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/dongmx/rdb"
+	"github.com/stretchr/testify/assert"
+)
+
+// rdbString encodes s as a rdb length prefixed string, s must be shorter than 64 bytes
+func rdbString(s []byte) []byte {
+	return append([]byte{byte(len(s))}, s...)
+}
+
+// listpack encodes strings as 6 bit strings and "7", "-100" as integers
+func listpack(elems ...string) []byte {
+	body := []byte{}
+	for _, e := range elems {
+		switch e {
+		case "7":
+			body = append(body, 0x07, 1)
+		case "-100":
+			// 13 bit int
+			v := uint16(-100 + 8192)
+			body = append(body, 0xC0|byte(v>>8), byte(v), 2)
+		default:
+			body = append(body, 0x80|byte(len(e)))
+			body = append(body, e...)
+			body = append(body, byte(1+len(e)))
+		}
+	}
+	lp := make([]byte, 6, 6+len(body)+1)
+	binary.LittleEndian.PutUint32(lp, uint32(6+len(body)+1))
+	binary.LittleEndian.PutUint16(lp[4:], uint16(len(elems)))
+	lp = append(lp, body...)
+	return append(lp, 0xFF)
+}
+
+func decodeEntries(t *testing.T, file []byte) map[string]*Entry {
+	d := NewDecoder()
+	err := rdb.Decode(bytes.NewReader(file), d)
+	assert.NoError(t, err)
+
+	entries := map[string]*Entry{}
+	for e := range d.Entries {
+		entries[e.Key] = e
+	}
+	return entries
+}
+
+func TestDecodeListpackTypes(t *testing.T) {
+	file := []byte("REDIS0011")
+	file = append(file, 0xFE, 0x00)
+
+	file = append(file, byte(rdb.TypeHashListpack))
+	file = append(file, rdbString([]byte("hash"))...)
+	file = append(file, rdbString(listpack("f1", "v1", "f2", "7"))...)
+
+	file = append(file, byte(rdb.TypeZSetListpack))
+	file = append(file, rdbString([]byte("zset"))...)
+	file = append(file, rdbString(listpack("m1", "-100", "member2", "7"))...)
+
+	file = append(file, byte(rdb.TypeSetListpack))
+	file = append(file, rdbString([]byte("set"))...)
+	file = append(file, rdbString(listpack("a", "bb", "ccc"))...)
+
+	file = append(file, byte(rdb.TypeListQuicklist2))
+	file = append(file, rdbString([]byte("list"))...)
+	file = append(file, 2) // nodes
+	file = append(file, 2) // packed
+	file = append(file, rdbString(listpack("x", "7", "-100"))...)
+	file = append(file, 1) // plain
+	file = append(file, rdbString([]byte("a plain node"))...)
+
+	file = append(file, 0xFF)
+	file = append(file, make([]byte, 8)...)
+
+	entries := decodeEntries(t, file)
+	assert.Len(t, entries, 4)
+
+	assert.Equal(t, "hash", entries["hash"].Type)
+	assert.Equal(t, "listpack", entries["hash"].Encoding)
+	assert.Equal(t, uint64(2), entries["hash"].NumOfElem)
+
+	assert.Equal(t, "sortedset", entries["zset"].Type)
+	assert.Equal(t, uint64(2), entries["zset"].NumOfElem)
+	assert.Equal(t, "member2", entries["zset"].FieldOfLargestElem)
+
+	assert.Equal(t, "set", entries["set"].Type)
+	assert.Equal(t, "listpack", entries["set"].Encoding)
+	assert.Equal(t, uint64(3), entries["set"].NumOfElem)
+	assert.Equal(t, "ccc", entries["set"].FieldOfLargestElem)
+
+	assert.Equal(t, "list", entries["list"].Type)
+	assert.Equal(t, "quicklist", entries["list"].Encoding)
+	assert.Equal(t, uint64(4), entries["list"].NumOfElem)
+	assert.Equal(t, "a plain node", entries["list"].FieldOfLargestElem)
+}
+
+func TestListpackEntryOverhead(t *testing.T) {
+	m := MemProfiler{}
+	assert.Equal(t, uint64(2), m.ListpackEntryOverhead([]byte("7")))
+	assert.Equal(t, uint64(3), m.ListpackEntryOverhead([]byte("-100")))
+	assert.Equal(t, uint64(5), m.ListpackEntryOverhead([]byte("70000")))
+	assert.Equal(t, uint64(5), m.ListpackEntryOverhead([]byte("abc")))
+	assert.Equal(t, uint64(2+100+1), m.ListpackEntryOverhead(bytes.Repeat([]byte("a"), 100)))
+}
+
+func TestMemModelFor(t *testing.T) {
+	for version, model := range map[string]string{
+		"3.0.7":  "3.0",
+		"3.2.12": "4",
+		"4.0.14": "4",
+		"5.0.7":  "5",
+		"6.2.6":  "6",
+		"7":      "7.0",
+		"7.0.15": "7.0",
+		"7.2.4":  "7.2",
+		"8.0.0":  "7.2",
+	} {
+		m, err := MemModelFor(version)
+		assert.NoError(t, err)
+		assert.Equal(t, model, m.Version, version)
+	}
+	_, err := MemModelFor("unstable")
+	assert.Error(t, err)
+}
+
+func TestMemModelStrings(t *testing.T) {
+	m := MemProfiler{model: memModel("6")}
+	assert.Equal(t, uint64(16), m.RobjOverhead())
+	// sdshdr5, sdshdr8 and sdshdr16
+	assert.Equal(t, uint64(16), m.SizeofSds([]byte("0123456789")))
+	assert.Equal(t, uint64(48), m.SizeofSds(bytes.Repeat([]byte("a"), 40)))
+	assert.Equal(t, uint64(320), m.SizeofSds(bytes.Repeat([]byte("a"), 300)))
+	// integer and embstr values
+	assert.Equal(t, uint64(0), m.SizeofStringValue([]byte("12345678901")))
+	assert.Equal(t, uint64(32-16), m.SizeofStringValue([]byte("hello")))
+	assert.Equal(t, uint64(64-16), m.SizeofStringValue(bytes.Repeat([]byte("a"), 44)))
+	assert.Equal(t, uint64(56), m.SizeofStringValue(bytes.Repeat([]byte("a"), 45)))
+	assert.Equal(t, "int", m.StringEncoding([]byte("12345678901")))
+	assert.Equal(t, "embstr", m.StringEncoding(bytes.Repeat([]byte("a"), 44)))
+	assert.Equal(t, "raw", m.StringEncoding(bytes.Repeat([]byte("a"), 45)))
+
+	legacy := MemProfiler{model: memModel("3.0")}
+	assert.Equal(t, uint64(24), legacy.SizeofSds([]byte("0123456789")))
+	assert.Equal(t, m.HashtableEntryOverhead(), m.SetEntryOverhead())
+	latest := MemProfiler{}
+	assert.Equal(t, uint64(16), latest.SetEntryOverhead())
+}
+
+func TestDecodeRedisVersion(t *testing.T) {
+	file := []byte("REDIS0009")
+	file = append(file, 0xFA)
+	file = append(file, rdbString([]byte("redis-ver"))...)
+	file = append(file, rdbString([]byte("7.2.4"))...)
+	file = append(file, 0xFE, 0x00)
+	file = append(file, byte(rdb.TypeString))
+	file = append(file, rdbString([]byte("a"))...)
+	file = append(file, rdbString([]byte("v"))...)
+	file = append(file, 0xFF)
+	file = append(file, make([]byte, 8)...)
+
+	d := NewDecoder()
+	go rdb.Decode(bytes.NewReader(file), d)
+	for range d.Entries {
+	}
+	assert.Equal(t, "7.2", d.GetMemModel())
+	// the 2 buckets of the main dict of a single key, no expires dict
+	assert.Equal(t, uint64(56+2*8*3/2), d.GetDictTablesOverhead())
+
+	d = NewDecoder()
+	assert.NoError(t, d.SetRedisVersion("5.0"))
+	go rdb.Decode(bytes.NewReader(file), d)
+	for range d.Entries {
+	}
+	assert.Equal(t, "5", d.GetMemModel())
+
+	// a key expiring at ctime has a ttl of 0 and is still in the expires dict
+	d = NewDecoder()
+	d.ctime = 1000
+	d.emit(&Entry{Key: "a", Expiry: 1000 * 1000, TTL: d.ttl(1000 * 1000)})
+	assert.Equal(t, uint32(1), d.counted[0].ExpiresSize)
+}
+
+func TestDecodeIdleAndFreq(t *testing.T) {
+	file := []byte("REDIS0009")
+	file = append(file, 0xFE, 0x00)
+
+	file = append(file, 0xF8, 0x40, 0xC8) // idle 200
+	file = append(file, byte(rdb.TypeString))
+	file = append(file, rdbString([]byte("idle"))...)
+	file = append(file, rdbString([]byte("v"))...)
+
+	file = append(file, 0xF9, 10) // freq 10
+	file = append(file, byte(rdb.TypeString))
+	file = append(file, rdbString([]byte("freq"))...)
+	file = append(file, rdbString([]byte("v"))...)
+
+	file = append(file, byte(rdb.TypeString))
+	file = append(file, rdbString([]byte("none"))...)
+	file = append(file, rdbString([]byte("v"))...)
+
+	file = append(file, 0xFF)
+	file = append(file, make([]byte, 8)...)
+
+	entries := decodeEntries(t, file)
+	assert.Len(t, entries, 3)
+
+	assert.Equal(t, int64(200), entries["idle"].Idle)
+	assert.Equal(t, -1, entries["idle"].Freq)
+	assert.Equal(t, int64(-1), entries["freq"].Idle)
+	assert.Equal(t, 10, entries["freq"].Freq)
+	assert.Equal(t, int64(-1), entries["none"].Idle)
+	assert.Equal(t, -1, entries["none"].Freq)
+}
+
+func TestDecodeDatabases(t *testing.T) {
+	file := []byte("REDIS0009")
+
+	file = append(file, 0xFE, 0x00)
+	file = append(file, byte(rdb.TypeString))
+	file = append(file, rdbString([]byte("a"))...)
+	file = append(file, rdbString([]byte("v"))...)
+
+	file = append(file, 0xFE, 0x05)
+	file = append(file, 0xFB, 0x02, 0x01) // resize db 2 keys, 1 expire
+	file = append(file, byte(rdb.TypeString))
+	file = append(file, rdbString([]byte("b"))...)
+	file = append(file, rdbString([]byte("v"))...)
+
+	file = append(file, 0xFF)
+	file = append(file, make([]byte, 8)...)
+
+	d := NewDecoder()
+	err := rdb.Decode(bytes.NewReader(file), d)
+	assert.NoError(t, err)
+
+	dbs := map[string]int{}
+	for e := range d.Entries {
+		dbs[e.Key] = e.DB
+	}
+	assert.Equal(t, map[string]int{"a": 0, "b": 5}, dbs)
+	assert.Equal(t, map[int]*DBSize{5: {DBSize: 2, ExpiresSize: 1}}, d.GetDBSizes())
+}
+
+func TestDecodeSalvage(t *testing.T) {
+	file := []byte("REDIS0009")
+	file = append(file, 0xFE, 0x00)
+	file = append(file, byte(rdb.TypeString))
+	file = append(file, rdbString([]byte("a"))...)
+	file = append(file, rdbString([]byte("v"))...)
+
+	// unknown length encoding of the value
+	offset := len(file)
+	file = append(file, byte(rdb.TypeString))
+	file = append(file, rdbString([]byte("bad"))...)
+	file = append(file, 0xBF, 'x')
+
+	file = append(file, byte(rdb.TypeString))
+	file = append(file, rdbString([]byte("b"))...)
+	file = append(file, rdbString([]byte("v"))...)
+	// truncated before the EOF opcode
+
+	d := NewDecoder()
+	err := d.DecodeSalvage(context.Background(), bytes.NewReader(file))
+	assert.NoError(t, err)
+
+	keys := []string{}
+	for e := range d.Entries {
+		keys = append(keys, e.Key)
+	}
+	assert.Equal(t, []string{"a", "b"}, keys)
+
+	report := d.GetSalvageReport()
+	assert.True(t, report.Partial())
+	assert.True(t, report.Truncated)
+	assert.Equal(t, []rdb.SkippedRange{{Offset: int64(offset), Bytes: 7, Key: "bad", Err: report.Skipped[0].Err}}, report.Skipped)
+}
+
+// moduleID encodes a 9 characters module type name and encoding version
+func moduleID(name string, encver uint64) []byte {
+	const charset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	id := uint64(0)
+	for _, c := range []byte(name) {
+		id = id<<6 | uint64(bytes.IndexByte([]byte(charset), c))
+	}
+	b := make([]byte, 9)
+	b[0] = 0x81 // 64 bit length
+	binary.BigEndian.PutUint64(b[1:], id<<10|encver)
+	return b
+}
+
+func TestDecodeModule(t *testing.T) {
+	file := []byte("REDIS0009")
+	file = append(file, 0xFE, 0x00)
+
+	// module aux: module id, when opcode, when, then a uint and EOF
+	file = append(file, 0xF7)
+	file = append(file, moduleID("ReJSON-RL", 3)...)
+	file = append(file, 2, 2)
+	file = append(file, 2, 10, 0)
+
+	file = append(file, byte(rdb.TypeModule2))
+	file = append(file, rdbString([]byte("json"))...)
+	file = append(file, moduleID("ReJSON-RL", 3)...)
+	file = append(file, 5)
+	file = append(file, rdbString([]byte(`{"a":1}`))...)
+	file = append(file, 4)
+	file = append(file, make([]byte, 8)...)
+	file = append(file, 3)
+	file = append(file, make([]byte, 4)...)
+	file = append(file, 1, 7)
+	file = append(file, 0)
+
+	file = append(file, byte(rdb.TypeString))
+	file = append(file, rdbString([]byte("after"))...)
+	file = append(file, rdbString([]byte("v"))...)
+
+	file = append(file, 0xFF)
+	file = append(file, make([]byte, 8)...)
+
+	entries := decodeEntries(t, file)
+	assert.Len(t, entries, 2)
+
+	json := entries["json"]
+	assert.Equal(t, "module:ReJSON-RL", json.Type)
+	m := MemProfiler{}
+	assert.Equal(t, m.TopLevelObjOverhead([]byte("json"), 0)+7+8+4+8, json.Bytes)
+	assert.Equal(t, "string", entries["after"].Type)
+}
+
+func TestLargestElements(t *testing.T) {
+	d := NewDecoder()
+	d.currentEntry = &Entry{Key: "big", Type: "list", DB: 2}
+	for i := 0; i < 4*defaultLargestElements; i++ {
+		d.countElement([]byte(strings.Repeat("x", i)), uint64(i))
+	}
+	// elements of a growing collection replaced in the heap are dropped
+	assert.True(t, len(d.keyElements) <= 2*defaultLargestElements)
+	d.currentEntry.Bytes = 10000
+	d.endElements()
+	d.currentEntry = &Entry{Key: "small", Type: "set"}
+	d.countElement([]byte("a"), 1)
+	d.endElements()
+
+	elems := d.GetLargestElements()
+	assert.Len(t, elems, defaultLargestElements+1)
+	assert.Equal(t, uint64(4*defaultLargestElements-1), elems[0].Len)
+	assert.Equal(t, uint64(3*defaultLargestElements), elems[len(elems)-2].Len)
+	assert.Equal(t, "big", elems[0].Key)
+	assert.Equal(t, 2, elems[0].DB)
+	for _, elem := range elems[:defaultLargestElements] {
+		assert.Equal(t, uint64(10000), elem.KeyBytes)
+	}
+	assert.Equal(t, strings.Repeat("x", maxElementField)+"...", elems[0].Field)
+	assert.Empty(t, d.keyElements)
+
+	// a small database keeps its own largest elements
+	assert.Equal(t, "small", elems[len(elems)-1].Key)
+	assert.Equal(t, 0, elems[len(elems)-1].DB)
+
+	d = NewDecoder()
+	d.KeepLargestElements(2 * defaultLargestElements)
+	d.currentEntry = &Entry{Key: "big", Type: "list"}
+	for i := 0; i < 4*defaultLargestElements; i++ {
+		d.countElement([]byte("x"), uint64(i))
+	}
+	d.endElements()
+	assert.Len(t, d.GetLargestElements(), 2*defaultLargestElements)
+}
+
+func compressedWith(t *testing.T, newWriter func(io.Writer) io.WriteCloser, v []byte) []byte {
+	var buf bytes.Buffer
+	w := newWriter(&buf)
+	_, err := w.Write(v)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestClassifyValue(t *testing.T) {
+	text := []byte(strings.Repeat("some text ", 10))
+	gz := compressedWith(t, func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) }, text)
+	zl := compressedWith(t, func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) }, text)
+	cases := []struct {
+		v     []byte
+		class string
+	}{
+		{[]byte(""), "empty"},
+		{[]byte("-42"), "int"},
+		{[]byte("3.14159"), "float"},
+		{[]byte("12345678901234567890123"), "float"},
+		{gz, "gzip"},
+		{zl, "zlib"},
+		{[]byte("\xff\x06\x00\x00sNaPpY\x01"), "snappy"},
+		{[]byte{0xac, 0xed, 0x00, 0x05, 0x73, 0x72}, "java"},
+		{[]byte{0x80, 0x04, 0x95, 0x4b, '.'}, "pickle"},
+		{[]byte{0x08, 0x96, 0x01, 0x12, 0x03, 'a', 'b', 'c'}, "protobuf"},
+		{[]byte{0x00, 0xff, 0xfe}, "binary"},
+		{[]byte(` {"id": 1, "tags": ["a", "b"]}`), "json"},
+		{[]byte(`{"id": 1`), "text"},
+		{[]byte(`a:1:{i:0;s:1:"a";}`), "php"},
+		{[]byte("<a>b</a>"), "xml"},
+		{[]byte("0123456789abcdef0123"), "hex"},
+		{[]byte("SGVsbG8gV29ybGQhIQ=="), "base64"},
+		{[]byte("abcdefghijklmnop"), "text"},
+		{[]byte("  "), "text"},
+		{text, "text"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.class, classifyValue(c.v), "%q", c.v)
+	}
+
+	assert.Equal(t, uint64(8), reformatted("float", []byte("3.14159265")))
+	assert.Equal(t, uint64(10), reformatted("hex", []byte("0123456789abcdef0123")))
+	assert.Equal(t, uint64(13), reformatted("base64", []byte("SGVsbG8gV29ybGQhIQ==")))
+	assert.Equal(t, uint64(7), reformatted("json", []byte(`{ "a": 1 }`)))
+	assert.Equal(t, uint64(4), reformatted("text", []byte("text")))
+}
+
+func TestInspectValues(t *testing.T) {
+	file := []byte("REDIS0011")
+	file = append(file, 0xFE, 0x00)
+	for _, kv := range [][2]string{
+		{"json", `{"id": 1, "name": "a name"}`},
+		{"int", "12345"},
+		{"text", "some text"},
+	} {
+		file = append(file, byte(rdb.TypeString))
+		file = append(file, rdbString([]byte(kv[0]))...)
+		file = append(file, rdbString([]byte(kv[1]))...)
+	}
+	file = append(file, byte(rdb.TypeHashListpack))
+	file = append(file, rdbString([]byte("hash"))...)
+	file = append(file, rdbString(listpack("f1", `{"a": 1}`, "f2", "7"))...)
+	file = append(file, byte(rdb.TypeSetListpack))
+	file = append(file, rdbString([]byte("set"))...)
+	file = append(file, rdbString(listpack("a", "bb"))...)
+	file = append(file, 0xFF)
+	file = append(file, make([]byte, 8)...)
+
+	decode := func(sample int) map[string]*Entry {
+		d := NewDecoder()
+		d.InspectValues(sample)
+		assert.NoError(t, rdb.Decode(bytes.NewReader(file), d))
+		entries := map[string]*Entry{}
+		for e := range d.Entries {
+			entries[e.Key] = e
+		}
+		return entries
+	}
+
+	entries := decode(1)
+	json := entries["json"].Content
+	if assert.NotNil(t, json) {
+		assert.Equal(t, uint64(1), json.Sample)
+		assert.Len(t, json.Classes, 1)
+		assert.Equal(t, uint64(1), json.Classes["json"].Num)
+		assert.Equal(t, uint64(27), json.Classes["json"].Len)
+		assert.Equal(t, uint64(24), json.Classes["json"].Reformatted)
+		assert.True(t, json.Classes["json"].Compressed <= json.Classes["json"].Len)
+	}
+	assert.Equal(t, uint64(1), entries["int"].Content.Classes["int"].Num)
+	hash := entries["hash"].Content
+	if assert.NotNil(t, hash) {
+		assert.Equal(t, uint64(1), hash.Classes["json"].Num)
+		assert.Equal(t, uint64(1), hash.Classes["int"].Num)
+	}
+	assert.Nil(t, entries["set"].Content)
+
+	// one in two of the strings and hashes, and the set
+	entries = decode(2)
+	assert.Nil(t, entries["json"].Content)
+	assert.Equal(t, uint64(2), entries["int"].Content.Sample)
+	assert.Nil(t, entries["text"].Content)
+	assert.NotNil(t, entries["hash"].Content)
+
+	assert.Nil(t, decodeEntries(t, file)["json"].Content)
+}
+
+func TestInspectorCompressed(t *testing.T) {
+	d := NewDecoder()
+	d.InspectValues(1)
+	repeated := bytes.Repeat([]byte("a"), 1000)
+	assert.True(t, d.inspect.compressed(repeated) < 50)
+
+	random := make([]byte, 1000)
+	rand.New(rand.NewSource(1)).Read(random)
+	assert.Equal(t, uint64(1000), d.inspect.compressed(random))
+
+	// values longer than maxInspectLen are extrapolated from their head
+	long := bytes.Repeat([]byte("abcdefgh"), maxInspectLen/4)
+	n := d.inspect.compressed(long)
+	assert.True(t, n > 2*d.inspect.compressed(long[:maxInspectLen])-2)
+	assert.True(t, n < uint64(len(long))/10)
+}
@@ -0,0 +1,153 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decoder
+
+import (
+	"container/heap"
+	"sort"
+)
+
+const (
+	// defaultLargestElements is the number of largest elements of the
+	// collections of each database kept by a decoder, unless it is set by
+	// KeepLargestElements
+	defaultLargestElements = 500
+	// maxElementField is the most bytes of a field, member or item kept
+	maxElementField = 64
+)
+
+// Element is an element of a collection: a field and its value of a hash,
+// a member of a set or sorted set, or an item of a list
+type Element struct {
+	Key  string
+	Type string
+	DB   int
+	// Field is the hash field, or the member or item, cut to 64 bytes
+	Field string
+	// Len is the length of the field and value of a hash, or of the member
+	// or item
+	Len uint64
+	// KeyBytes is the bytes of the key of the element
+	KeyBytes uint64
+	// index is that of the element in its heap, -1 once it is replaced
+	index int
+}
+
+// KeepLargestElements makes the decoder keep the num largest elements of the
+// collections of each database
+func (d *Decoder) KeepLargestElements(num int) {
+	if num < 1 {
+		num = 1
+	}
+	d.largestElements = num
+}
+
+// countElement keeps an element of the current collection if it is one of
+// the largest of its database
+func (d *Decoder) countElement(field []byte, l uint64) {
+	e := d.currentEntry
+	h, ok := d.elements[e.DB]
+	if !ok {
+		h = &elementHeap{}
+		d.elements[e.DB] = h
+	}
+	if len(*h) >= d.largestElements && l <= (*h)[0].Len {
+		return
+	}
+	if len(field) > maxElementField {
+		field = append(field[:maxElementField:maxElementField], "..."...)
+	}
+	elem := &Element{Key: e.Key, Type: e.Type, DB: e.DB, Field: string(field), Len: l}
+	if len(*h) < d.largestElements {
+		heap.Push(h, elem)
+	} else {
+		(*h)[0].index = -1
+		(*h)[0] = elem
+		elem.index = 0
+		heap.Fix(h, 0)
+	}
+	if len(d.keyElements) >= 2*d.largestElements {
+		d.keyElements = keptElements(d.keyElements)
+	}
+	d.keyElements = append(d.keyElements, elem)
+}
+
+// keptElements drops the elements replaced in their heap, so elements of a
+// collection growing steadily are not all kept until it ends
+func keptElements(elems []*Element) []*Element {
+	kept := elems[:0]
+	for _, elem := range elems {
+		if elem.index >= 0 {
+			kept = append(kept, elem)
+		}
+	}
+	for i := len(kept); i < len(elems); i++ {
+		elems[i] = nil
+	}
+	return kept
+}
+
+// endElements sets the bytes of the key of elements of the current
+// collection once it is known
+func (d *Decoder) endElements() {
+	for _, elem := range d.keyElements {
+		elem.KeyBytes = d.currentEntry.Bytes
+	}
+	d.keyElements = d.keyElements[:0]
+}
+
+// GetLargestElements get the largest elements of the collections of every
+// database, ordered by length. The largest of all are the first of them.
+func (d *Decoder) GetLargestElements() []*Element {
+	res := []*Element{}
+	for _, h := range d.elements {
+		res = append(res, *h...)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Len > res[j].Len
+	})
+	return res
+}
+
+type elementHeap []*Element
+
+func (h elementHeap) Len() int {
+	return len(h)
+}
+
+func (h elementHeap) Less(i, j int) bool {
+	return h[i].Len < h[j].Len
+}
+
+func (h elementHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *elementHeap) Push(x interface{}) {
+	elem := x.(*Element)
+	elem.index = len(*h)
+	*h = append(*h, elem)
+}
+
+func (h *elementHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	x.index = -1
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,152 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decoder
+
+import (
+	"math"
+	"strconv"
+)
+
+// EncodingSizes are the estimated bytes of a hash, sorted set or set in
+// every encoding it may be loaded in, whatever encoding it is saved in
+type EncodingSizes struct {
+	// Bytes of the key by encoding, as OBJECT ENCODING reports it. Sets of
+	// integers only have an intset size, and sets have a listpack size
+	// since 7.2 only.
+	Bytes map[string]uint64
+	// Longest is the length of the longest field, value or member
+	Longest uint64
+}
+
+// sizesEstimate accumulates the EncodingSizes of the current collection
+type sizesEstimate struct {
+	// top is the bytes of the key besides its value
+	top    uint64
+	packed uint64
+	table  uint64
+	// ints is set while every member of a set is an integer, of width
+	// bytes at most
+	ints    bool
+	width   uint64
+	members uint64
+	longest uint64
+}
+
+// EstimateEncodings makes the decoder estimate every hash, sorted set and
+// set in each encoding it may be loaded in, as Sizes of its entry
+func (d *Decoder) EstimateEncodings() {
+	d.estimate = true
+}
+
+func (d *Decoder) startSizes(key []byte, expiry int64, typ string) {
+	if !d.estimate {
+		return
+	}
+	d.sizes = &sizesEstimate{
+		top:   d.m.TopLevelObjOverhead(key, expiry),
+		ints:  typ == "set",
+		width: 2,
+	}
+}
+
+func (s *sizesEstimate) elem(elem []byte) {
+	if l := uint64(len(elem)); l > s.longest {
+		s.longest = l
+	}
+}
+
+func (d *Decoder) sizeHset(field, value []byte) {
+	s := d.sizes
+	if s == nil {
+		return
+	}
+	s.elem(field)
+	s.elem(value)
+	s.packed += d.m.PackedEntryOverhead(field) + d.m.PackedEntryOverhead(value)
+	s.table += d.m.SizeofString(field) + d.m.SizeofString(value) + d.m.HashtableEntryOverhead()
+	if d.rdbVer < 8 {
+		s.table += 2 * d.m.RobjOverhead()
+	}
+}
+
+func (d *Decoder) sizeZadd(score float64, member []byte) {
+	s := d.sizes
+	if s == nil {
+		return
+	}
+	s.elem(member)
+	s.packed += d.m.PackedEntryOverhead(member)
+	s.packed += d.m.PackedEntryOverhead([]byte(strconv.FormatFloat(score, 'g', 17, 64)))
+	s.table += 8 + d.m.SizeofString(member) + d.m.SkiplistEntryOverhead(s.members)
+	if d.rdbVer < 8 {
+		s.table += d.m.RobjOverhead()
+	}
+	s.members++
+}
+
+func (d *Decoder) sizeSadd(member []byte) {
+	s := d.sizes
+	if s == nil {
+		return
+	}
+	s.elem(member)
+	s.packed += d.m.ListpackEntryOverhead(member)
+	s.table += d.m.SizeofString(member) + d.m.SetEntryOverhead()
+	if d.rdbVer < 8 {
+		s.table += d.m.RobjOverhead()
+	}
+	if !s.ints {
+		return
+	}
+	n, err := strconv.ParseInt(string(member), 10, 64)
+	switch {
+	case err != nil:
+		s.ints = false
+	case n < math.MinInt32 || n > math.MaxInt32:
+		s.width = 8
+	case (n < math.MinInt16 || n > math.MaxInt16) && s.width < 4:
+		s.width = 4
+	}
+}
+
+// endSizes sets Sizes of the current entry of type typ
+func (d *Decoder) endSizes(typ string) {
+	s := d.sizes
+	if s == nil {
+		return
+	}
+	d.sizes = nil
+	e := d.currentEntry
+	n := e.NumOfElem
+	bytes := map[string]uint64{}
+	packed := d.m.Encoding(typ, "ziplist")
+	switch typ {
+	case "hash":
+		bytes[packed] = s.top + d.m.PackedSize(s.packed)
+		bytes["hashtable"] = s.top + d.m.HashtableOverhead(n) + s.table
+	case "sortedset":
+		bytes[packed] = s.top + d.m.PackedSize(s.packed)
+		bytes["skiplist"] = s.top + d.m.SkiplistOverhead(n) + s.table
+	case "set":
+		if d.m.Model().setNoValue {
+			bytes["listpack"] = s.top + d.m.PackedOverhead(d.m.ListpackHeaderOverhead()+s.packed)
+		}
+		if s.ints {
+			bytes["intset"] = s.top + d.m.IntsetOverhead(n, s.width)
+		}
+		bytes["hashtable"] = s.top + d.m.HashtableOverhead(n) + s.table
+	}
+	e.Sizes = &EncodingSizes{Bytes: bytes, Longest: s.longest}
+}
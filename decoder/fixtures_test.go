@@ -0,0 +1,226 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decoder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dongmx/rdb"
+	"github.com/stretchr/testify/assert"
+)
+
+// fixturesDir holds the rdb files of the tests of the rdb parser
+const fixturesDir = "../third_party/rdb/fixtures"
+
+// decodeFixture decodes a rdb of fixturesDir with the memory model of
+// version, or of the rdb if version is ""
+func decodeFixture(t *testing.T, name, version string) []*Entry {
+	return decodeFixtureWith(t, name, version, NewDecoder())
+}
+
+// decodeFixtureWith decodes a rdb of fixturesDir with d
+func decodeFixtureWith(t *testing.T, name, version string, d *Decoder) []*Entry {
+	f, err := os.Open(filepath.Join(fixturesDir, name+".rdb"))
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	defer f.Close()
+
+	if version != "" {
+		assert.NoError(t, d.SetRedisVersion(version))
+	}
+	errc := make(chan error, 1)
+	go func() {
+		err := rdb.Decode(f, d)
+		if err != nil {
+			close(d.Entries)
+		}
+		errc <- err
+	}()
+	entries := []*Entry{}
+	for e := range d.Entries {
+		entries = append(entries, e)
+	}
+	assert.NoError(t, <-errc, name)
+	return entries
+}
+
+// TestFixtureBytes pins estimated bytes of every encoding, a change of the
+// memory model shows up here
+func TestFixtureBytes(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		encoding string
+		version  string
+		keys     int
+		bytes    uint64
+	}{
+		{"easily_compressible_string_key", "string", "", 1, 312},
+		{"integer_keys", "int", "", 6, 544},
+		{"keys_with_expiry", "string", "", 1, 144},
+		{"ziplist_with_integers", "ziplist", "", 1, 126},
+		{"ziplist_that_compresses_easily", "ziplist", "", 1, 223},
+		{"rdb_v7_list_quicklist", "quicklist", "", 1, 151},
+		{"linkedlist", "linkedlist", "", 1, 104120},
+		{"zipmap_that_compresses_easily", "zipmap", "", 1, 120},
+		{"hash_as_ziplist", "ziplist", "", 1, 136},
+		{"dictionary", "hashtable", "", 1, 196456},
+		{"intset_16", "intset", "", 1, 80},
+		{"intset_64", "intset", "", 1, 96},
+		{"regular_set", "hashtable", "", 1, 592},
+		{"v9_set", "hashtable", "", 1, 256},
+		{"sorted_set_as_ziplist", "ziplist", "", 1, 232},
+		{"regular_sorted_set", "skiplist", "", 1, 84984},
+		{"v9_zset", "skiplist", "", 1, 400},
+		{"stream", "stream", "", 1, 1000},
+
+		// ziplists become listpacks and sets have no values since 7.x
+		{"integer_keys", "int", "7.2", 6, 496},
+		{"ziplist_with_integers", "ziplist", "7.2", 1, 142},
+		{"rdb_v7_list_quicklist", "quicklist", "7.2", 1, 150},
+		{"linkedlist", "linkedlist", "7.2", 1, 96112},
+		{"hash_as_ziplist", "ziplist", "7.2", 1, 119},
+		{"dictionary", "hashtable", "7.2", 1, 180408},
+		{"intset_16", "intset", "7.2", 1, 72},
+		{"regular_set", "hashtable", "7.2", 1, 448},
+		{"sorted_set_as_ziplist", "ziplist", "7.2", 1, 202},
+		{"regular_sorted_set", "skiplist", "7.2", 1, 80936},
+	} {
+		entries := decodeFixture(t, c.name, c.version)
+		total := uint64(0)
+		for _, e := range entries {
+			total += e.Bytes
+		}
+		assert.Len(t, entries, c.keys, "%s %s", c.name, c.encoding)
+		assert.Equal(t, c.bytes, total, "%s %s %s", c.name, c.encoding, c.version)
+	}
+}
+
+// TestFixtureEncodings checks the encodings values have once loaded by the
+// redis of the memory model
+func TestFixtureEncodings(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		version  string
+		typ      string
+		encoding string
+	}{
+		{"easily_compressible_string_key", "", "string", "embstr"},
+		{"integer_keys", "", "string", "embstr"},
+		{"ziplist_with_integers", "3.0", "list", "ziplist"},
+		{"ziplist_with_integers", "6.2", "list", "quicklist"},
+		{"rdb_v7_list_quicklist", "", "list", "quicklist"},
+		{"linkedlist", "3.0", "list", "linkedlist"},
+		{"linkedlist", "7.2", "list", "quicklist"},
+		{"zipmap_that_compresses_easily", "6.2", "hash", "ziplist"},
+		{"hash_as_ziplist", "6.2", "hash", "ziplist"},
+		{"hash_as_ziplist", "7.0", "hash", "listpack"},
+		{"dictionary", "", "hash", "hashtable"},
+		{"intset_16", "", "set", "intset"},
+		{"regular_set", "", "set", "hashtable"},
+		{"sorted_set_as_ziplist", "6.2", "sortedset", "ziplist"},
+		{"sorted_set_as_ziplist", "7.2", "sortedset", "listpack"},
+		{"regular_sorted_set", "", "sortedset", "skiplist"},
+		{"stream", "", "stream", "stream"},
+	} {
+		entries := decodeFixture(t, c.name, c.version)
+		if assert.NotEmpty(t, entries, c.name) {
+			assert.Equal(t, c.typ, entries[0].Type, "%s %s", c.name, c.version)
+			assert.Equal(t, c.encoding, entries[0].Encoding, "%s %s", c.name, c.version)
+		}
+	}
+}
+
+func TestSkiplistDeterministic(t *testing.T) {
+	first := decodeFixture(t, "regular_sorted_set", "")
+	second := decodeFixture(t, "regular_sorted_set", "")
+	assert.Equal(t, first[0].Bytes, second[0].Bytes)
+
+	// levels follow the expected distribution for p = 1/4
+	levels := map[uint64]int{}
+	for n := uint64(0); n < 1024; n++ {
+		levels[zsetLevel(n)]++
+	}
+	assert.Equal(t, map[uint64]int{1: 768, 2: 192, 3: 48, 4: 12, 5: 3, 6: 1}, levels)
+}
+
+// TestFixtureEncodingSizes checks collections are estimated in the encoding
+// they are loaded in as they are without EstimateEncodings
+func TestFixtureEncodingSizes(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		version   string
+		encodings []string
+	}{
+		{"dictionary", "6.2", []string{"ziplist", "hashtable"}},
+		{"hash_as_ziplist", "7.2", []string{"listpack", "hashtable"}},
+		{"regular_sorted_set", "7.2", []string{"listpack", "skiplist"}},
+		{"sorted_set_as_ziplist", "6.2", []string{"ziplist", "skiplist"}},
+		{"intset_16", "6.2", []string{"intset", "hashtable"}},
+		{"intset_64", "7.2", []string{"intset", "listpack", "hashtable"}},
+		{"regular_set", "7.2", []string{"listpack", "hashtable"}},
+		{"regular_set", "6.2", []string{"hashtable"}},
+	} {
+		d := NewDecoder()
+		d.EstimateEncodings()
+		entries := decodeFixtureWith(t, c.name, c.version, d)
+		if !assert.Len(t, entries, 1, c.name) {
+			continue
+		}
+		e := entries[0]
+		if !assert.NotNil(t, e.Sizes, c.name) {
+			continue
+		}
+		encodings := []string{}
+		for enc := range e.Sizes.Bytes {
+			encodings = append(encodings, enc)
+		}
+		assert.ElementsMatch(t, c.encodings, encodings, c.name)
+		assert.True(t, e.Sizes.Longest > 0, c.name)
+
+		bytes := e.Sizes.Bytes[e.Encoding]
+		switch e.Encoding {
+		case "ziplist", "listpack":
+			// packed bytes are rounded to the allocation
+			assert.True(t, bytes >= e.Bytes && bytes <= e.Bytes*5/4, "%s: %d of %d", c.name, bytes, e.Bytes)
+		default:
+			assert.Equal(t, e.Bytes, bytes, c.name)
+		}
+	}
+
+	// strings and lists have no other encodings
+	d := NewDecoder()
+	d.EstimateEncodings()
+	for _, e := range decodeFixtureWith(t, "rdb_v7_list_quicklist", "", d) {
+		assert.Nil(t, e.Sizes)
+	}
+}
+
+func TestFixtureLargestElements(t *testing.T) {
+	d := NewDecoder()
+	entries := decodeFixtureWith(t, "zipmap_with_big_values", "", d)
+	elems := d.GetLargestElements()
+	assert.NotEmpty(t, elems)
+	for i, elem := range elems {
+		assert.Equal(t, entries[0].Key, elem.Key)
+		assert.Equal(t, entries[0].Bytes, elem.KeyBytes)
+		if i > 0 {
+			assert.True(t, elem.Len <= elems[i-1].Len)
+		}
+	}
+	assert.Equal(t, entries[0].NumOfElem, uint64(len(elems)))
+}
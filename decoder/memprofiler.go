@@ -15,9 +15,11 @@
 package decoder
 
 import (
-	"math/rand"
+	"fmt"
+	"math/bits"
 	"sort"
 	"strconv"
+	"strings"
 )
 
 var (
@@ -58,8 +60,97 @@ var (
 	}
 )
 
+// MemModel is the memory layout of data structures in a redis version
+type MemModel struct {
+	// Version is the redis version of the model, e.g. "7.2"
+	Version string
+	// sdsHeader8 uses the sdshdr of redis before 3.2, 8 bytes for any length
+	sdsHeader8 bool
+	// embstrLimit is the max length of a string allocated with its robj
+	embstrLimit int
+	// dictSize is sizeof(dict), without the table
+	dictSize uint64
+	// listpack replaces ziplist in redis 7.0, ziplists loaded from a rdb are
+	// converted to listpacks
+	listpack bool
+	// setNoValue uses dict entries without the value for sets since 7.2
+	setNoValue bool
+}
+
+var memModels = []*MemModel{
+	{Version: "3.0", sdsHeader8: true, embstrLimit: 39, dictSize: 96},
+	{Version: "4", embstrLimit: 44, dictSize: 96},
+	{Version: "5", embstrLimit: 44, dictSize: 96},
+	{Version: "6", embstrLimit: 44, dictSize: 96},
+	{Version: "7.0", embstrLimit: 44, dictSize: 56, listpack: true},
+	{Version: "7.2", embstrLimit: 44, dictSize: 56, listpack: true, setNoValue: true},
+}
+
+func memModel(version string) *MemModel {
+	for _, m := range memModels {
+		if m.Version == version {
+			return m
+		}
+	}
+	return memModels[len(memModels)-1]
+}
+
+// MemModelFor get the memory model of a redis version like "6.2.6" or "7"
+func MemModelFor(version string) (*MemModel, error) {
+	parts := strings.SplitN(version, ".", 3)
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis version %q", version)
+	}
+	minor := 0
+	if len(parts) > 1 {
+		if minor, err = strconv.Atoi(parts[1]); err != nil {
+			return nil, fmt.Errorf("invalid redis version %q", version)
+		}
+	}
+	switch {
+	case major < 3 || major == 3 && minor < 2:
+		return memModel("3.0"), nil
+	case major <= 4:
+		return memModel("4"), nil
+	case major == 5:
+		return memModel("5"), nil
+	case major == 6:
+		return memModel("6"), nil
+	case major == 7 && minor < 2:
+		return memModel("7.0"), nil
+	}
+	return memModel("7.2"), nil
+}
+
+// memModelForRDB get the memory model of the oldest redis saving rdb of
+// version ver, for a rdb without the redis-ver aux field
+func memModelForRDB(ver int) *MemModel {
+	switch {
+	case ver <= 6:
+		return memModel("3.0")
+	case ver <= 8:
+		return memModel("4")
+	case ver == 9:
+		return memModel("5")
+	case ver == 10:
+		return memModel("7.0")
+	}
+	return memModel("7.2")
+}
+
 // MemProfiler get memory use for all kinds of data stuct
-type MemProfiler struct{}
+type MemProfiler struct {
+	model *MemModel
+}
+
+// Model get the memory model in use, the latest if none is set
+func (m *MemProfiler) Model() *MemModel {
+	if m.model == nil {
+		return memModels[len(memModels)-1]
+	}
+	return m.model
+}
 
 // mallocOverhead used memory
 func (m *MemProfiler) mallocOverhead(size uint64) uint64 {
@@ -75,13 +166,14 @@ func (m *MemProfiler) mallocOverhead(size uint64) uint64 {
 // Each top level object is an entry in a dictionary, and so we have to include
 // the overhead of a dictionary entry
 func (m *MemProfiler) TopLevelObjOverhead(key []byte, expiry int64) uint64 {
-	return m.HashtableEntryOverhead() + m.SizeofString(key) + m.RobjOverhead() + m.KeyExpiryOverhead(expiry)
+	return m.HashtableEntryOverhead() + m.SizeofSds(key) + m.RobjOverhead() + m.KeyExpiryOverhead(expiry)
 }
 
 // HashtableOverhead get memory use of a hashtable
 // See  https://github.com/antirez/redis/blob/unstable/src/dict.h
-// See the structures dict and dictht
-// 2 * (3 unsigned longs + 1 pointer) + int + long + 2 pointers
+// Before 7.0 the dict has 2 dictht of 3 unsigned longs + 1 pointer each,
+// besides 2 pointers, a long and an iterator count, 96 bytes. Since 7.0 the
+// tables are kept in the dict itself, 56 bytes.
 //
 // Additionally, see **table in dictht
 // The length of the table is the next power of 2
@@ -90,7 +182,7 @@ func (m *MemProfiler) TopLevelObjOverhead(key []byte, expiry int64) uint64 {
 // case in which both tables are allocated, and so multiply
 // the size of **table by 1.5
 func (m *MemProfiler) HashtableOverhead(size uint64) uint64 {
-	return 4 + 7*longSize + 4*pointerSize + nextPower(size)*pointerSize*3/2
+	return m.Model().dictSize + nextPower(size)*pointerSize*3/2
 }
 
 func (m *MemProfiler) SizeofStreamRadixTree(numElements uint64) uint64 {
@@ -132,6 +224,54 @@ func (m *MemProfiler) HashtableEntryOverhead() uint64 {
 	return 3 * pointerSize
 }
 
+// SetEntryOverhead get memory use of a dict entry of a set, since 7.2 the
+// entry has no value
+func (m *MemProfiler) SetEntryOverhead() uint64 {
+	if m.Model().setNoValue {
+		return 2 * pointerSize
+	}
+	return m.HashtableEntryOverhead()
+}
+
+// PackedOverhead get memory use of a ziplist, listpack, intset or zipmap of
+// size bytes
+func (m *MemProfiler) PackedOverhead(size uint64) uint64 {
+	return m.mallocOverhead(size)
+}
+
+// PackedSize get memory use of a listpack of entries bytes of entries, or
+// of a ziplist before 7.0
+func (m *MemProfiler) PackedSize(entries uint64) uint64 {
+	if m.Model().listpack {
+		return m.PackedOverhead(m.ListpackHeaderOverhead() + entries)
+	}
+	return m.PackedOverhead(m.ZiplistHeaderOverhead() + entries)
+}
+
+// PackedEntryOverhead get memory use of an entry of a listpack, or of a
+// ziplist before 7.0
+func (m *MemProfiler) PackedEntryOverhead(value []byte) uint64 {
+	if m.Model().listpack {
+		return m.ListpackEntryOverhead(value)
+	}
+	return m.ZiplistEntryOverhead(value)
+}
+
+// IntsetOverhead get memory use of an intset of n integers of width bytes
+// each, after a header of encoding and length
+func (m *MemProfiler) IntsetOverhead(n, width uint64) uint64 {
+	return m.PackedOverhead(4 + 4 + n*width)
+}
+
+// ConvertsToListpack reports whether values of the encoding are converted
+// to listpacks when the rdb is loaded
+func (m *MemProfiler) ConvertsToListpack(encoding string) bool {
+	if !m.Model().listpack {
+		return false
+	}
+	return encoding == "ziplist" || encoding == "zipmap" || encoding == "quicklist"
+}
+
 // LinkedlistOverhead get memory use of a linked list
 // See https://github.com/antirez/redis/blob/unstable/src/adlist.h
 // A list has 5 pointers + an unsigned long
@@ -151,9 +291,10 @@ func (m *MemProfiler) SkiplistOverhead(size uint64) uint64 {
 	return 2*pointerSize + m.HashtableOverhead(size) + (2*pointerSize + 16)
 }
 
-// SkiplistEntryOverhead get memory use of a skiplist entry
-func (m *MemProfiler) SkiplistEntryOverhead() uint64 {
-	return m.HashtableEntryOverhead() + 2*pointerSize + 8 + (pointerSize+8)*zsetRandLevel()
+// SkiplistEntryOverhead get memory use of the n-th entry of a skiplist,
+// counting from 0
+func (m *MemProfiler) SkiplistEntryOverhead(n uint64) uint64 {
+	return m.HashtableEntryOverhead() + 2*pointerSize + 8 + (pointerSize+8)*zsetLevel(n)
 }
 
 func (m *MemProfiler) QuicklistOverhead(size uint64) uint64 {
@@ -199,6 +340,60 @@ func (m *MemProfiler) ZiplistEntryOverhead(value []byte) uint64 {
 	return uint64(header + size)
 }
 
+// ListpackHeaderOverhead get memory use of a listpack header
+// See https://github.com/redis/redis/blob/unstable/src/listpack.c
+// 4 bytes total bytes + 2 bytes number of elements + 1 byte end mark
+func (m *MemProfiler) ListpackHeaderOverhead() uint64 {
+	return 4 + 2 + 1
+}
+
+// ListpackEntryOverhead get memory use of a listpack entry
+// An entry is encoding + data + backlen, the backlen stores the size of
+// encoding + data in 1 to 5 bytes
+func (m *MemProfiler) ListpackEntryOverhead(value []byte) uint64 {
+	size := 0
+
+	if n, err := strconv.ParseInt(string(value), 10, 64); err == nil {
+		switch {
+		case n >= 0 && n <= 127:
+			size = 1
+		case n >= -4096 && n <= 4095:
+			size = 2
+		case n >= -32768 && n <= 32767:
+			size = 3
+		case n >= -8388608 && n <= 8388607:
+			size = 4
+		case n >= -2147483648 && n <= 2147483647:
+			size = 5
+		default:
+			size = 9
+		}
+	} else {
+		l := len(value)
+		if l < 64 {
+			size = 1 + l
+		} else if l < 4096 {
+			size = 2 + l
+		} else {
+			size = 5 + l
+		}
+	}
+
+	backlen := 5
+	switch {
+	case size <= 127:
+		backlen = 1
+	case size < 16383:
+		backlen = 2
+	case size < 2097151:
+		backlen = 3
+	case size < 268435455:
+		backlen = 4
+	}
+
+	return uint64(size + backlen)
+}
+
 // KeyExpiryOverhead get memory useage of a key expiry
 // Key expiry is stored in a hashtable, so we have to pay for the cost of a hashtable entry
 // The timestamp itself is stored as an int64, which is a 8 bytes
@@ -218,10 +413,36 @@ func (m *MemProfiler) KeyExpiryOverhead(expiry int64) uint64 {
 //     int refcount;
 //     void *ptr;
 // } robj;
+// type, encoding and lru share 4 bytes
 const LRU_BITS = 24
 
 func (m *MemProfiler) RobjOverhead() uint64 {
-	return pointerSize + 4 + 4 + LRU_BITS + 4
+	return (4+4+LRU_BITS)/8 + 4 + pointerSize
+}
+
+// sdsHeader get the size of the sds header of a string of length l, the
+// smallest of sdshdr5/8/16/32/64 it fits in since 3.2
+func (m *MemProfiler) sdsHeader(l uint64) uint64 {
+	switch {
+	case m.Model().sdsHeader8:
+		return 8
+	case l < 1<<5:
+		return 1
+	case l < 1<<8:
+		return 3
+	case l < 1<<16:
+		return 5
+	case l < 1<<32:
+		return 9
+	}
+	return 17
+}
+
+// SizeofSds get memory use of a sds, e.g. a key
+// https://github.com/antirez/redis/blob/unstable/src/sds.h
+func (m *MemProfiler) SizeofSds(bytes []byte) uint64 {
+	l := uint64(len(bytes))
+	return m.mallocOverhead(m.sdsHeader(l) + l + 1)
 }
 
 // SizeofString get memory use of a string
@@ -235,8 +456,66 @@ func (m *MemProfiler) SizeofString(bytes []byte) uint64 {
 		}
 		return 8
 	}
-	l := uint64(len(str))
-	return m.mallocOverhead(l + 8 + 1)
+	return m.SizeofSds(bytes)
+}
+
+// StringEncoding get the encoding of a string value: an integer is kept in
+// the robj, and a string up to the embstr limit is allocated with it
+func (m *MemProfiler) StringEncoding(bytes []byte) string {
+	l := len(bytes)
+	if l <= 20 {
+		if _, err := strconv.ParseInt(string(bytes), 10, 64); err == nil {
+			return "int"
+		}
+	}
+	if l <= m.Model().embstrLimit {
+		return "embstr"
+	}
+	return "raw"
+}
+
+// SizeofStringValue get memory use of the value of a string key besides its
+// robj. An embstr is allocated with the robj and a sdshdr8.
+func (m *MemProfiler) SizeofStringValue(bytes []byte) uint64 {
+	switch m.StringEncoding(bytes) {
+	case "int":
+		return 0
+	case "embstr":
+		header := uint64(3)
+		if m.Model().sdsHeader8 {
+			header = 8
+		}
+		l := uint64(len(bytes))
+		return m.mallocOverhead(m.RobjOverhead()+header+l+1) - m.RobjOverhead()
+	}
+	return m.SizeofSds(bytes)
+}
+
+// Encoding get the encoding of a collection of type typ saved with encoding
+// enc once the rdb is loaded, as OBJECT ENCODING reports it. Lists are
+// quicklists since redis 3.2, and ziplists are listpacks since 7.0.
+func (m *MemProfiler) Encoding(typ, enc string) string {
+	switch enc {
+	case "zipmap", "ziplist":
+		if typ == "list" {
+			if m.Model().sdsHeader8 {
+				return "ziplist"
+			}
+			return "quicklist"
+		}
+		if m.Model().listpack {
+			return "listpack"
+		}
+		return "ziplist"
+	case "quicklist2":
+		return "quicklist"
+	case "linkedlist":
+		if m.Model().sdsHeader8 {
+			return "linkedlist"
+		}
+		return "quicklist"
+	}
+	return enc
 }
 
 // ElemLen get length of a element
@@ -260,13 +539,13 @@ func nextPower(size uint64) uint64 {
 	return power
 }
 
-func zsetRandLevel() uint64 {
-	level := 1
-	rint := rand.Intn(0xFFFF)
-	for rint < int(0xFFFF*1/4) { //skiplistP
-		level++
-		rint = rand.Intn(0xFFFF)
-	}
+// zsetLevel get the level of the n-th node of a skiplist. Redis gives a
+// node a random level, above l with probability skiplistP^l. Instead one in
+// 4 nodes is given level 2 or above, one in 16 level 3 or above and so on,
+// so a sorted set is estimated at the expected size, the same in every run.
+func zsetLevel(n uint64) uint64 {
+	// n+1 is a multiple of 4^(level-1)
+	level := 1 + bits.TrailingZeros64(n+1)/2
 	if level < skiplistMaxLevel {
 		return uint64(level)
 	}
@@ -0,0 +1,140 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dongmx/rdb"
+	"github.com/xueqiu/rdr/aof"
+)
+
+// isAOF checks whether path is an appendonlydir, a manifest of it or an
+// aof file, which may start with a rdb preamble
+func isAOF(path string) bool {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	if fi.IsDir() {
+		_, err := aof.FindManifest(path)
+		return err == nil
+	}
+
+	name := path
+	if ext := filepath.Ext(name); ext != ".aof" && isRDBFile(name) && ext != ".rdb" {
+		// compressed file, like appendonly.aof.gz
+		name = strings.TrimSuffix(name, ext)
+	}
+	switch filepath.Ext(name) {
+	case ".aof", ".manifest":
+		return true
+	case ".rdb":
+		return false
+	}
+
+	f, err := openRDB(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	b := make([]byte, 1)
+	if _, err := f.Read(b); err != nil {
+		return false
+	}
+	return b[0] == '*'
+}
+
+// decodeAOF replays an aof file or a multi part aof and calls decode hooks
+// of d for keys of the resulting keyspace, bytes read are counted into m
+// which may be nil. Replaying stops when ctx is done.
+func decodeAOF(ctx context.Context, path string, d rdb.Decoder, m *meter) error {
+	r := aof.NewReplayerContext(ctx)
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	manifest := ""
+	if fi.IsDir() {
+		if manifest, err = aof.FindManifest(path); err != nil {
+			return err
+		}
+	} else if strings.HasSuffix(path, ".manifest") {
+		manifest = path
+	}
+
+	if manifest == "" {
+		err = loadAOFFile(r, path, false, m)
+	} else {
+		err = loadManifest(r, manifest, m)
+	}
+	if err != nil {
+		return err
+	}
+
+	if r.Truncated() {
+		fmt.Fprintf(os.Stderr, "aof: the last command of %s is truncated and ignored\n", path)
+	}
+	if skipped := r.Skipped(); len(skipped) > 0 {
+		names := []string{}
+		for name, n := range skipped {
+			names = append(names, fmt.Sprintf("%s(%d)", name, n))
+		}
+		sort.Strings(names)
+		fmt.Fprintf(os.Stderr, "aof: skipped unsupported commands: %s\n", strings.Join(names, " "))
+	}
+
+	r.Emit(d)
+	return nil
+}
+
+func loadManifest(r *aof.Replayer, manifest string, m *meter) error {
+	f, err := os.Open(manifest)
+	if err != nil {
+		return err
+	}
+	files, err := aof.ReadManifest(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(manifest)
+	for _, file := range files {
+		isRDB := file.Type == aof.FileTypeBase && strings.HasSuffix(file.Name, ".rdb")
+		if err := loadAOFFile(r, filepath.Join(dir, file.Name), isRDB, m); err != nil {
+			return fmt.Errorf("%s: %v", file.Name, err)
+		}
+	}
+	return nil
+}
+
+func loadAOFFile(r *aof.Replayer, path string, isRDB bool, m *meter) error {
+	f, err := openMeteredRDB(path, m)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if isRDB {
+		return r.LoadRDB(f)
+	}
+	return r.Load(f)
+}
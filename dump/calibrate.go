@@ -0,0 +1,131 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"sort"
+)
+
+// Calibration compares the memory estimated from a rdb with the used-mem
+// aux field saved by redis 4.0 and later, which is used_memory of INFO
+// memory when the rdb was saved
+type Calibration struct {
+	UsedMem uint64
+	// Estimated is the total bytes of every key
+	Estimated uint64
+	// DictTables is the estimated memory of the main and expires dicts of
+	// databases, which is in no key
+	DictTables uint64
+	// Unaccounted is used-mem not in Estimated or DictTables: fragmentation
+	// of keys, replication backlog, client buffers, lua scripts and such. It
+	// is negative if keys are overestimated.
+	Unaccounted int64
+	// Ratio is Estimated / UsedMem
+	Ratio float64
+	// Factor scales estimated bytes to add up to used-mem, it is 1 if it is
+	// not applied
+	Factor float64
+	// Types are the bytes and the share of used-mem of every type, ordered
+	// by bytes
+	Types []*TypeCalibration
+}
+
+// TypeCalibration is the estimated bytes of a type against used-mem
+type TypeCalibration struct {
+	Type  string
+	Bytes uint64
+	// Ratio is Bytes / UsedMem
+	Ratio float64
+}
+
+// calibrate compares bytes counted by c with usedMem, nil if usedMem is
+// not known
+func calibrate(c *Counter, usedMem, dictTables uint64) *Calibration {
+	if usedMem == 0 {
+		return nil
+	}
+	cal := &Calibration{
+		UsedMem:    usedMem,
+		DictTables: dictTables,
+		Factor:     1,
+	}
+	for t, bytes := range c.typeBytes {
+		cal.Estimated += bytes
+		cal.Types = append(cal.Types, &TypeCalibration{
+			Type:  t,
+			Bytes: bytes,
+			Ratio: float64(bytes) / float64(usedMem),
+		})
+	}
+	sort.Slice(cal.Types, func(i, j int) bool {
+		if cal.Types[i].Bytes == cal.Types[j].Bytes {
+			return cal.Types[i].Type < cal.Types[j].Type
+		}
+		return cal.Types[i].Bytes > cal.Types[j].Bytes
+	})
+	cal.Ratio = float64(cal.Estimated) / float64(usedMem)
+	cal.Unaccounted = int64(usedMem) - int64(cal.Estimated) - int64(dictTables)
+	return cal
+}
+
+// correctionFactor get the factor scaling estimated bytes to add up to
+// used-mem
+func (cal *Calibration) correctionFactor() float64 {
+	if cal.Estimated == 0 {
+		return 1
+	}
+	return float64(cal.UsedMem) / float64(cal.Estimated)
+}
+
+// scaleBytes multiplies the bytes of types, key prefixes, key templates and
+// every distribution of c and its database counters by factor. The largest
+// keys and size quantiles keep their estimated bytes.
+func (c *Counter) scaleBytes(factor float64) {
+	scale := func(n uint64) uint64 {
+		return uint64(float64(n)*factor + 0.5)
+	}
+	for _, m := range []map[typeKey]uint64{
+		c.lengthLevelBytes, c.encodingBytes, c.ttlBytes, c.idleBytes, c.freqBytes,
+	} {
+		for k, v := range m {
+			m[k] = scale(v)
+		}
+	}
+	for k, v := range c.typeBytes {
+		c.typeBytes[k] = scale(v)
+	}
+	for k, v := range c.slotBytes {
+		c.slotBytes[k] = scale(v)
+	}
+	// scaling every prefix alike keeps the order of the heap
+	for _, e := range *c.largestKeyPrefixes {
+		e.Bytes = scale(e.Bytes)
+		e.ColdBytes = scale(e.ColdBytes)
+		e.Err = scale(e.Err)
+	}
+	c.prefixErrorBound = scale(c.prefixErrorBound)
+	c.skewedBytes = scale(c.skewedBytes)
+	c.coldBytes = scale(c.coldBytes)
+	for _, nt := range c.nearThresholds {
+		nt.Bytes = scale(nt.Bytes)
+	}
+	for _, t := range c.keyTemplates {
+		t.Bytes = scale(t.Bytes)
+	}
+	sortKeyTemplates(c.keyTemplates)
+	for _, dc := range c.dbs {
+		dc.scaleBytes(factor)
+	}
+}
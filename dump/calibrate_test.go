@@ -0,0 +1,55 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func TestCalibrate(t *testing.T) {
+	c := NewCounter(nil)
+	in := make(chan *decoder.Entry, 3)
+	in <- &decoder.Entry{Key: "user:1", Type: "string", Bytes: 100, Idle: -1, Freq: -1}
+	in <- &decoder.Entry{Key: "user:2", Type: "string", Bytes: 100, Idle: -1, Freq: -1, DB: 1}
+	in <- &decoder.Entry{Key: "order:1", Type: "hash", Bytes: 600, NumOfElem: 10, Idle: -1, Freq: -1}
+	close(in)
+	c.Count(in)
+
+	assert.Nil(t, calibrate(c, 0, 100))
+
+	cal := calibrate(c, 1000, 100)
+	assert.Equal(t, uint64(800), cal.Estimated)
+	assert.Equal(t, int64(100), cal.Unaccounted)
+	assert.Equal(t, 0.8, cal.Ratio)
+	assert.Equal(t, 1.0, cal.Factor)
+	assert.Equal(t, []*TypeCalibration{
+		{Type: "hash", Bytes: 600, Ratio: 0.6},
+		{Type: "string", Bytes: 200, Ratio: 0.2},
+	}, cal.Types)
+
+	c.scaleBytes(cal.correctionFactor())
+	assert.Equal(t, map[string]uint64{"hash": 750, "string": 250}, c.typeBytes)
+	prefixes := map[string]uint64{}
+	for _, e := range c.GetLargestKeyPrefixes() {
+		prefixes[e.Key] = e.Bytes
+	}
+	assert.Equal(t, map[string]uint64{"user": 250, "order": 750}, prefixes)
+	assert.Equal(t, uint64(125), c.selectDB(1).typeBytes["string"])
+	// the largest keys keep their estimates
+	assert.Equal(t, uint64(600), c.GetLargestEntries(1)[0].Bytes)
+}
@@ -0,0 +1,129 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
+)
+
+var (
+	gzipMagic  = []byte{0x1f, 0x8b}
+	zstdMagic  = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	lz4Magic   = []byte{0x04, 0x22, 0x4d, 0x18}
+	bzip2Magic = []byte("BZh")
+)
+
+// rdbExtensions are file extensions accepted as rdb or aof files, compressed
+// files are detected by magic bytes rather than by extension
+var rdbExtensions = []string{".rdb", ".aof", ".gz", ".zst", ".zstd", ".lz4", ".bz2"}
+
+// isRDBFile checks whether the file name looks like a rdb or aof file or a
+// compressed one, like dump.rdb.gz
+func isRDBFile(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, e := range rdbExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+type multiCloser struct {
+	io.Reader
+	closers []func() error
+}
+
+func (m *multiCloser) Close() error {
+	var err error
+	for _, c := range m.closers {
+		if e := c(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// decompress detects the compression format of r by magic bytes and
+// returns a streaming reader of the decompressed rdb, plain rdb is returned
+// as is.
+func decompress(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(4)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return gr, nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return &multiCloser{
+			Reader:  zr,
+			closers: []func() error{func() error { zr.Close(); return nil }},
+		}, nil
+	case bytes.HasPrefix(magic, lz4Magic):
+		return &multiCloser{Reader: lz4.NewReader(br)}, nil
+	case bytes.HasPrefix(magic, bzip2Magic):
+		return &multiCloser{Reader: bzip2.NewReader(br)}, nil
+	}
+	return &multiCloser{Reader: br}, nil
+}
+
+// openRDB opens a rdb file which may be compressed by gzip, zstd, lz4 or
+// bzip2, closing the returned reader closes the file as well.
+func openRDB(path string) (io.ReadCloser, error) {
+	return openMeteredRDB(path, nil)
+}
+
+// openMeteredRDB is openRDB counting bytes read from the file into m, m may
+// be nil
+func openMeteredRDB(path string, m *meter) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	var fr io.Reader = f
+	if m != nil {
+		fr = m.reader(f)
+	}
+	r, err := decompress(fr)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &multiCloser{
+		Reader:  r,
+		closers: []func() error{r.Close, f.Close},
+	}, nil
+}
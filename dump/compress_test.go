@@ -0,0 +1,70 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecompress(t *testing.T) {
+	rdb := []byte("REDIS0009\xff")
+
+	compressors := map[string]func(w io.Writer) io.WriteCloser{
+		"plain": func(w io.Writer) io.WriteCloser { return nopWriteCloser{w} },
+		"gzip":  func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) },
+		"zstd": func(w io.Writer) io.WriteCloser {
+			zw, _ := zstd.NewWriter(w)
+			return zw
+		},
+		"lz4": func(w io.Writer) io.WriteCloser { return lz4.NewWriter(w) },
+	}
+	for name, compressor := range compressors {
+		buf := &bytes.Buffer{}
+		w := compressor(buf)
+		w.Write(rdb)
+		w.Close()
+
+		r, err := decompress(buf)
+		assert.NoError(t, err, name)
+		content, err := ioutil.ReadAll(r)
+		assert.NoError(t, err, name)
+		assert.Equal(t, rdb, content, name)
+		assert.NoError(t, r.Close(), name)
+	}
+}
+
+func TestIsRDBFile(t *testing.T) {
+	assert.True(t, isRDBFile("dump.rdb"))
+	assert.True(t, isRDBFile("dump.rdb.gz"))
+	assert.True(t, isRDBFile("dump.rdb.ZST"))
+	assert.True(t, isRDBFile("backup-20200101.lz4"))
+	assert.True(t, isRDBFile("appendonly.aof"))
+	assert.False(t, isRDBFile("dump.txt"))
+	assert.False(t, isRDBFile("dump"))
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
@@ -0,0 +1,135 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"sort"
+
+	"github.com/xueqiu/rdr/decoder"
+)
+
+// ContentEntry counts the inspected values of a class in keys of a type, or
+// the inspected values of keys of a key prefix
+type ContentEntry struct {
+	Type string
+	// Key is the key prefix, empty for a class of values of all keys
+	Key string `json:",omitempty"`
+	// Class is that of the values, for a key prefix the one of the most
+	// bytes
+	Class string
+	// Keys are the keys inspected
+	Keys uint64
+	decoder.ContentCount
+	// CompressSaving and ReformatSaving are the bytes estimated to be saved
+	// in all keys the inspected ones are sampled from, by compressing values
+	// with flate or by storing them in a denser format
+	CompressSaving uint64
+	ReformatSaving uint64
+	// classLen is the length of values by class of a key prefix
+	classLen map[string]uint64
+}
+
+func (ce *ContentEntry) add(class string, cc *decoder.ContentCount, sample uint64) {
+	ce.ContentCount.Add(cc)
+	ce.CompressSaving += (cc.Len - cc.Compressed) * sample
+	if cc.Reformatted < cc.Len {
+		ce.ReformatSaving += (cc.Len - cc.Reformatted) * sample
+	}
+	if ce.classLen != nil {
+		ce.classLen[class] += cc.Len
+	}
+}
+
+// countByContent counts the values inspected of e by class, and by the key
+// prefixes of e kept by the prefix sketch, so they take no more memory than
+// the prefixes themselves. It is called after e is counted by key prefix.
+func (c *Counter) countByContent(e *decoder.Entry) {
+	if e.Content == nil {
+		return
+	}
+	prefixes := []*ContentEntry{}
+	key := typeKey{Type: e.Type}
+	for _, prefix := range c.keyPrefixes(e.Key) {
+		if len(prefix) == 0 {
+			continue
+		}
+		key.Key = prefix
+		p := c.prefixSketch.content(key)
+		if p == nil {
+			continue
+		}
+		p.Keys++
+		prefixes = append(prefixes, p)
+	}
+	for class, cc := range e.Content.Classes {
+		key := typeKey{Type: e.Type, Key: class}
+		ce, ok := c.contentClasses[key]
+		if !ok {
+			ce = &ContentEntry{Type: e.Type, Class: class}
+			c.contentClasses[key] = ce
+		}
+		ce.Keys++
+		ce.add(class, cc, e.Content.Sample)
+		for _, p := range prefixes {
+			p.add(class, cc, e.Content.Sample)
+		}
+	}
+}
+
+// GetContentClasses get inspected values of every type by class, ordered by
+// the bytes compression saves
+func (c *Counter) GetContentClasses() []*ContentEntry {
+	res := []*ContentEntry{}
+	for _, ce := range c.contentClasses {
+		res = append(res, ce)
+	}
+	sortContentEntries(res)
+	return res
+}
+
+// GetContentPrefixes get the num key prefixes whose values compression
+// saves the most bytes
+func (c *Counter) GetContentPrefixes(num int) []*ContentEntry {
+	res := []*ContentEntry{}
+	for _, p := range c.contentPrefixes {
+		if p.classLen != nil {
+			p.Class = ""
+			for class, l := range p.classLen {
+				if p.Class == "" || l > p.classLen[p.Class] || l == p.classLen[p.Class] && class < p.Class {
+					p.Class = class
+				}
+			}
+		}
+		res = append(res, p)
+	}
+	sortContentEntries(res)
+	if num < len(res) {
+		res = res[:num]
+	}
+	return res
+}
+
+func sortContentEntries(res []*ContentEntry) {
+	sort.Slice(res, func(i, j int) bool {
+		a, b := res[i], res[j]
+		if a.CompressSaving != b.CompressSaving {
+			return a.CompressSaving > b.CompressSaving
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Key+a.Class < b.Key+b.Class
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func content(sample uint64, classes map[string]*decoder.ContentCount) *decoder.ValueContent {
+	return &decoder.ValueContent{Classes: classes, Sample: sample}
+}
+
+func TestCounterContent(t *testing.T) {
+	in := make(chan *decoder.Entry, 5)
+	in <- &decoder.Entry{Key: "user:1", Type: "string", Content: content(10, map[string]*decoder.ContentCount{
+		"json": {Num: 1, Len: 1000, Compressed: 300, Reformatted: 800},
+	})}
+	in <- &decoder.Entry{Key: "user:2", Type: "string", Content: content(10, map[string]*decoder.ContentCount{
+		"json": {Num: 1, Len: 500, Compressed: 200, Reformatted: 500},
+	})}
+	in <- &decoder.Entry{Key: "img:1", Type: "hash", DB: 1, Content: content(10, map[string]*decoder.ContentCount{
+		"gzip":   {Num: 2, Len: 4000, Compressed: 4000, Reformatted: 4000},
+		"base64": {Num: 1, Len: 400, Compressed: 300, Reformatted: 300},
+	})}
+	in <- &decoder.Entry{Key: "user:3", Type: "string"}
+	in <- &decoder.Entry{Key: "set", Type: "set"}
+	close(in)
+	c := NewCounter(nil)
+	c.SetFullDB(1)
+	c.Count(in)
+
+	classes := c.GetContentClasses()
+	assert.Len(t, classes, 3)
+	json := classes[0]
+	assert.Equal(t, "json", json.Class)
+	assert.Equal(t, "string", json.Type)
+	assert.Equal(t, uint64(2), json.Keys)
+	assert.Equal(t, uint64(1500), json.Len)
+	assert.Equal(t, uint64(10000), json.CompressSaving)
+	assert.Equal(t, uint64(2000), json.ReformatSaving)
+	assert.Equal(t, "base64", classes[1].Class)
+	assert.Equal(t, "gzip", classes[2].Class)
+	assert.Equal(t, uint64(0), classes[2].CompressSaving)
+
+	prefixes := c.GetContentPrefixes(10)
+	assert.Len(t, prefixes, 2)
+	assert.Equal(t, "user", prefixes[0].Key)
+	assert.Equal(t, "json", prefixes[0].Class)
+	assert.Equal(t, uint64(2), prefixes[0].Keys)
+	assert.Equal(t, uint64(10000), prefixes[0].CompressSaving)
+	assert.Equal(t, "img", prefixes[1].Key)
+	assert.Equal(t, "gzip", prefixes[1].Class)
+	assert.Equal(t, uint64(1), prefixes[1].Keys)
+	assert.Equal(t, uint64(1000), prefixes[1].CompressSaving)
+	assert.Equal(t, uint64(1000), prefixes[1].ReformatSaving)
+	assert.Len(t, c.GetContentPrefixes(1), 1)
+
+	assert.Len(t, c.selectDB(1).GetContentClasses(), 2)
+
+	// classes and top prefixes are kept with the result
+	r := c.result().counter()
+	assert.Equal(t, classes, r.GetContentClasses())
+	assert.Equal(t, prefixes, r.GetContentPrefixes(10))
+	assert.Equal(t, c.selectDB(1).GetContentPrefixes(10), r.selectDB(1).GetContentPrefixes(10))
+}
+
+func TestCounterContentTrackedPrefixes(t *testing.T) {
+	opts := DefaultAnalysisOptions()
+	opts.MaxPrefixes = 2
+	opts.PrefixMemory = prefixMemoryFor(2)
+	in := make(chan *decoder.Entry)
+	go func() {
+		for i := 0; i < 100; i++ {
+			in <- &decoder.Entry{Key: fmt.Sprintf("tmp%d", i), Type: "string", Bytes: 1, Content: content(1, map[string]*decoder.ContentCount{
+				"text": {Num: 1, Len: 10, Compressed: 10, Reformatted: 10},
+			})}
+			in <- &decoder.Entry{Key: fmt.Sprintf("user:%d", i), Type: "string", Bytes: 100, Content: content(1, map[string]*decoder.ContentCount{
+				"json": {Num: 1, Len: 100, Compressed: 50, Reformatted: 100},
+			})}
+		}
+		close(in)
+	}()
+	c := NewCounter(opts)
+	c.Count(in)
+
+	// values are only counted by the prefixes kept
+	assert.True(t, len(c.contentPrefixes) <= 2)
+	prefixes := c.GetContentPrefixes(10)
+	assert.Equal(t, "user", prefixes[0].Key)
+	assert.Equal(t, uint64(100), prefixes[0].Keys)
+	assert.Len(t, c.GetContentClasses(), 2)
+}
@@ -23,62 +23,207 @@ import (
 	"github.com/xueqiu/rdr/decoder"
 )
 
-// NewCounter return a pointer of Counter
-func NewCounter() *Counter {
+// ttlBuckets of keys, relative to ctime of the rdb. Keys with expiry are
+// counted into the first bucket whose ttl limit they are less than.
+var ttlBuckets = [...]string{"expired", "1h", "1d", "7d", "30d", "30d+", "none"}
+
+var ttlLimits = []int64{
+	0,
+	3600 * 1000,
+	24 * 3600 * 1000,
+	7 * 24 * 3600 * 1000,
+	30 * 24 * 3600 * 1000,
+}
+
+type ttlCount [len(ttlBuckets)]uint64
+
+// idleBuckets of keys by lru idle time, "unknown" if the rdb is not saved
+// with a lru maxmemory-policy
+var idleBuckets = []string{"1h", "1d", "7d", "30d", "90d", "90d+", "unknown"}
+
+var idleLimits = []int64{
+	3600,
+	24 * 3600,
+	7 * 24 * 3600,
+	30 * 24 * 3600,
+	90 * 24 * 3600,
+}
+
+// freqBuckets of keys by lfu counter, "unknown" if the rdb is not saved
+// with a lfu maxmemory-policy. New keys start with a counter of 5.
+var freqBuckets = []string{"0-4", "5-9", "10-19", "20-49", "50-99", "100-255", "unknown"}
+
+var freqLimits = []int{4, 9, 19, 49, 99}
+
+// NewCounter return a pointer of Counter counting with opts, or with the
+// default options if opts is nil
+func NewCounter(opts *AnalysisOptions) *Counter {
+	if opts == nil {
+		opts = DefaultAnalysisOptions()
+	}
 	h := &entryHeap{}
 	heap.Init(h)
 	p := &prefixHeap{}
 	heap.Init(p)
-	return &Counter{
+	grouper, err := newKeyGrouper(opts)
+	if err != nil {
+		// rules are checked when options are loaded
+		grouper = &keyGrouper{detectTokens: opts.DetectTokens}
+	}
+	c := &Counter{
 		largestEntries:     h,
 		largestKeyPrefixes: p,
-		lengthLevel0:       100,
-		lengthLevel1:       1000,
-		lengthLevel2:       10000,
-		lengthLevel3:       100000,
-		lengthLevel4:       1000000,
+		opts:               opts,
+		grouper:            grouper,
 		lengthLevelBytes:   map[typeKey]uint64{},
 		lengthLevelNum:     map[typeKey]uint64{},
-		keyPrefixBytes:     map[typeKey]uint64{},
-		keyPrefixNum:       map[typeKey]uint64{},
+		encodingBytes:      map[typeKey]uint64{},
+		encodingNum:        map[typeKey]uint64{},
+		nearThresholds:     map[nearKey]*NearThreshold{},
+		skewedEntries:      &entryHeap{},
+		contentClasses:     map[typeKey]*ContentEntry{},
+		contentPrefixes:    map[typeKey]*ContentEntry{},
+		prefixSketch:       newBudgetPrefixSketch(opts, opts.PrefixMemory),
+		ttlBytes:           map[typeKey]uint64{},
+		ttlNum:             map[typeKey]uint64{},
+		idleBytes:          map[typeKey]uint64{},
+		idleNum:            map[typeKey]uint64{},
+		freqBytes:          map[typeKey]uint64{},
+		freqNum:            map[typeKey]uint64{},
+		coldIdle:           int64(opts.ColdIdleDays) * 24 * 3600,
 		typeBytes:          map[string]uint64{},
 		typeNum:            map[string]uint64{},
-		separators:         ":;,_- ",
+		typeSizes:          map[string]*QuantileSketch{},
+		typeElems:          map[string]*QuantileSketch{},
 		slotBytes:          map[int]uint64{},
 		slotNum:            map[int]uint64{},
+		dbs:                map[int]*Counter{},
+		fullDB:             -1,
+		dbSizes:            map[int]*decoder.DBSize{},
+	}
+	if opts.MineTemplates {
+		c.miner = newTemplateMiner(opts.Separators, opts.MaxTemplates)
 	}
+	return c
 }
 
 // Counter for redis memory useage
 type Counter struct {
 	largestEntries     *entryHeap
 	largestKeyPrefixes *prefixHeap
-	lengthLevel0       uint64
-	lengthLevel1       uint64
-	lengthLevel2       uint64
-	lengthLevel3       uint64
-	lengthLevel4       uint64
+	opts               *AnalysisOptions
+	grouper            *keyGrouper
 	lengthLevelBytes   map[typeKey]uint64
 	lengthLevelNum     map[typeKey]uint64
-	keyPrefixBytes     map[typeKey]uint64
-	keyPrefixNum       map[typeKey]uint64
-	separators         string
+	encodingBytes      map[typeKey]uint64 // Key of typeKey is the encoding
+	encodingNum        map[typeKey]uint64
+	nearThresholds     map[nearKey]*NearThreshold
+	largestElements    []*decoder.Element
+	skewedEntries      *entryHeap // largest collections with a skewed element
+	skewedNum          uint64
+	skewedBytes        uint64
+	contentClasses     map[typeKey]*ContentEntry // Key of typeKey is the class
+	contentPrefixes    map[typeKey]*ContentEntry
+	prefixSketch       *prefixSketch
+	prefixErrorBound   uint64 // most bytes a key prefix may be overcounted by
+	ttlBytes           map[typeKey]uint64
+	ttlNum             map[typeKey]uint64
+	idleBytes          map[typeKey]uint64
+	idleNum            map[typeKey]uint64
+	freqBytes          map[typeKey]uint64
+	freqNum            map[typeKey]uint64
+	coldIdle           int64 // keys idle for longer than coldIdle seconds are cold
+	coldBytes          uint64
+	coldNum            uint64
 	typeBytes          map[string]uint64
 	typeNum            map[string]uint64
+	typeSizes          map[string]*QuantileSketch // bytes of every key by type
+	typeElems          map[string]*QuantileSketch // elements of every key by type
 	slotBytes          map[int]uint64
 	slotNum            map[int]uint64
+	dbs                map[int]*Counter // counters of every database, nil in a database counter
+	fullDB             int              // database counted in every dimension, -1 for none
+	light              bool             // a database counter of types, length levels, ttls and largest keys only
+	dbSizes            map[int]*decoder.DBSize
+	miner              *templateMiner // nil unless templates are mined
+	keyTemplates       []*KeyTemplate
 }
 
 // Count by various dimensions
 func (c *Counter) Count(in <-chan *decoder.Entry) {
 	for e := range in {
 		c.count(e)
+		c.countByDB(e)
 	}
 	// get largest prefixes
-	c.calcuLargestKeyPrefix(1000)
+	c.calcuLargestKeyPrefix(c.opts.MaxPrefixes)
+	c.calcuKeyTemplates()
+	for _, dc := range c.dbs {
+		dc.calcuLargestKeyPrefix(c.opts.MaxPrefixes)
+		dc.calcuKeyTemplates()
+	}
+}
+
+// SetFullDB makes the counter of database n count every dimension, as the
+// instance does. Other databases are counted by type, length level, ttl and
+// their largest keys only, so counting does not take twice the memory.
+func (c *Counter) SetFullDB(n int) {
+	c.fullDB = n
+	if n >= 0 {
+		// the database takes half the memory budget of prefixes
+		c.prefixSketch = newBudgetPrefixSketch(c.opts, c.opts.PrefixMemory/2)
+	}
+}
+
+// SetDBSizes set size hints of databases from the decoder
+func (c *Counter) SetDBSizes(sizes map[int]*decoder.DBSize) {
+	c.dbSizes = sizes
+}
+
+// selectDB get the counter of database n, or c itself if n is negative
+func (c *Counter) selectDB(n int) *Counter {
+	if n < 0 || c.dbs == nil {
+		return c
+	}
+	if dc, ok := c.dbs[n]; ok {
+		return dc
+	}
+	return NewCounter(c.opts)
 }
 
-// GetLargestEntries from heap, num max is 500
+// GetDBCount get keys and bytes of every database, ordered by db number
+func (c *Counter) GetDBCount() []*DBEntry {
+	res := []*DBEntry{}
+	dbs := map[int]*DBEntry{}
+	for n, dc := range c.dbs {
+		entry := &DBEntry{DB: n}
+		for _, v := range dc.typeNum {
+			entry.Num += v
+		}
+		for _, v := range dc.typeBytes {
+			entry.Bytes += v
+		}
+		dbs[n] = entry
+	}
+	for n, size := range c.dbSizes {
+		entry, ok := dbs[n]
+		if !ok {
+			entry = &DBEntry{DB: n}
+			dbs[n] = entry
+		}
+		entry.DBSize = size.DBSize
+		entry.ExpiresSize = size.ExpiresSize
+	}
+	for _, entry := range dbs {
+		res = append(res, entry)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].DB < res[j].DB
+	})
+	return res
+}
+
+// GetLargestEntries from heap, num max is the LargestKeys option
 func (c *Counter) GetLargestEntries(num int) []*decoder.Entry {
 	res := []*decoder.Entry{}
 
@@ -107,6 +252,12 @@ func (c *Counter) GetLargestKeyPrefixes() []*PrefixEntry {
 	return res
 }
 
+// GetKeyTemplates get the mined key templates ordered by bytes, nil if
+// templates are not mined
+func (c *Counter) GetKeyTemplates() []*KeyTemplate {
+	return c.keyTemplates
+}
+
 // GetLenLevelCount from map
 func (c *Counter) GetLenLevelCount() []*PrefixEntry {
 	res := []*PrefixEntry{}
@@ -123,12 +274,75 @@ func (c *Counter) GetLenLevelCount() []*PrefixEntry {
 	return res
 }
 
+// GetTTLCount from map, ordered by ttl bucket
+func (c *Counter) GetTTLCount() []*PrefixEntry {
+	return getBucketCount(ttlBuckets[:], c.ttlBytes, c.ttlNum)
+}
+
+// GetIdleCount from map, ordered by idle bucket
+func (c *Counter) GetIdleCount() []*PrefixEntry {
+	return getBucketCount(idleBuckets, c.idleBytes, c.idleNum)
+}
+
+// GetFreqCount from map, ordered by freq bucket
+func (c *Counter) GetFreqCount() []*PrefixEntry {
+	return getBucketCount(freqBuckets, c.freqBytes, c.freqNum)
+}
+
+func getBucketCount(buckets []string, bytes, num map[typeKey]uint64) []*PrefixEntry {
+	res := []*PrefixEntry{}
+
+	for _, bucket := range buckets {
+		for key := range num {
+			if key.Key != bucket {
+				continue
+			}
+			entry := &PrefixEntry{}
+			entry.Type = key.Type
+			entry.Key = key.Key
+			entry.Bytes = bytes[key]
+			entry.Num = num[key]
+			res = append(res, entry)
+		}
+	}
+	return res
+}
+
 func (c *Counter) count(e *decoder.Entry) {
-	c.countLargestEntries(e, 500)
+	if c.light {
+		c.countLargestEntries(e, c.opts.TopN)
+		c.countByType(e)
+		c.countByLength(e)
+		c.countByTTL(e)
+		return
+	}
+	c.countLargestEntries(e, c.opts.LargestKeys)
 	c.countByType(e)
 	c.countByLength(e)
+	c.countByEncoding(e)
+	c.countSkewed(e)
+	c.countByTTL(e)
+	c.countByAccess(e)
 	c.countByKeyPrefix(e)
+	c.countByContent(e)
 	c.countBySlot(e)
+	if c.miner != nil {
+		c.miner.add(e)
+	}
+}
+
+func (c *Counter) countByDB(e *decoder.Entry) {
+	dc, ok := c.dbs[e.DB]
+	if !ok {
+		dc = NewCounter(c.opts)
+		dc.dbs = nil
+		dc.light = e.DB != c.fullDB
+		if !dc.light {
+			dc.prefixSketch = newBudgetPrefixSketch(c.opts, c.opts.PrefixMemory/2)
+		}
+		c.dbs[e.DB] = dc
+	}
+	dc.count(e)
 }
 
 func (c *Counter) countLargestEntries(e *decoder.Entry, num int) {
@@ -140,59 +354,105 @@ func (c *Counter) countLargestEntries(e *decoder.Entry, num int) {
 }
 
 func (c *Counter) countByLength(e *decoder.Entry) {
+	levels := c.opts.LengthLevels
+	for i := len(levels) - 1; i >= 0; i-- {
+		if e.NumOfElem > levels[i] {
+			key := typeKey{
+				Type: e.Type,
+				Key:  strconv.FormatUint(levels[i], 10),
+			}
+			c.lengthLevelBytes[key] += e.Bytes
+			c.lengthLevelNum[key]++
+			return
+		}
+	}
+}
+
+func ttlBucket(e *decoder.Entry) int {
+	if e.Expiry <= 0 {
+		return len(ttlBuckets) - 1
+	}
+	for i, limit := range ttlLimits {
+		if e.TTL <= limit {
+			return i
+		}
+	}
+	return len(ttlBuckets) - 2
+}
+
+func (c *Counter) countByTTL(e *decoder.Entry) {
 	key := typeKey{
 		Type: e.Type,
-		Key:  strconv.FormatUint(c.lengthLevel0, 10),
+		Key:  ttlBuckets[ttlBucket(e)],
 	}
+	c.ttlBytes[key] += e.Bytes
+	c.ttlNum[key]++
+}
 
-	add := func(c *Counter, key typeKey, e *decoder.Entry) {
-		c.lengthLevelBytes[key] += e.Bytes
-		c.lengthLevelNum[key]++
+func (c *Counter) countByAccess(e *decoder.Entry) {
+	key := typeKey{
+		Type: e.Type,
+		Key:  idleBuckets[len(idleBuckets)-1],
+	}
+	if e.Idle >= 0 {
+		key.Key = idleBuckets[len(idleBuckets)-2]
+		for i, limit := range idleLimits {
+			if e.Idle <= limit {
+				key.Key = idleBuckets[i]
+				break
+			}
+		}
+	}
+	c.idleBytes[key] += e.Bytes
+	c.idleNum[key]++
+	if e.Idle > c.coldIdle {
+		c.coldBytes += e.Bytes
+		c.coldNum++
 	}
 
-	// must lengthLevel4 > lengthLevel3 > lengthLevel2 ...
-	if e.NumOfElem > c.lengthLevel4 {
-		key.Key = strconv.FormatUint(c.lengthLevel4, 10)
-		add(c, key, e)
-	} else if e.NumOfElem > c.lengthLevel3 {
-		key.Key = strconv.FormatUint(c.lengthLevel3, 10)
-		add(c, key, e)
-	} else if e.NumOfElem > c.lengthLevel2 {
-		key.Key = strconv.FormatUint(c.lengthLevel2, 10)
-		add(c, key, e)
-	} else if e.NumOfElem > c.lengthLevel1 {
-		key.Key = strconv.FormatUint(c.lengthLevel1, 10)
-		add(c, key, e)
-	} else if e.NumOfElem > c.lengthLevel0 {
-		key.Key = strconv.FormatUint(c.lengthLevel0, 10)
-		add(c, key, e)
+	key.Key = freqBuckets[len(freqBuckets)-1]
+	if e.Freq >= 0 {
+		key.Key = freqBuckets[len(freqBuckets)-2]
+		for i, limit := range freqLimits {
+			if e.Freq <= limit {
+				key.Key = freqBuckets[i]
+				break
+			}
+		}
 	}
+	c.freqBytes[key] += e.Bytes
+	c.freqNum[key]++
 }
 
 func (c *Counter) countByType(e *decoder.Entry) {
 	c.typeNum[e.Type]++
 	c.typeBytes[e.Type] += e.Bytes
+	if c.light {
+		return
+	}
+	sizes, ok := c.typeSizes[e.Type]
+	if !ok {
+		sizes = NewQuantileSketch()
+		c.typeSizes[e.Type] = sizes
+		c.typeElems[e.Type] = NewQuantileSketch()
+	}
+	sizes.Add(e.Bytes)
+	c.typeElems[e.Type].Add(e.NumOfElem)
 }
 
 func (c *Counter) countByKeyPrefix(e *decoder.Entry) {
-	// reset all numbers to 0
-	k := strings.Map(func(c rune) rune {
-		if c >= 48 && c <= 57 { //48 == "0" 57 == "9"
-			return '0'
-		}
-		return c
-	}, e.Key)
-	prefixes := getPrefixes(k, c.separators)
+	prefixes := c.keyPrefixes(e.Key)
 	key := typeKey{
 		Type: e.Type,
 	}
+	bucket := ttlBucket(e)
+	cold := e.Idle > c.coldIdle
 	for _, prefix := range prefixes {
 		if len(prefix) == 0 {
 			continue
 		}
 		key.Key = prefix
-		c.keyPrefixBytes[key] += e.Bytes
-		c.keyPrefixNum[key]++
+		c.prefixSketch.add(key, e, bucket, cold)
 	}
 }
 
@@ -206,21 +466,35 @@ func (c *Counter) countBySlot(e *decoder.Entry) {
 }
 
 func (c *Counter) calcuLargestKeyPrefix(num int) {
-	for key := range c.keyPrefixBytes {
-		k := &PrefixEntry{}
-		k.Type = key.Type
-		k.Key = key.Key
-		k.Bytes = c.keyPrefixBytes[key]
-		k.Num = c.keyPrefixNum[key]
-		delete(c.keyPrefixBytes, key)
-		delete(c.keyPrefixNum, key)
-
+	c.prefixErrorBound = c.prefixSketch.errorBound()
+	for _, p := range c.prefixSketch.contents() {
+		c.contentPrefixes[typeKey{Type: p.Type, Key: p.Key}] = p
+	}
+	for _, k := range c.prefixSketch.drain() {
 		heap.Push(c.largestKeyPrefixes, k)
 		l := c.largestKeyPrefixes.Len()
 		if l > num {
 			heap.Pop(c.largestKeyPrefixes)
 		}
 	}
+	// only the prefixes kept need their quantiles
+	for _, k := range *c.largestKeyPrefixes {
+		if k.sizes != nil {
+			k.Sizes = k.sizes.Summary()
+			k.Elems = k.elems.Summary()
+			k.sizes, k.elems = nil, nil
+		}
+	}
+}
+
+// calcuKeyTemplates keeps the templates learned by the miner, which is
+// dropped with the tokens it has seen
+func (c *Counter) calcuKeyTemplates() {
+	if c.miner == nil {
+		return
+	}
+	c.keyTemplates = c.miner.templates()
+	c.miner = nil
 }
 
 type entryHeap []*decoder.Entry
@@ -259,6 +533,22 @@ type PrefixEntry struct {
 	typeKey
 	Bytes uint64
 	Num   uint64
+	// TTLNum is number of keys in each ttl bucket, only for key prefixes
+	TTLNum map[string]uint64 `json:",omitempty"`
+	// ColdBytes and ColdNum of keys idle for longer than Counter.coldIdle,
+	// only for key prefixes
+	ColdBytes uint64 `json:",omitempty"`
+	ColdNum   uint64 `json:",omitempty"`
+	// Err is the most Bytes may be more than the true bytes of a prefix
+	// counted in bounded memory
+	Err uint64 `json:",omitempty"`
+	// Sizes and Elems are quantiles of bytes and elements of keys, only for
+	// key prefixes. They are of the keys counted since the prefix became one
+	// of MaxPrefixes of the most bytes, see prefixSketch.
+	Sizes *SizeQuantiles `json:",omitempty"`
+	Elems *SizeQuantiles `json:",omitempty"`
+	// sizes and elems are sketched while counting
+	sizes, elems *QuantileSketch
 }
 
 func (h prefixHeap) Len() int {
@@ -320,6 +610,17 @@ func removeDuplicatesUnordered(elements []string) []string {
 	return result
 }
 
+// keyPrefixes get the prefixes a key is counted in, those of its group and
+// the group itself if it is whole
+func (c *Counter) keyPrefixes(key string) []string {
+	group, whole := c.grouper.group(key)
+	prefixes := getPrefixes(group, c.opts.Separators)
+	if whole && !containsString(prefixes, group) {
+		prefixes = append(prefixes, group)
+	}
+	return prefixes
+}
+
 func getPrefixes(s, sep string) []string {
 	res := []string{}
 	sepIdx := strings.IndexAny(s, sep)
@@ -354,6 +655,15 @@ func hasAnySuffix(s, suffix string) bool {
 	return false
 }
 
+// DBEntry record keys and bytes of a database, with size hints from the rdb
+type DBEntry struct {
+	DB          int
+	Bytes       uint64
+	Num         uint64
+	DBSize      uint32 `json:",omitempty"`
+	ExpiresSize uint32 `json:",omitempty"`
+}
+
 // support for sorting of slots
 type SlotEntry struct {
 	Slot int
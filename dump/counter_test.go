@@ -31,7 +31,7 @@ func TestGetLargestKeyPrefixes(t *testing.T) {
 		LenOfLargestElem:   1,
 		FieldOfLargestElem: "test",
 	}
-	c := NewCounter()
+	c := NewCounter(nil)
 	c.countByKeyPrefix(e)
 	c.calcuLargestKeyPrefix(1)
 	for _, p := range c.GetLargestKeyPrefixes() {
@@ -61,3 +61,124 @@ func TestSlotHeap(t *testing.T) {
 		}
 	}
 }
+
+func TestCountByTTL(t *testing.T) {
+	c := NewCounter(nil)
+	hour := int64(3600 * 1000)
+	entries := []*decoder.Entry{
+		{Key: "a", Type: "string", Bytes: 10},
+		{Key: "b", Type: "string", Bytes: 20, Expiry: 1, TTL: -hour},
+		{Key: "c", Type: "string", Bytes: 30, Expiry: 1, TTL: hour / 2},
+		{Key: "d", Type: "string", Bytes: 40, Expiry: 1, TTL: 2 * hour},
+		{Key: "e", Type: "hash", Bytes: 50, Expiry: 1, TTL: 100 * 24 * hour},
+	}
+	for _, e := range entries {
+		c.countByTTL(e)
+	}
+
+	buckets := map[string]uint64{}
+	for _, p := range c.GetTTLCount() {
+		buckets[p.Type+":"+p.Key] = p.Bytes
+	}
+	assert.Equal(t, map[string]uint64{
+		"string:none":    10,
+		"string:expired": 20,
+		"string:1h":      30,
+		"string:1d":      40,
+		"hash:30d+":      50,
+	}, buckets)
+}
+
+func TestCountByAccess(t *testing.T) {
+	c := NewCounter(nil)
+	day := int64(24 * 3600)
+	entries := []*decoder.Entry{
+		{Key: "a", Type: "string", Bytes: 10, Idle: -1, Freq: -1},
+		{Key: "b", Type: "string", Bytes: 20, Idle: 60, Freq: 5},
+		{Key: "c", Type: "string", Bytes: 30, Idle: 40 * day, Freq: 0},
+		{Key: "d", Type: "hash", Bytes: 40, Idle: 100 * day, Freq: 255},
+	}
+	for _, e := range entries {
+		c.countByAccess(e)
+	}
+
+	idle := map[string]uint64{}
+	for _, p := range c.GetIdleCount() {
+		idle[p.Type+":"+p.Key] = p.Bytes
+	}
+	assert.Equal(t, map[string]uint64{
+		"string:unknown": 10,
+		"string:1h":      20,
+		"string:90d":     30,
+		"hash:90d+":      40,
+	}, idle)
+
+	freq := map[string]uint64{}
+	for _, p := range c.GetFreqCount() {
+		freq[p.Type+":"+p.Key] = p.Num
+	}
+	assert.Equal(t, map[string]uint64{
+		"string:unknown": 1,
+		"string:5-9":     1,
+		"string:0-4":     1,
+		"hash:100-255":   1,
+	}, freq)
+	assert.Equal(t, uint64(70), c.coldBytes)
+	assert.Equal(t, uint64(2), c.coldNum)
+
+	// the cold threshold is an option
+	opts := DefaultAnalysisOptions()
+	opts.ColdIdleDays = 50
+	c = NewCounter(opts)
+	for _, e := range entries {
+		c.countByAccess(e)
+	}
+	assert.Equal(t, uint64(40), c.coldBytes)
+	oa := NewOpsAnalyzer(c)
+	assert.Equal(t, uint64(1), oa.coldKeys)
+	assert.Equal(t, uint64(40), oa.coldBytes)
+}
+
+func TestCountByDB(t *testing.T) {
+	c := NewCounter(nil)
+	in := make(chan *decoder.Entry, 3)
+	in <- &decoder.Entry{Key: "a", Type: "string", Bytes: 10}
+	in <- &decoder.Entry{Key: "b", Type: "string", Bytes: 20, DB: 5}
+	in <- &decoder.Entry{Key: "c", Type: "hash", Bytes: 30, DB: 5}
+	close(in)
+	c.Count(in)
+	c.SetDBSizes(map[int]*decoder.DBSize{
+		5: {DBSize: 2, ExpiresSize: 1},
+		7: {DBSize: 1},
+	})
+
+	assert.Equal(t, []*DBEntry{
+		{DB: 0, Bytes: 10, Num: 1},
+		{DB: 5, Bytes: 50, Num: 2, DBSize: 2, ExpiresSize: 1},
+		{DB: 7, DBSize: 1},
+	}, c.GetDBCount())
+
+	assert.Equal(t, c, c.selectDB(-1))
+	assert.Equal(t, map[string]uint64{"string": 1, "hash": 1}, c.selectDB(5).typeNum)
+	assert.Len(t, c.selectDB(5).GetLargestEntries(10), 2)
+	assert.Empty(t, c.selectDB(7).typeNum)
+	// databases not selected are counted by type, length level, ttl and
+	// largest keys only
+	assert.True(t, c.selectDB(5).light)
+	assert.Empty(t, c.selectDB(5).GetLargestKeyPrefixes())
+	assert.Empty(t, c.selectDB(5).typeSizes)
+	assert.Len(t, c.selectDB(5).GetTTLCount(), 2)
+
+	c = NewCounter(nil)
+	c.SetFullDB(5)
+	in = make(chan *decoder.Entry, 2)
+	in <- &decoder.Entry{Key: "a", Type: "string", Bytes: 10}
+	in <- &decoder.Entry{Key: "b", Type: "string", Bytes: 20, DB: 5}
+	close(in)
+	c.Count(in)
+	assert.False(t, c.selectDB(5).light)
+	assert.Len(t, c.selectDB(5).GetLargestKeyPrefixes(), 1)
+	assert.Equal(t, uint64(1), c.selectDB(5).typeSizes["string"].Count)
+	assert.True(t, c.selectDB(0).light)
+	assert.Empty(t, c.selectDB(0).GetLargestKeyPrefixes())
+}
@@ -2,9 +2,9 @@ package dump
 
 import (
 	"container/heap"
+	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"path/filepath"
 
 	"github.com/dongmx/rdb"
@@ -12,50 +12,32 @@ import (
 	"github.com/xueqiu/rdr/decoder"
 )
 
-// Dump rdb file statistical information
-func Dump(path string) (map[string]interface{}, error) {
-	var data map[string]interface{}
-	decoder := decoder.NewDecoder()
-	go func() {
-		f, err := os.Open(path)
-		defer close(decoder.Entries)
-		if err != nil {
-			fmt.Printf("open rdbfile err: %v\n", err)
-			return
-		}
-		err = rdb.Decode(f, decoder)
-		if err != nil {
-			fmt.Printf("decode rdbfile err: %v\n", err)
-			return
-		}
-	}()
-	cnt := NewCounter()
-	cnt.Count(decoder.Entries)
-	filename := filepath.Base(path)
-	data = getData(filename, cnt)
-	return data, nil
-}
-
 // ToCliWriter dump rdb file statistical information to STDOUT.
 func ToCliWriter(cli *cli.Context) {
 	if cli.NArg() < 1 {
 		fmt.Fprintln(cli.App.ErrWriter, " requires at least 1 argument")
 		return
 	}
+	if v := cli.String("redis-version"); v != "" {
+		if _, err := decoder.MemModelFor(v); err != nil {
+			fmt.Fprintln(cli.App.ErrWriter, err)
+			return
+		}
+	}
+	opts, err := analysisOptions(cli)
+	if err != nil {
+		fmt.Fprintln(cli.App.ErrWriter, err)
+		return
+	}
 
 	// parse rdbfile
 	fmt.Fprintln(cli.App.Writer, "[")
 	nargs := cli.NArg()
 	for i := 0; i < nargs; i++ {
 		file := cli.Args().Get(i)
-		decoder := decoder.NewDecoder()
+		decoder := newDecoder(opts)
 		go Decode(cli, decoder, file)
-		cnt := NewCounter()
-		cnt.Count(decoder.Entries)
-		filename := filepath.Base(file)
-		data := getData(filename, cnt)
-		data["MemoryUse"] = decoder.GetUsedMem()
-		data["CTime"] = decoder.GetTimestamp()
+		data := countData(cli, opts, filepath.Base(file), decoder)
 		jsonBytes, _ := json.MarshalIndent(data, "", "    ")
 		fmt.Fprint(cli.App.Writer, string(jsonBytes))
 		if i == nargs-1 {
@@ -67,39 +49,132 @@ func ToCliWriter(cli *cli.Context) {
 	fmt.Fprintln(cli.App.Writer, "]")
 }
 
+// newDecoder creates a decoder inspecting values and keeping the largest
+// elements as opts sets
+func newDecoder(opts *AnalysisOptions) *decoder.Decoder {
+	d := decoder.NewDecoder()
+	if opts == nil {
+		return d
+	}
+	if opts.InspectValues > 0 {
+		d.InspectValues(opts.InspectValues)
+	}
+	d.KeepLargestElements(opts.largestElements())
+	return d
+}
+
+// countData counts entries of decoder with opts until decoding is done and
+// returns the statistical information for the cli
+func countData(cli *cli.Context, opts *AnalysisOptions, filename string, decoder *decoder.Decoder) map[string]interface{} {
+	cnt := NewCounter(opts)
+	cnt.SetFullDB(cli.Int("db"))
+	cnt.Count(decoder.Entries)
+	cnt.SetDBSizes(decoder.GetDBSizes())
+	cnt.SetLargestElements(decoder.GetLargestElements())
+	cal := calibrate(cnt, uint64(decoder.GetUsedMem()), decoder.GetDictTablesOverhead())
+	if cli.Bool("calibrate") {
+		if cal != nil {
+			cal.Factor = cal.correctionFactor()
+			cnt.scaleBytes(cal.Factor)
+		} else {
+			fmt.Fprintf(cli.App.ErrWriter, "%s: no used-mem in the rdb, bytes are not calibrated\n", filename)
+		}
+	}
+	data := getData(filename, cnt.selectDB(cli.Int("db")))
+	data["Databases"] = cnt.GetDBCount()
+	data["MemoryUse"] = decoder.GetUsedMem()
+	data["CTime"] = decoder.GetTimestamp()
+	data["MemoryModel"] = decoder.GetMemModel()
+	if cal != nil {
+		data["Calibration"] = cal
+	}
+	if decoder.GetChecksumError() != nil {
+		data["ChecksumMismatch"] = true
+	}
+	if report := decoder.GetSalvageReport(); report != nil {
+		data["Partial"] = report.Partial()
+		data["Truncated"] = report.Truncated
+		data["SkippedBytes"] = report.SkippedBytes()
+		data["Skipped"] = report.Skipped
+		if report.Partial() {
+			fmt.Fprintf(cli.App.ErrWriter, "%s: partial result, %d bytes skipped\n", filename, report.SkippedBytes())
+		}
+	}
+	return data
+}
+
 // Decode ...
 func Decode(c *cli.Context, decoder *decoder.Decoder, filepath string) {
-	f, err := os.Open(filepath)
+	if v := c.String("redis-version"); v != "" {
+		if err := decoder.SetRedisVersion(v); err != nil {
+			fmt.Fprintf(c.App.ErrWriter, "%v\n", err)
+			close(decoder.Entries)
+			return
+		}
+	}
+	m := newMeter(decoder.GetKeys)
+	m.setTotal(inputSize(filepath))
+	done := make(chan struct{})
+	defer close(done)
+	go reportProgress(c.App.ErrWriter, filepath, m, done)
+
+	if isAOF(filepath) {
+		if err := decodeAOF(context.Background(), filepath, decoder, m); err != nil {
+			fmt.Fprintf(c.App.ErrWriter, "decode aof err: %v\n", err)
+			close(decoder.Entries)
+		}
+		return
+	}
+
+	f, err := openMeteredRDB(filepath, m)
 	if err != nil {
 		fmt.Fprintf(c.App.ErrWriter, "open rdbfile err: %v\n", err)
 		close(decoder.Entries)
 		return
 	}
-	err = rdb.Decode(f, decoder)
+	defer f.Close()
+	if c.Bool("salvage") {
+		err = decoder.DecodeSalvage(context.Background(), f)
+	} else {
+		err = rdb.Decode(f, decoder)
+	}
 	if err != nil {
+		decodeFailed(c, decoder, filepath, err)
+	}
+}
+
+// decodeFailed prints the error of decoding a rdb and closes the entries of
+// decoder. A checksum mismatch is only a warning as every key has been
+// decoded, it is recorded by decoder.
+func decodeFailed(c *cli.Context, decoder *decoder.Decoder, name string, err error) {
+	if decoder.ChecksumFailed(err) {
+		fmt.Fprintf(c.App.ErrWriter, "%s: warning: %v\n", name, err)
+	} else {
 		fmt.Fprintf(c.App.ErrWriter, "decode rdbfile err: %v\n", err)
-		close(decoder.Entries)
-		return
 	}
+	close(decoder.Entries)
 }
 
 func getData(filename string, cnt *Counter) map[string]interface{} {
 	data := make(map[string]interface{})
 	data["CurrentInstance"] = filename
-	data["LargestKeys"] = cnt.GetLargestEntries(100)
+	data["LargestKeys"] = cnt.GetLargestEntries(cnt.opts.TopN)
 
 	largestKeyPrefixesByType := map[string][]*PrefixEntry{}
 	for _, entry := range cnt.GetLargestKeyPrefixes() {
-		// if mem usage is less than 1M, and the list is long enough, then it's unnecessary to add it.
-		if entry.Bytes < 1000*1000 && len(largestKeyPrefixesByType[entry.Type]) > 50 {
+		// if mem usage is less than PrefixMinBytes, and the list is long enough, then it's unnecessary to add it.
+		if entry.Bytes < cnt.opts.PrefixMinBytes && len(largestKeyPrefixesByType[entry.Type]) > cnt.opts.PrefixesPerType {
 			continue
 		}
 		largestKeyPrefixesByType[entry.Type] = append(largestKeyPrefixesByType[entry.Type], entry)
 	}
 	data["LargestKeyPrefixes"] = largestKeyPrefixesByType
+	data["PrefixErrorBound"] = cnt.prefixErrorBound
 
 	data["TypeBytes"] = cnt.typeBytes
 	data["TypeNum"] = cnt.typeNum
+	data["TypeSizes"] = summaries(cnt.typeSizes)
+	data["TypeElems"] = summaries(cnt.typeElems)
 	totalNum := uint64(0)
 	for _, v := range cnt.typeNum {
 		totalNum += v
@@ -117,6 +192,37 @@ func getData(filename string, cnt *Counter) map[string]interface{} {
 	}
 	data["LenLevelCount"] = lenLevelCount
 
+	encodingCount := map[string][]*PrefixEntry{}
+	for _, entry := range cnt.GetEncodingCount() {
+		encodingCount[entry.Type] = append(encodingCount[entry.Type], entry)
+	}
+	data["EncodingCount"] = encodingCount
+	data["NearThresholds"] = cnt.GetNearThresholds()
+	data["LargestElements"] = cnt.GetLargestElements(cnt.opts.TopN)
+	data["SkewedKeys"] = cnt.GetSkewedEntries(cnt.opts.TopN)
+	data["SkewedNum"] = cnt.skewedNum
+	data["SkewedBytes"] = cnt.skewedBytes
+	data["ContentClasses"] = cnt.GetContentClasses()
+	data["ContentPrefixes"] = cnt.GetContentPrefixes(cnt.opts.TopN)
+
+	ttlCount := map[string][]*PrefixEntry{}
+	for _, entry := range cnt.GetTTLCount() {
+		ttlCount[entry.Type] = append(ttlCount[entry.Type], entry)
+	}
+	data["TTLCount"] = ttlCount
+
+	idleCount := map[string][]*PrefixEntry{}
+	for _, entry := range cnt.GetIdleCount() {
+		idleCount[entry.Type] = append(idleCount[entry.Type], entry)
+	}
+	data["IdleCount"] = idleCount
+
+	freqCount := map[string][]*PrefixEntry{}
+	for _, entry := range cnt.GetFreqCount() {
+		freqCount[entry.Type] = append(freqCount[entry.Type], entry)
+	}
+	data["FreqCount"] = freqCount
+
 	var slotBytesHeap slotHeap
 	for slot, length := range cnt.slotBytes {
 		heap.Push(&slotBytesHeap, &SlotEntry{
@@ -131,7 +237,7 @@ func getData(filename string, cnt *Counter) map[string]interface{} {
 		})
 	}
 
-	topN := 100
+	topN := cnt.opts.TopN
 	slotBytes := make(slotHeap, 0, topN)
 	slotNums := make(slotHeap, 0, topN)
 
@@ -153,6 +259,8 @@ func getData(filename string, cnt *Counter) map[string]interface{} {
 
 	data["SlotBytes"] = slotBytes
 	data["SlotNums"] = slotNums
+	data["Options"] = cnt.opts
+	data["KeyTemplates"] = cnt.GetKeyTemplates()
 
 	return data
 }
@@ -0,0 +1,82 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/xueqiu/rdr/decoder"
+)
+
+// collectionTypes may have one element taking most of a key
+var collectionTypes = map[string]bool{"hash": true, "set": true, "list": true, "sortedset": true}
+
+// countSkewed counts e if it is a collection whose largest element takes
+// more than SkewThreshold of its bytes, the largest of these are kept
+func (c *Counter) countSkewed(e *decoder.Entry) {
+	t := c.opts.SkewThreshold
+	if t <= 0 || !collectionTypes[e.Type] || e.NumOfElem < 2 {
+		return
+	}
+	if float64(e.LenOfLargestElem) <= t*float64(e.Bytes) {
+		return
+	}
+	c.skewedNum++
+	c.skewedBytes += e.Bytes
+	heap.Push(c.skewedEntries, e)
+	if c.skewedEntries.Len() > c.opts.LargestKeys {
+		heap.Pop(c.skewedEntries)
+	}
+}
+
+// SetLargestElements set the largest elements of collections from the
+// decoder, ordered by length, and those of every database. The decoder keeps
+// the largest of each database, so a small database has its own.
+func (c *Counter) SetLargestElements(elems []*decoder.Element) {
+	num := c.opts.largestElements()
+	for n, dc := range c.dbs {
+		dc.largestElements = []*decoder.Element{}
+		for _, elem := range elems {
+			if elem.DB == n && len(dc.largestElements) < num {
+				dc.largestElements = append(dc.largestElements, elem)
+			}
+		}
+	}
+	if len(elems) > num {
+		elems = elems[:num]
+	}
+	c.largestElements = elems
+}
+
+// GetLargestElements get the num largest elements of collections
+func (c *Counter) GetLargestElements(num int) []*decoder.Element {
+	res := c.largestElements
+	if num < len(res) {
+		res = res[:num]
+	}
+	return res
+}
+
+// GetSkewedEntries get the num largest collections with one element taking
+// more than SkewThreshold of their bytes
+func (c *Counter) GetSkewedEntries(num int) []*decoder.Entry {
+	res := append([]*decoder.Entry{}, *c.skewedEntries...)
+	sort.Sort(sort.Reverse(entryHeap(res)))
+	if num < len(res) {
+		res = res[:num]
+	}
+	return res
+}
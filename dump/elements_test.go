@@ -0,0 +1,85 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func TestCounterSkewed(t *testing.T) {
+	in := make(chan *decoder.Entry, 5)
+	in <- &decoder.Entry{Key: "h1", Type: "hash", NumOfElem: 10, LenOfLargestElem: 900, Bytes: 1000}
+	in <- &decoder.Entry{Key: "h2", Type: "hash", NumOfElem: 10, LenOfLargestElem: 100, Bytes: 1000}
+	in <- &decoder.Entry{Key: "l1", Type: "list", NumOfElem: 3, LenOfLargestElem: 1500, Bytes: 2000, DB: 1}
+	in <- &decoder.Entry{Key: "one", Type: "set", NumOfElem: 1, LenOfLargestElem: 90, Bytes: 100}
+	in <- &decoder.Entry{Key: "s", Type: "string", NumOfElem: 900, Bytes: 1000}
+	close(in)
+	c := NewCounter(nil)
+	c.SetFullDB(1)
+	c.Count(in)
+	c.SetLargestElements([]*decoder.Element{
+		{Key: "l1", Type: "list", DB: 1, Len: 1500},
+		{Key: "h1", Type: "hash", Len: 900},
+	})
+
+	assert.Equal(t, uint64(2), c.skewedNum)
+	assert.Equal(t, uint64(3000), c.skewedBytes)
+	skewed := c.GetSkewedEntries(10)
+	assert.Len(t, skewed, 2)
+	assert.Equal(t, "l1", skewed[0].Key)
+	assert.Len(t, c.GetSkewedEntries(1), 1)
+	assert.Len(t, c.GetLargestElements(10), 2)
+	assert.Len(t, c.GetLargestElements(1), 1)
+
+	dc := c.selectDB(1)
+	assert.Equal(t, uint64(1), dc.skewedNum)
+	assert.Equal(t, "l1", dc.GetLargestElements(10)[0].Key)
+	assert.Len(t, c.selectDB(0).GetLargestElements(10), 1)
+
+	// a database has its own largest elements beyond those of the instance
+	opts := DefaultAnalysisOptions()
+	opts.LargestKeys = 1
+	opts.TopN = 1
+	in = make(chan *decoder.Entry, 2)
+	in <- &decoder.Entry{Key: "l1", Type: "list", DB: 1}
+	in <- &decoder.Entry{Key: "h1", Type: "hash"}
+	close(in)
+	small := NewCounter(opts)
+	small.Count(in)
+	small.SetLargestElements([]*decoder.Element{
+		{Key: "l1", Type: "list", DB: 1, Len: 1500},
+		{Key: "h1", Type: "hash", Len: 900},
+	})
+	assert.Len(t, small.GetLargestElements(10), 1)
+	assert.Equal(t, "h1", small.selectDB(0).GetLargestElements(10)[0].Key)
+
+	// elements and skewed keys are kept with the result
+	r := c.result().counter()
+	assert.Equal(t, c.GetLargestElements(10), r.GetLargestElements(10))
+	assert.Equal(t, skewed, r.GetSkewedEntries(10))
+	assert.Equal(t, c.skewedBytes, r.skewedBytes)
+	assert.Equal(t, dc.GetLargestElements(10), r.selectDB(1).GetLargestElements(10))
+
+	opts = DefaultAnalysisOptions()
+	opts.SkewThreshold = 0
+	c = NewCounter(opts)
+	c.count(&decoder.Entry{Key: "h1", Type: "hash", NumOfElem: 10, LenOfLargestElem: 900, Bytes: 1000})
+	assert.Empty(t, c.GetSkewedEntries(10))
+	opts.SkewThreshold = 1.5
+	assert.Error(t, opts.validate())
+}
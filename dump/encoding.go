@@ -0,0 +1,193 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"sort"
+
+	"github.com/xueqiu/rdr/decoder"
+)
+
+// nearThresholdKeys is the number of largest keys kept of each
+// NearThreshold
+const nearThresholdKeys = 5
+
+// EncodingLimits are the configs of the instance limiting the entries and
+// the length of elements of packed encodings
+type EncodingLimits struct {
+	// HashEntries and HashValue are hash-max-ziplist-entries and
+	// hash-max-ziplist-value, or the listpack ones since 7.0
+	HashEntries uint64 `json:"hash_max_entries" yaml:"hash_max_entries"`
+	HashValue   uint64 `json:"hash_max_value" yaml:"hash_max_value"`
+	// ZsetEntries and ZsetValue are zset-max-ziplist-entries and
+	// zset-max-ziplist-value, or the listpack ones since 7.0
+	ZsetEntries uint64 `json:"zset_max_entries" yaml:"zset_max_entries"`
+	ZsetValue   uint64 `json:"zset_max_value" yaml:"zset_max_value"`
+	// SetIntsetEntries is set-max-intset-entries
+	SetIntsetEntries uint64 `json:"set_max_intset_entries" yaml:"set_max_intset_entries"`
+	// SetEntries and SetValue are set-max-listpack-entries and
+	// set-max-listpack-value of 7.2
+	SetEntries uint64 `json:"set_max_entries" yaml:"set_max_entries"`
+	SetValue   uint64 `json:"set_max_value" yaml:"set_max_value"`
+}
+
+// defaultEncodingLimits are those of redis.conf
+func defaultEncodingLimits() EncodingLimits {
+	return EncodingLimits{
+		HashEntries:      128,
+		HashValue:        64,
+		ZsetEntries:      128,
+		ZsetValue:        64,
+		SetIntsetEntries: 512,
+		SetEntries:       128,
+		SetValue:         64,
+	}
+}
+
+// NearThreshold counts collections near a limit of packed encodings. Packed
+// ones are near it below, they are converted once they grow past it. Ones
+// in a hashtable or skiplist are near it above, raising the limit a little
+// packs them.
+type NearThreshold struct {
+	Type     string
+	Encoding string
+	// Limit is the config, e.g. hash-max-ziplist-entries, of value Value
+	Limit string
+	Value uint64
+	Above bool
+	Num   uint64
+	Bytes uint64
+	// Keys are the largest collections near the limit
+	Keys []*decoder.Entry
+}
+
+type nearKey struct {
+	Type     string
+	Encoding string
+	Limit    string
+	Above    bool
+}
+
+// packedEncodings of collections, limited by EncodingLimits
+var packedEncodings = map[string]bool{"ziplist": true, "listpack": true, "intset": true}
+
+// nearLimit get the config limit e is near, "" if none. Collections are
+// near an entries limit within a fraction near of it. A hashtable or
+// skiplist with no more entries than the limit was converted for a long
+// element, it is near the value limit if its largest element, a field and
+// value for hashes, is within near of it. Sets in a hashtable are compared
+// with the intset limit only, as sets of other than integers are packed
+// since 7.2 only.
+func nearLimit(e *decoder.Entry, l *EncodingLimits, near float64) (limit string, value uint64) {
+	var entries, maxValue uint64
+	var entriesName, valueName string
+	switch e.Type {
+	case "hash":
+		entries, maxValue = l.HashEntries, l.HashValue
+		entriesName, valueName = "hash-max-ziplist-entries", "hash-max-ziplist-value"
+	case "sortedset":
+		entries, maxValue = l.ZsetEntries, l.ZsetValue
+		entriesName, valueName = "zset-max-ziplist-entries", "zset-max-ziplist-value"
+	case "set":
+		if e.Encoding == "listpack" {
+			entries, maxValue = l.SetEntries, l.SetValue
+			entriesName, valueName = "set-max-listpack-entries", "set-max-listpack-value"
+		} else {
+			entries = l.SetIntsetEntries
+			entriesName = "set-max-intset-entries"
+		}
+	default:
+		return "", 0
+	}
+
+	n := float64(e.NumOfElem)
+	switch {
+	case packedEncodings[e.Encoding]:
+		if n >= float64(entries)*(1-near) && n <= float64(entries) {
+			return entriesName, entries
+		}
+	case n > float64(entries):
+		if n <= float64(entries)*(1+near) {
+			return entriesName, entries
+		}
+	case valueName != "":
+		l := float64(e.LenOfLargestElem)
+		if l > float64(maxValue) && l <= float64(maxValue)*(1+near) {
+			return valueName, maxValue
+		}
+	}
+	return "", 0
+}
+
+func (c *Counter) countByEncoding(e *decoder.Entry) {
+	if e.Encoding == "" {
+		return
+	}
+	key := typeKey{Type: e.Type, Key: e.Encoding}
+	c.encodingBytes[key] += e.Bytes
+	c.encodingNum[key]++
+
+	limit, value := nearLimit(e, &c.opts.EncodingLimits, c.opts.NearThreshold)
+	if limit == "" {
+		return
+	}
+	above := !packedEncodings[e.Encoding]
+	nk := nearKey{Type: e.Type, Encoding: e.Encoding, Limit: limit, Above: above}
+	nt, ok := c.nearThresholds[nk]
+	if !ok {
+		nt = &NearThreshold{Type: e.Type, Encoding: e.Encoding, Limit: limit, Value: value, Above: above}
+		c.nearThresholds[nk] = nt
+	}
+	nt.Num++
+	nt.Bytes += e.Bytes
+	nt.addKey(e)
+}
+
+// addKey keeps e if it is one of the largest keys of nt
+func (nt *NearThreshold) addKey(e *decoder.Entry) {
+	i := sort.Search(len(nt.Keys), func(i int) bool { return nt.Keys[i].Bytes < e.Bytes })
+	if i >= nearThresholdKeys {
+		return
+	}
+	if len(nt.Keys) < nearThresholdKeys {
+		nt.Keys = append(nt.Keys, nil)
+	}
+	copy(nt.Keys[i+1:], nt.Keys[i:])
+	nt.Keys[i] = e
+}
+
+// GetEncodingCount get keys and bytes of every type by encoding
+func (c *Counter) GetEncodingCount() []*PrefixEntry {
+	res := typeKeyEntries(c.encodingBytes, c.encodingNum)
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Type != res[j].Type {
+			return res[i].Type < res[j].Type
+		}
+		return res[i].Bytes > res[j].Bytes
+	})
+	return res
+}
+
+// GetNearThresholds get collections near encoding limits, ordered by bytes
+func (c *Counter) GetNearThresholds() []*NearThreshold {
+	res := []*NearThreshold{}
+	for _, nt := range c.nearThresholds {
+		res = append(res, nt)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Bytes > res[j].Bytes
+	})
+	return res
+}
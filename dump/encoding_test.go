@@ -0,0 +1,83 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func TestNearLimit(t *testing.T) {
+	l := defaultEncodingLimits()
+	cases := []struct {
+		e     decoder.Entry
+		limit string
+	}{
+		{decoder.Entry{Type: "hash", Encoding: "ziplist", NumOfElem: 120}, "hash-max-ziplist-entries"},
+		{decoder.Entry{Type: "hash", Encoding: "listpack", NumOfElem: 128}, "hash-max-ziplist-entries"},
+		{decoder.Entry{Type: "hash", Encoding: "listpack", NumOfElem: 50}, ""},
+		{decoder.Entry{Type: "hash", Encoding: "hashtable", NumOfElem: 140}, "hash-max-ziplist-entries"},
+		{decoder.Entry{Type: "hash", Encoding: "hashtable", NumOfElem: 1000}, ""},
+		{decoder.Entry{Type: "hash", Encoding: "hashtable", NumOfElem: 10, LenOfLargestElem: 70}, "hash-max-ziplist-value"},
+		{decoder.Entry{Type: "hash", Encoding: "hashtable", NumOfElem: 10, LenOfLargestElem: 700}, ""},
+		{decoder.Entry{Type: "sortedset", Encoding: "skiplist", NumOfElem: 150}, "zset-max-ziplist-entries"},
+		{decoder.Entry{Type: "set", Encoding: "intset", NumOfElem: 500}, "set-max-intset-entries"},
+		{decoder.Entry{Type: "set", Encoding: "listpack", NumOfElem: 110}, "set-max-listpack-entries"},
+		{decoder.Entry{Type: "set", Encoding: "hashtable", NumOfElem: 10, LenOfLargestElem: 70}, ""},
+		{decoder.Entry{Type: "list", Encoding: "quicklist", NumOfElem: 128}, ""},
+	}
+	for _, cs := range cases {
+		limit, _ := nearLimit(&cs.e, &l, 0.2)
+		assert.Equal(t, cs.limit, limit, "%+v", cs.e)
+	}
+}
+
+func TestCounterEncoding(t *testing.T) {
+	in := make(chan *decoder.Entry)
+	go func() {
+		for i := 0; i < 10; i++ {
+			in <- &decoder.Entry{Key: fmt.Sprintf("h%d", i), Type: "hash", Encoding: "hashtable", NumOfElem: 130, Bytes: uint64(100 + i)}
+			in <- &decoder.Entry{Key: fmt.Sprintf("z%d", i), Type: "hash", Encoding: "ziplist", NumOfElem: 10, Bytes: 10}
+		}
+		in <- &decoder.Entry{Key: "s", Type: "string", Encoding: "embstr", Bytes: 5}
+		close(in)
+	}()
+	c := NewCounter(nil)
+	c.Count(in)
+
+	encodings := map[string]uint64{}
+	for _, e := range c.GetEncodingCount() {
+		encodings[e.Type+"/"+e.Key] = e.Num
+	}
+	assert.Equal(t, map[string]uint64{"hash/hashtable": 10, "hash/ziplist": 10, "string/embstr": 1}, encodings)
+
+	near := c.GetNearThresholds()
+	assert.Len(t, near, 1)
+	assert.Equal(t, "hash-max-ziplist-entries", near[0].Limit)
+	assert.Equal(t, uint64(128), near[0].Value)
+	assert.True(t, near[0].Above)
+	assert.Equal(t, uint64(10), near[0].Num)
+	assert.Len(t, near[0].Keys, nearThresholdKeys)
+	assert.Equal(t, "h9", near[0].Keys[0].Key)
+	assert.Equal(t, "h5", near[0].Keys[4].Key)
+
+	// encodings are kept with the result
+	r := c.result().counter()
+	assert.Equal(t, c.GetEncodingCount(), r.GetEncodingCount())
+	assert.Equal(t, c.GetNearThresholds(), r.GetNearThresholds())
+}
@@ -0,0 +1,253 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// GroupRule groups keys matching a template or a regex under one name.
+// A template is a glob, * matching any characters and ? one, with
+// placeholders like {uuid}, {hex}, {base64}, {email}, {ts}, {date}, {int}
+// and date layouts like {yyyyMMdd}.
+type GroupRule struct {
+	Template string `json:"template,omitempty" yaml:"template,omitempty"`
+	Regex    string `json:"regex,omitempty" yaml:"regex,omitempty"`
+	// Name of the group, $1 and such are replaced by submatches of Regex.
+	// It is the template or the regex if empty.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// tokenDetector finds a kind of id in keys, replaced by {name}
+type tokenDetector struct {
+	name  string
+	re    *regexp.Regexp
+	valid func(s string) bool
+}
+
+// tokenPatterns are the regexes of placeholders in templates
+var tokenPatterns = map[string]string{
+	"uuid":   `[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`,
+	"email":  `[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`,
+	"ts":     `1[0-9]{9}(?:[0-9]{3})?`,
+	"date":   `(?:19|20)[0-9]{2}-?(?:0[1-9]|1[0-2])-?(?:0[1-9]|[12][0-9]|3[01])`,
+	"hex":    `[0-9a-fA-F]{16,}`,
+	"base64": `[A-Za-z0-9+/]{16,}={0,2}`,
+	"int":    `[0-9]+`,
+}
+
+// tokenDetectors in the order they are tried, timestamps are seconds or
+// milliseconds since 2001, hex ids have a letter and a digit and base64 ids
+// have both cases and a digit, or padding
+var tokenDetectors = []*tokenDetector{
+	newTokenDetector("uuid", nil),
+	newTokenDetector("email", nil),
+	newTokenDetector("ts", nil),
+	newTokenDetector("date", nil),
+	newTokenDetector("hex", func(s string) bool {
+		return strings.ContainsAny(s, "0123456789") && strings.ContainsAny(s, "abcdefABCDEF")
+	}),
+	newTokenDetector("base64", func(s string) bool {
+		if strings.HasSuffix(s, "=") {
+			return true
+		}
+		return strings.ContainsAny(s, "0123456789") &&
+			strings.ContainsAny(s, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") &&
+			strings.ContainsAny(s, "abcdefghijklmnopqrstuvwxyz")
+	}),
+}
+
+func newTokenDetector(name string, valid func(s string) bool) *tokenDetector {
+	return &tokenDetector{name: name, re: regexp.MustCompile(tokenPatterns[name]), valid: valid}
+}
+
+// replace every token in key found by d, a token is not part of a longer
+// run of letters and digits
+func (d *tokenDetector) replace(key string) string {
+	matches := d.re.FindAllStringIndex(key, -1)
+	if matches == nil {
+		return key
+	}
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		s, e := m[0], m[1]
+		if s > 0 && isAlnum(key[s-1]) || e < len(key) && isAlnum(key[e]) {
+			continue
+		}
+		if d.valid != nil && !d.valid(key[s:e]) {
+			continue
+		}
+		b.WriteString(key[last:s])
+		b.WriteString("{" + d.name + "}")
+		last = e
+	}
+	if last == 0 {
+		return key
+	}
+	b.WriteString(key[last:])
+	return b.String()
+}
+
+func isAlnum(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// dateLayout matches placeholders of date layouts like {yyyy-MM-dd}
+var dateLayout = regexp.MustCompile(`^[yMdHhms]+(?:[-_.:/ ]?[yMdHhms]+)*$`)
+
+// templateRegex compiles a template into an anchored regex
+func templateRegex(template string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(template); i++ {
+		switch c := template[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '{':
+			end := strings.IndexByte(template[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("template %q: unclosed {", template)
+			}
+			name := template[i+1 : i+end]
+			if p, ok := tokenPatterns[name]; ok {
+				b.WriteString("(?:" + p + ")")
+			} else if dateLayout.MatchString(name) {
+				for _, r := range name {
+					if strings.ContainsRune("yMdHhms", r) {
+						b.WriteString("[0-9]")
+					} else {
+						b.WriteString(regexp.QuoteMeta(string(r)))
+					}
+				}
+			} else {
+				return nil, fmt.Errorf("template %q: unknown placeholder {%s}", template, name)
+			}
+			i += end
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
+
+// compiledRule is a GroupRule ready to match keys
+type compiledRule struct {
+	re   *regexp.Regexp
+	name string
+	// expand is set if name has submatches of re
+	expand bool
+}
+
+func compileRule(r GroupRule) (*compiledRule, error) {
+	if (r.Template == "") == (r.Regex == "") {
+		return nil, errors.New("a group rule must have either a template or a regex")
+	}
+	if r.Template != "" {
+		re, err := templateRegex(r.Template)
+		if err != nil {
+			return nil, err
+		}
+		name := r.Name
+		if name == "" {
+			name = r.Template
+		}
+		return &compiledRule{re: re, name: name}, nil
+	}
+	re, err := regexp.Compile(r.Regex)
+	if err != nil {
+		return nil, fmt.Errorf("regex %q: %v", r.Regex, err)
+	}
+	if r.Name == "" {
+		return &compiledRule{re: re, name: r.Regex}, nil
+	}
+	return &compiledRule{re: re, name: r.Name, expand: strings.Contains(r.Name, "$")}, nil
+}
+
+// keyGrouper turns keys into the names their prefixes are counted by
+type keyGrouper struct {
+	rules        []*compiledRule
+	detectTokens bool
+	separators   string
+}
+
+// newKeyGrouper compiles the group rules of opts
+func newKeyGrouper(opts *AnalysisOptions) (*keyGrouper, error) {
+	g := &keyGrouper{detectTokens: opts.DetectTokens, separators: opts.Separators}
+	for _, r := range opts.GroupRules {
+		cr, err := compileRule(r)
+		if err != nil {
+			return nil, err
+		}
+		g.rules = append(g.rules, cr)
+	}
+	return g, nil
+}
+
+// group get the group name of key: the name of the first rule matching it,
+// or else key with tokens replaced by placeholders and digits by 0. whole
+// reports whether the name is a group itself, as the name of a rule or a
+// key ending with a token is, rather than only its prefixes.
+func (g *keyGrouper) group(key string) (name string, whole bool) {
+	for _, r := range g.rules {
+		if !r.expand {
+			if r.re.MatchString(key) {
+				return r.name, true
+			}
+			continue
+		}
+		if m := r.re.FindStringSubmatchIndex(key); m != nil {
+			return string(r.re.ExpandString(nil, r.name, key, m)), true
+		}
+	}
+	if !g.detectTokens {
+		return resetDigits(key), false
+	}
+	for _, d := range tokenDetectors {
+		key = d.replace(key)
+	}
+	last := key[strings.LastIndexAny(key, g.separators)+1:]
+	whole = last != "" && placeholders.FindString(last) == last
+
+	// placeholders keep their digits, a {base64} is not a {base00}
+	var b strings.Builder
+	prev := 0
+	for _, m := range placeholders.FindAllStringIndex(key, -1) {
+		b.WriteString(resetDigits(key[prev:m[0]]))
+		b.WriteString(key[m[0]:m[1]])
+		prev = m[1]
+	}
+	b.WriteString(resetDigits(key[prev:]))
+	return b.String(), whole
+}
+
+// placeholders matches what tokenDetectors replace tokens by
+var placeholders = regexp.MustCompile(`\{(?:uuid|email|ts|date|hex|base64)\}`)
+
+// resetDigits reset all numbers to 0
+func resetDigits(s string) string {
+	return strings.Map(func(c rune) rune {
+		if c >= 48 && c <= 57 { //48 == "0" 57 == "9"
+			return '0'
+		}
+		return c
+	}, s)
+}
@@ -0,0 +1,145 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func TestDetectTokens(t *testing.T) {
+	g := &keyGrouper{detectTokens: true, separators: ":;,_- "}
+	for key, group := range map[string]string{
+		"session:3f2b8c1e-9a4d-4e7b-8c2a-1d5e6f7a8b9c": "session:{uuid}",
+		"user:alice.smith@example.com:profile":         "user:{email}:profile",
+		"login:1700000000":                             "login:{ts}",
+		"event:1700000000123:clicks":                   "event:{ts}:clicks",
+		"order:20240131:42":                            "order:{date}:00",
+		"order:2024-01-31":                             "order:{date}",
+		"obj:5f8d0c1b2a3e4f5061728394":                 "obj:{hex}",
+		"md5_d41d8cd98f00b204e9800998ecf8427e":         "md0_{hex}",
+		"token:QWxhZGRpbjpvcGVuIHNlc2FtZQ==":           "token:{base64}",
+		"token:dGhpc0lzQVRva2VuV2l0aDEyMw":             "token:{base64}",
+		"RELATIONSFOLLOWERIDS6420000664":               "RELATIONSFOLLOWERIDS0000000000",
+		"cache:deadbeefdeadbeefx":                      "cache:deadbeefdeadbeefx",
+		"counter:1234567890123456":                     "counter:0000000000000000",
+		"ThisIsALongCamelCaseName":                     "ThisIsALongCamelCaseName",
+	} {
+		name, _ := g.group(key)
+		assert.Equal(t, group, name, key)
+	}
+	_, whole := g.group("session:3f2b8c1e-9a4d-4e7b-8c2a-1d5e6f7a8b9c")
+	assert.True(t, whole)
+	_, whole = g.group("user:alice.smith@example.com:profile")
+	assert.False(t, whole)
+
+	// only digits are reset without detecting tokens
+	g = &keyGrouper{}
+	name, whole := g.group("session:3f2b8c1e-9a4d-4e7b-8c2a-1d5e6f7a8b9c")
+	assert.Equal(t, "session:0f0b0c0e-0a0d-0e0b-0c0a-0d0e0f0a0b0c", name)
+	assert.False(t, whole)
+}
+
+func TestGroupRules(t *testing.T) {
+	g, err := newKeyGrouper(&AnalysisOptions{GroupRules: []GroupRule{
+		{Template: "session:{uuid}"},
+		{Template: "order:{yyyyMMdd}:*"},
+		{Template: "log:{yyyy-MM-dd}:?"},
+		{Regex: `^cache:v[0-9]+:([a-z]+):`, Name: "cache:$1"},
+		{Regex: `^tmp:`},
+	}})
+	assert.NoError(t, err)
+	for key, group := range map[string]string{
+		"session:3f2b8c1e-9a4d-4e7b-8c2a-1d5e6f7a8b9c": "session:{uuid}",
+		"session:abc":             "session:abc",
+		"order:20240131:42:items": "order:{yyyyMMdd}:*",
+		"order:2024013:42":        "order:0000000:00",
+		"log:2024-01-31:a":        "log:{yyyy-MM-dd}:?",
+		"cache:v12:user:42":       "cache:user",
+		"tmp:x.y":                 "^tmp:",
+	} {
+		name, _ := g.group(key)
+		assert.Equal(t, group, name, key)
+	}
+
+	for _, r := range []GroupRule{
+		{},
+		{Template: "a", Regex: "b"},
+		{Template: "a:{nope}"},
+		{Template: "a:{uuid"},
+		{Regex: "("},
+	} {
+		_, err := newKeyGrouper(&AnalysisOptions{GroupRules: []GroupRule{r}})
+		assert.Error(t, err, "%+v", r)
+	}
+}
+
+func TestCountByKeyGroup(t *testing.T) {
+	opts := DefaultAnalysisOptions()
+	opts.GroupRules = []GroupRule{{Template: "order:{yyyyMMdd}:*"}}
+	opts.DetectTokens = true
+	c := NewCounter(opts)
+	in := make(chan *decoder.Entry, 4)
+	in <- &decoder.Entry{Key: "session:3f2b8c1e-9a4d-4e7b-8c2a-1d5e6f7a8b9c", Type: "string", Bytes: 10}
+	in <- &decoder.Entry{Key: "session:0c9d3a6b-1e2f-4a5b-9c8d-7e6f5a4b3c2d", Type: "string", Bytes: 20}
+	in <- &decoder.Entry{Key: "order:20240131:1", Type: "hash", Bytes: 30}
+	in <- &decoder.Entry{Key: "order:20240201:2", Type: "hash", Bytes: 40}
+	close(in)
+	c.Count(in)
+
+	prefixes := map[string]uint64{}
+	for _, p := range c.GetLargestKeyPrefixes() {
+		prefixes[p.Key] = p.Num
+	}
+	assert.Equal(t, map[string]uint64{
+		"session":            2,
+		"session:{uuid}":     2,
+		"order":              2,
+		"order:{yyyyMMdd}":   2,
+		"order:{yyyyMMdd}:*": 2,
+	}, prefixes)
+
+	patterns := map[string]string{}
+	for _, p := range NewOpsAnalyzer(c).keyPatterns {
+		patterns[p.Pattern] = p.Example
+	}
+	assert.Equal(t, "order:20240201:2", patterns["order:{yyyyMMdd}:*"])
+	assert.Equal(t, "session:0c9d3a6b-1e2f-4a5b-9c8d-7e6f5a4b3c2d", patterns["session:{uuid}"])
+}
+
+func TestLoadGroupRules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rules")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "rules.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("- template: \"session:{uuid}\"\n- regex: \"^cache:(\\\\w+)\"\n  name: \"cache:$1\"\n"), 0644))
+
+	rules, err := LoadGroupRules(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []GroupRule{
+		{Template: "session:{uuid}"},
+		{Regex: `^cache:(\w+)`, Name: "cache:$1"},
+	}, rules)
+
+	path = filepath.Join(dir, "rdr.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("group_rules:\n  - template: \"a:{nope}\"\n"), 0644))
+	_, err = LoadAnalysisOptions(path)
+	assert.Error(t, err)
+}
@@ -17,6 +17,11 @@ type HistoryEntry struct {
 	FileSize    int64     `json:"file_size"`
 	TotalKeys   uint64    `json:"total_keys"`
 	TotalMemory uint64    `json:"total_memory"`
+	// ChecksumMismatch is set if the CRC64 checksum of the rdb does not match
+	ChecksumMismatch bool `json:"checksum_mismatch,omitempty"`
+	// Partial is set if corrupted objects are skipped in salvage mode
+	Partial      bool  `json:"partial,omitempty"`
+	SkippedBytes int64 `json:"skipped_bytes,omitempty"`
 }
 
 // HistoryManager manages analysis history
@@ -0,0 +1,183 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/dongmx/rdb"
+	"github.com/julienschmidt/httprouter"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+// Job is a parse running in the background of the web server, its id is
+// the name of the instance being parsed
+type Job struct {
+	ID       string
+	ctx      context.Context
+	cancel   context.CancelFunc
+	progress *ParseProgress
+	// file is the uploaded file parsed by the job, "" if there is none
+	file string
+	// db is the database the job counts in full into the saved counter of
+	// the instance, -1 if it parses the whole instance
+	db int
+	// checksumErr is set when every key is decoded but the checksum of the
+	// rdb does not match
+	checksumErr *rdb.ChecksumError
+}
+
+var (
+	jobs      = make(map[string]*Job)
+	jobsMutex sync.Mutex
+
+	// cleanupCancelled removes the progress tracker and the uploaded file
+	// of cancelled jobs, set by the --cleanup flag of web
+	cleanupCancelled bool
+	// salvageMode decodes uploaded rdb files in salvage mode, set by the
+	// --salvage flag of web
+	salvageMode bool
+	// redisVersion pins the memory model of jobs to a redis version, set by
+	// the --redis-version flag of web
+	redisVersion string
+	// jobOptions are the analysis options of jobs, set by the flags and
+	// the config file of web
+	jobOptions *AnalysisOptions
+)
+
+// startJob registers a cancellable job for the parse tracked by pp
+func startJob(id, file string, pp *ParseProgress) *Job {
+	ctx, cancel := context.WithCancel(context.Background())
+	j := &Job{
+		ID:       id,
+		ctx:      ctx,
+		cancel:   cancel,
+		progress: pp,
+		file:     file,
+		db:       -1,
+	}
+	jobsMutex.Lock()
+	jobs[id] = j
+	jobsMutex.Unlock()
+	return j
+}
+
+// newJobDecoder creates a decoder using the memory model of redisVersion,
+// inspecting values as jobOptions sets
+func newJobDecoder() *decoder.Decoder {
+	dec := newDecoder(jobOptions)
+	if redisVersion != "" {
+		// checked when web starts
+		dec.SetRedisVersion(redisVersion)
+	}
+	return dec
+}
+
+// getJob get a running job by id, nil if there is none
+func getJob(id string) *Job {
+	jobsMutex.Lock()
+	defer jobsMutex.Unlock()
+	return jobs[id]
+}
+
+// Cancel stops the job while it is parsing, decoding stops before the next
+// object. It reports false if the job is already saving its result or has
+// ended.
+func (j *Job) Cancel() bool {
+	if !j.progress.compareAndSetStatus("parsing", "cancelled") {
+		return false
+	}
+	j.progress.AddLog("Cancelled by user")
+	j.cancel()
+	return true
+}
+
+// fail marks the job as failed by err, or as cancelled if err is caused by
+// cancelling it
+func (j *Job) fail(step string, err error) {
+	if j.ctx.Err() != nil {
+		j.progress.SetStatus("cancelled")
+		return
+	}
+	log.Printf("Error in %s of %v: %v", step, j.ID, err)
+	j.progress.SetError(fmt.Sprintf("%s failed: %v", step, err))
+	j.progress.AddLog(fmt.Sprintf("ERROR: %v", err))
+}
+
+// decodeFailed handles the error of decoding a rdb. A checksum mismatch is
+// only a warning as every key has been decoded, the result is still saved.
+func (j *Job) decodeFailed(err error) {
+	if cerr, ok := err.(*rdb.ChecksumError); ok {
+		log.Printf("Checksum of %v does not match: %v", j.ID, cerr)
+		j.checksumErr = cerr
+		j.progress.AddLog(fmt.Sprintf("WARNING: %v", cerr))
+		return
+	}
+	j.fail("Decode", err)
+}
+
+// finish unregisters the job when it has stopped. A cancelled parse drops
+// its partial counter, and with cleanupCancelled its progress tracker and
+// uploaded file as well.
+func (j *Job) finish() {
+	jobsMutex.Lock()
+	delete(jobs, j.ID)
+	jobsMutex.Unlock()
+	j.cancel()
+
+	// a cancelled recount of a database leaves the instance as it was
+	if !j.progress.Cancelled() || j.db >= 0 {
+		return
+	}
+	counters.Delete(j.ID)
+	if resultsDir != "" {
+		os.Remove(resultPath(j.ID))
+	}
+	if !cleanupCancelled {
+		return
+	}
+	removeProgress(j.ID)
+	if j.file != "" {
+		if err := os.Remove(j.file); err != nil {
+			log.Printf("Error removing file of cancelled job %v: %v", j.ID, err)
+		}
+	}
+}
+
+// cancelJobHandler cancels the job given by id
+func cancelJobHandler(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	j := getJob(p.ByName("id"))
+	if j == nil {
+		respondWithError(w, "任务不存在或已结束", http.StatusNotFound)
+		return
+	}
+	if !j.Cancel() {
+		respondWithError(w, "任务已完成，无法取消", http.StatusConflict)
+		return
+	}
+	json.NewEncoder(w).Encode(UploadResponse{
+		Success:   true,
+		Message:   "任务已取消",
+		Instances: []string{j.ID},
+	})
+}
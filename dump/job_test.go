@@ -0,0 +1,112 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func TestCancelJob(t *testing.T) {
+	router := httprouter.New()
+	router.DELETE("/api/jobs/:id", cancelJobHandler)
+
+	f, err := ioutil.TempFile("", "job_test")
+	assert.NoError(t, err)
+	f.Close()
+	defer os.Remove(f.Name())
+
+	pp := NewParseProgress("job_test")
+	pp.SetStatus("parsing")
+	j := startJob("job_test", f.Name(), pp)
+	counters.Set("job_test", NewCounter(nil))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/api/jobs/job_test", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Error(t, j.ctx.Err())
+	assert.True(t, pp.Cancelled())
+
+	cleanupCancelled = true
+	defer func() { cleanupCancelled = false }()
+	j.finish()
+	assert.Nil(t, getJob("job_test"))
+	assert.Nil(t, GetProgress("job_test"))
+	assert.False(t, counters.Check("job_test"))
+	_, err = os.Stat(f.Name())
+	assert.True(t, os.IsNotExist(err))
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/api/jobs/job_test", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestFinishJobKeepsCompleted(t *testing.T) {
+	pp := NewParseProgress("job_completed")
+	j := startJob("job_completed", "", pp)
+	counters.Set("job_completed", NewCounter(nil))
+	pp.SetStatus("completed")
+
+	j.finish()
+	assert.Nil(t, getJob("job_completed"))
+	assert.True(t, counters.Check("job_completed"))
+	assert.NotNil(t, GetProgress("job_completed"))
+}
+
+func TestCancelFinishedJob(t *testing.T) {
+	router := httprouter.New()
+	router.DELETE("/api/jobs/:id", cancelJobHandler)
+
+	for _, status := range []string{"saving", "completed"} {
+		pp := NewParseProgress("job_finished")
+		pp.SetStatus(status)
+		j := startJob("job_finished", "", pp)
+		counters.Set("job_finished", NewCounter(nil))
+
+		// a job saving or having saved its result is not cancelled
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/api/jobs/job_finished", nil))
+		assert.Equal(t, http.StatusConflict, w.Code, status)
+		assert.NoError(t, j.ctx.Err())
+		assert.False(t, pp.Cancelled())
+
+		j.finish()
+		assert.True(t, counters.Check("job_finished"), status)
+		counters.Delete("job_finished")
+		removeProgress("job_finished")
+	}
+}
+
+func TestCountAndSaveCancelled(t *testing.T) {
+	pp := NewParseProgress("job_stopped")
+	defer removeProgress("job_stopped")
+	pp.SetStatus("parsing")
+	j := startJob("job_stopped", "", pp)
+	j.cancel()
+	dec := decoder.NewDecoder()
+	close(dec.Entries)
+
+	countAndSave(j, dec, "", 0)
+	assert.False(t, counters.Check("job_stopped"))
+	assert.Equal(t, "parsing", pp.GetData()["status"])
+	j.finish()
+}
@@ -0,0 +1,294 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xueqiu/rdr/decoder"
+)
+
+const (
+	// templateCardinality is the number of distinct tokens a position of
+	// keys may have before it becomes a wildcard
+	templateCardinality = 16
+	// maxTemplateTokens limits the positions of a key, the rest of a longer
+	// key is its last token
+	maxTemplateTokens = 16
+	// templateExamples is the number of example keys kept of a template
+	templateExamples = 3
+	// wildcard is the token of a position merged into a wildcard
+	wildcard = "*"
+)
+
+// KeyTemplate is a template of keys learned by mining, with * for the
+// positions that vary
+type KeyTemplate struct {
+	Template string
+	Num      uint64
+	Bytes    uint64
+	// TTLNum is the number of keys with an expiry, TTLShare their share
+	TTLNum   uint64
+	TTLShare float64
+	// Types is the number of keys of each type
+	Types    map[string]uint64
+	Examples []string
+}
+
+// templateGroup holds the templates of keys with the same separators
+type templateGroup struct {
+	seps []string
+	// values are the distinct tokens of each position, nil once the
+	// position is a wildcard
+	values    []map[string]struct{}
+	templates map[string]*minedTemplate
+}
+
+type minedTemplate struct {
+	tokens []string
+	// seq orders templates by creation, so merges keep the examples seen
+	// first
+	seq int
+	*KeyTemplate
+}
+
+// templateMiner learns key templates from a stream of keys, like mining
+// templates of log lines. Keys are split into tokens at separators and
+// grouped by their separators, a position of a group with more than
+// templateCardinality distinct tokens becomes a wildcard. When there are
+// more than max templates, the position with most tokens of the group with
+// most templates becomes a wildcard, so memory is bounded.
+type templateMiner struct {
+	separators string
+	max        int
+	groups     map[string]*templateGroup
+	// size is the number of templates of every group
+	size int
+	seq  int
+	// other counts keys with separators of no group when there are max
+	// groups already
+	other *KeyTemplate
+}
+
+func newTemplateMiner(separators string, max int) *templateMiner {
+	return &templateMiner{
+		separators: separators,
+		max:        max,
+		groups:     map[string]*templateGroup{},
+	}
+}
+
+// tokenize splits key into tokens at separators, numbers are wildcards
+// from the start
+func (m *templateMiner) tokenize(key string) (tokens, seps []string) {
+	for len(tokens) < maxTemplateTokens-1 {
+		i := strings.IndexAny(key, m.separators)
+		if i < 0 {
+			break
+		}
+		_, n := utf8.DecodeRuneInString(key[i:])
+		tokens = append(tokens, key[:i])
+		seps = append(seps, key[i:i+n])
+		key = key[i+n:]
+	}
+	tokens = append(tokens, key)
+	for i, t := range tokens {
+		if isNumber(t) {
+			tokens[i] = wildcard
+		}
+	}
+	return tokens, seps
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *templateMiner) add(e *decoder.Entry) {
+	tokens, seps := m.tokenize(e.Key)
+	gk := strings.Join(seps, "")
+	g, ok := m.groups[gk]
+	if !ok {
+		if len(m.groups) >= m.max {
+			if m.other == nil {
+				m.other = &KeyTemplate{Template: wildcard, Types: map[string]uint64{}}
+			}
+			countTemplate(m.other, e)
+			return
+		}
+		g = &templateGroup{
+			seps:      seps,
+			values:    make([]map[string]struct{}, len(tokens)),
+			templates: map[string]*minedTemplate{},
+		}
+		for i := range g.values {
+			g.values[i] = map[string]struct{}{}
+		}
+		m.groups[gk] = g
+	}
+
+	for i, t := range tokens {
+		if g.values[i] == nil || t == wildcard {
+			continue
+		}
+		g.values[i][t] = struct{}{}
+		if len(g.values[i]) > templateCardinality {
+			m.size += g.wildcard(i)
+		}
+	}
+	for i := range tokens {
+		if g.values[i] == nil {
+			tokens[i] = wildcard
+		}
+	}
+
+	tk := strings.Join(tokens, "\x00")
+	t, ok := g.templates[tk]
+	if !ok {
+		m.seq++
+		t = &minedTemplate{
+			tokens:      tokens,
+			seq:         m.seq,
+			KeyTemplate: &KeyTemplate{Template: g.join(tokens), Types: map[string]uint64{}},
+		}
+		g.templates[tk] = t
+		m.size++
+	}
+	countTemplate(t.KeyTemplate, e)
+
+	for m.size > m.max {
+		m.shrink()
+	}
+}
+
+func countTemplate(t *KeyTemplate, e *decoder.Entry) {
+	t.Num++
+	t.Bytes += e.Bytes
+	if e.Expiry > 0 {
+		t.TTLNum++
+	}
+	t.Types[e.Type]++
+	if len(t.Examples) < templateExamples {
+		t.Examples = append(t.Examples, e.Key)
+	}
+}
+
+// shrink merges templates of the group with most templates, at its
+// position with most distinct tokens
+func (m *templateMiner) shrink() {
+	var largest *templateGroup
+	for _, g := range m.groups {
+		if largest == nil || len(g.templates) > len(largest.templates) {
+			largest = g
+		}
+	}
+	pos := -1
+	for i, v := range largest.values {
+		if v != nil && (pos < 0 || len(v) > len(largest.values[pos])) {
+			pos = i
+		}
+	}
+	if pos < 0 {
+		// every position is a wildcard, the group has a single template
+		return
+	}
+	m.size += largest.wildcard(pos)
+}
+
+// wildcard turns position i into a wildcard, merging templates differing
+// only there. It returns the change of the number of templates.
+func (g *templateGroup) wildcard(i int) int {
+	g.values[i] = nil
+	before := len(g.templates)
+	ordered := make([]*minedTemplate, 0, before)
+	for _, t := range g.templates {
+		ordered = append(ordered, t)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].seq < ordered[j].seq
+	})
+	merged := map[string]*minedTemplate{}
+	for _, t := range ordered {
+		t.tokens[i] = wildcard
+		tk := strings.Join(t.tokens, "\x00")
+		into, ok := merged[tk]
+		if !ok {
+			t.Template = g.join(t.tokens)
+			merged[tk] = t
+			continue
+		}
+		into.Num += t.Num
+		into.Bytes += t.Bytes
+		into.TTLNum += t.TTLNum
+		for typ, n := range t.Types {
+			into.Types[typ] += n
+		}
+		for _, ex := range t.Examples {
+			if len(into.Examples) < templateExamples {
+				into.Examples = append(into.Examples, ex)
+			}
+		}
+	}
+	g.templates = merged
+	return len(merged) - before
+}
+
+func (g *templateGroup) join(tokens []string) string {
+	var b strings.Builder
+	for i, t := range tokens {
+		b.WriteString(t)
+		if i < len(g.seps) {
+			b.WriteString(g.seps[i])
+		}
+	}
+	return b.String()
+}
+
+// templates get the learned templates, ordered by bytes
+func (m *templateMiner) templates() []*KeyTemplate {
+	res := []*KeyTemplate{}
+	for _, g := range m.groups {
+		for _, t := range g.templates {
+			res = append(res, t.KeyTemplate)
+		}
+	}
+	if m.other != nil {
+		res = append(res, m.other)
+	}
+	for _, t := range res {
+		t.TTLShare = float64(t.TTLNum) / float64(t.Num)
+	}
+	sortKeyTemplates(res)
+	return res
+}
+
+func sortKeyTemplates(res []*KeyTemplate) {
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Bytes == res[j].Bytes {
+			return res[i].Template < res[j].Template
+		}
+		return res[i].Bytes > res[j].Bytes
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func templateNums(templates []*KeyTemplate) map[string]uint64 {
+	res := map[string]uint64{}
+	for _, t := range templates {
+		res[t.Template] = t.Num
+	}
+	return res
+}
+
+func TestMineTemplates(t *testing.T) {
+	m := newTemplateMiner(":_", 100)
+	for i := 0; i < 40; i++ {
+		m.add(&decoder.Entry{Key: fmt.Sprintf("user:u%d:profile", i), Type: "hash", Bytes: 10, Expiry: int64(i % 2)})
+		m.add(&decoder.Entry{Key: fmt.Sprintf("user:u%d:feed", i), Type: "list", Bytes: 20})
+	}
+	for _, env := range []string{"prod", "test"} {
+		for i := 0; i < 3; i++ {
+			m.add(&decoder.Entry{Key: fmt.Sprintf("cfg_%s_%d", env, i), Type: "string", Bytes: 1})
+		}
+	}
+	m.add(&decoder.Entry{Key: "lock", Type: "string", Bytes: 1})
+
+	templates := m.templates()
+	assert.Equal(t, map[string]uint64{
+		"user:*:feed":    40,
+		"user:*:profile": 40,
+		"cfg_prod_*":     3,
+		"cfg_test_*":     3,
+		"lock":           1,
+	}, templateNums(templates))
+
+	feed := templates[0]
+	assert.Equal(t, "user:*:feed", feed.Template)
+	assert.Equal(t, uint64(800), feed.Bytes)
+	assert.Equal(t, map[string]uint64{"list": 40}, feed.Types)
+	assert.Equal(t, []string{"user:u0:feed", "user:u1:feed", "user:u2:feed"}, feed.Examples)
+	profile := templates[1]
+	assert.Equal(t, uint64(20), profile.TTLNum)
+	assert.Equal(t, 0.5, profile.TTLShare)
+}
+
+func TestMineTemplatesBounded(t *testing.T) {
+	m := newTemplateMiner(":", 4)
+	for i := 0; i < 10; i++ {
+		m.add(&decoder.Entry{Key: fmt.Sprintf("a:x%d:%c", i, 'a'+i), Type: "string", Bytes: 1})
+	}
+	assert.Equal(t, map[string]uint64{"a:*:*": 10}, templateNums(m.templates()))
+	assert.True(t, m.size <= 4)
+
+	// keys of new shapes go to the other template once there are enough
+	for i := 0; i < 6; i++ {
+		m.add(&decoder.Entry{Key: "b" + strings.Repeat(":", i), Type: "string", Bytes: 1})
+	}
+	assert.Len(t, m.groups, 4)
+	assert.Equal(t, uint64(2), templateNums(m.templates())["*"])
+
+	// long keys keep their tail in the last token
+	tokens, seps := newTemplateMiner(":", 1).tokenize("a:b:c:d:e:f:g:h:i:j:k:l:m:n:o:p:q:r")
+	assert.Len(t, tokens, maxTemplateTokens)
+	assert.Len(t, seps, maxTemplateTokens-1)
+	assert.Equal(t, "p:q:r", tokens[maxTemplateTokens-1])
+}
+
+func TestCounterKeyTemplates(t *testing.T) {
+	in := make(chan *decoder.Entry, 3)
+	in <- &decoder.Entry{Key: "user:1", Type: "string", Bytes: 10}
+	in <- &decoder.Entry{Key: "user:2", Type: "string", Bytes: 20, DB: 1}
+	in <- &decoder.Entry{Key: "order:1", Type: "hash", Bytes: 30}
+	close(in)
+	c := NewCounter(nil)
+	c.Count(in)
+	assert.Nil(t, c.GetKeyTemplates())
+
+	opts := DefaultAnalysisOptions()
+	opts.MineTemplates = true
+	c = NewCounter(opts)
+	in = make(chan *decoder.Entry, 3)
+	in <- &decoder.Entry{Key: "user:1", Type: "string", Bytes: 10}
+	in <- &decoder.Entry{Key: "user:2", Type: "string", Bytes: 20, DB: 1}
+	in <- &decoder.Entry{Key: "order:1", Type: "hash", Bytes: 30}
+	close(in)
+	c.SetFullDB(1)
+	c.Count(in)
+	assert.Nil(t, c.miner)
+	assert.Equal(t, map[string]uint64{"order:*": 1, "user:*": 2}, templateNums(c.GetKeyTemplates()))
+	assert.Equal(t, "order:*", c.GetKeyTemplates()[0].Template)
+	assert.Equal(t, map[string]uint64{"user:*": 1}, templateNums(c.selectDB(1).GetKeyTemplates()))
+
+	// templates are kept with the result
+	r := c.result()
+	assert.Equal(t, c.GetKeyTemplates(), r.counter().GetKeyTemplates())
+	assert.Equal(t, c.selectDB(1).GetKeyTemplates(), r.counter().selectDB(1).GetKeyTemplates())
+	assert.Nil(t, r.counter().miner)
+
+	c.scaleBytes(2)
+	assert.Equal(t, uint64(60), c.GetKeyTemplates()[0].Bytes)
+}
@@ -0,0 +1,195 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+// meter measures how far a decoding has gone, by bytes read from the input
+// and by keys decoded. Bytes are counted before decompression so they are
+// comparable to the file size.
+type meter struct {
+	// read, total and end are first for 64 bit alignment of atomic
+	// operations
+	read  int64
+	total int64
+	end   int64
+	start time.Time
+	keys  func() uint64
+}
+
+func newMeter(keys func() uint64) *meter {
+	return &meter{start: time.Now(), keys: keys}
+}
+
+// reader counts bytes read from r into the meter
+func (m *meter) reader(r io.Reader) io.Reader {
+	return &countingReader{r: r, m: m}
+}
+
+// finish stops the clock of the meter
+func (m *meter) finish() {
+	atomic.StoreInt64(&m.end, time.Now().UnixNano())
+}
+
+// setTotal sets the size of the input, 0 if it is unknown
+func (m *meter) setTotal(total int64) {
+	atomic.StoreInt64(&m.total, total)
+}
+
+type countingReader struct {
+	r io.Reader
+	m *meter
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	atomic.AddInt64(&c.m.read, int64(n))
+	return n, err
+}
+
+// meterStats is a snapshot of a meter
+type meterStats struct {
+	BytesRead   int64   `json:"bytesRead"`
+	TotalBytes  int64   `json:"totalBytes"`
+	Keys        uint64  `json:"keys"`
+	KeysPerSec  float64 `json:"keysPerSec"`
+	BytesPerSec float64 `json:"bytesPerSec"`
+	// ETA is the estimated seconds left, -1 if the size is unknown
+	ETA float64 `json:"eta"`
+}
+
+func (m *meter) stats() meterStats {
+	s := meterStats{
+		BytesRead:  atomic.LoadInt64(&m.read),
+		TotalBytes: atomic.LoadInt64(&m.total),
+		ETA:        -1,
+	}
+	if m.keys != nil {
+		s.Keys = m.keys()
+	}
+	elapsed := time.Since(m.start).Seconds()
+	if end := atomic.LoadInt64(&m.end); end > 0 {
+		elapsed = time.Unix(0, end).Sub(m.start).Seconds()
+	}
+	if elapsed > 0 {
+		s.KeysPerSec = float64(s.Keys) / elapsed
+		s.BytesPerSec = float64(s.BytesRead) / elapsed
+	}
+	if s.TotalBytes > 0 && s.BytesPerSec > 0 {
+		left := s.TotalBytes - s.BytesRead
+		if left < 0 {
+			left = 0
+		}
+		s.ETA = float64(left) / s.BytesPerSec
+	}
+	return s
+}
+
+// fraction of the input read, 0 if the size is unknown
+func (s meterStats) fraction() float64 {
+	if s.TotalBytes <= 0 {
+		return 0
+	}
+	f := float64(s.BytesRead) / float64(s.TotalBytes)
+	if f > 1 {
+		f = 1
+	}
+	return f
+}
+
+func (s meterStats) String() string {
+	str := formatBytes(uint64(s.BytesRead))
+	if s.TotalBytes > 0 {
+		str += fmt.Sprintf(" / %s (%.1f%%)", formatBytes(uint64(s.TotalBytes)), s.fraction()*100)
+	}
+	str += fmt.Sprintf(", %d keys, %.0f keys/s, %s/s", s.Keys, s.KeysPerSec, formatBytes(uint64(s.BytesPerSec)))
+	if s.ETA >= 0 {
+		str += fmt.Sprintf(", ETA %v", (time.Duration(s.ETA) * time.Second).Round(time.Second))
+	}
+	return str
+}
+
+// inputSize get size of a rdb or aof file, or the total size of files in an
+// appendonlydir, 0 if it is unknown
+func inputSize(path string) int64 {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	if !fi.IsDir() && filepath.Ext(path) != ".manifest" {
+		return fi.Size()
+	}
+	dir := path
+	if !fi.IsDir() {
+		dir = filepath.Dir(path)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return 0
+	}
+	size := int64(0)
+	for _, f := range files {
+		if f.Mode().IsRegular() && filepath.Ext(f.Name()) != ".manifest" {
+			size += f.Size()
+		}
+	}
+	return size
+}
+
+// isTerminal checks whether w is a terminal
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// reportProgress writes the progress of m to w until done is closed. On a
+// terminal the line is redrawn every second, otherwise a line is written
+// every 10 seconds.
+func reportProgress(w io.Writer, name string, m *meter, done <-chan struct{}) {
+	tty := isTerminal(w)
+	interval := 10 * time.Second
+	if tty {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			if tty {
+				fmt.Fprintf(w, "\r\033[K%s: %s\n", name, m.stats())
+			}
+			return
+		case <-ticker.C:
+			if tty {
+				fmt.Fprintf(w, "\r\033[K%s: %s", name, m.stats())
+			} else {
+				fmt.Fprintf(w, "%s: %s\n", name, m.stats())
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeter(t *testing.T) {
+	keys := uint64(0)
+	m := newMeter(func() uint64 { return keys })
+	m.start = time.Now().Add(-2 * time.Second)
+	m.setTotal(400)
+
+	_, err := ioutil.ReadAll(m.reader(bytes.NewReader(make([]byte, 100))))
+	assert.NoError(t, err)
+	keys = 10
+
+	s := m.stats()
+	assert.Equal(t, int64(100), s.BytesRead)
+	assert.Equal(t, uint64(10), s.Keys)
+	assert.InDelta(t, 0.25, s.fraction(), 0.001)
+	assert.InDelta(t, 5, s.KeysPerSec, 0.5)
+	assert.InDelta(t, 6, s.ETA, 0.5)
+
+	m.setTotal(0)
+	s = m.stats()
+	assert.Equal(t, float64(-1), s.ETA)
+	assert.Equal(t, float64(0), s.fraction())
+}
+
+func TestParseProgressByBytes(t *testing.T) {
+	pp := NewParseProgress("meter_test")
+	pp.SetStatus("parsing")
+	pp.SetProgress(30)
+
+	m := newMeter(nil)
+	m.setTotal(100)
+	pp.SetMeter(m)
+	ioutil.ReadAll(m.reader(bytes.NewReader(make([]byte, 50))))
+	assert.Equal(t, 55, pp.GetData()["progress"])
+
+	pp.SetStatus("completed")
+	pp.SetProgress(100)
+	data := pp.GetData()
+	assert.Equal(t, 100, data["progress"])
+	assert.Equal(t, float64(-1), data["eta"])
+}
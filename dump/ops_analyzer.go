@@ -24,7 +24,15 @@ type OpsAnalyzer struct {
 	keysWithTTL    uint64
 	keysWithoutTTL uint64
 	expiredKeys    uint64
-	expiryDistribution map[string]uint64 // "1h", "1d", "7d", "30d", "90d+", "expired"
+	expiryDistribution map[string]uint64 // "expired", "1h", "1d", "7d", "30d", "30d+", "none"
+
+	// Access analysis, only if the rdb has lru idle or lfu freq info
+	hasAccessInfo    bool
+	coldKeys         uint64
+	coldBytes        uint64
+	idleDistribution map[string]uint64
+	freqDistribution map[string]uint64
+	coldPrefixes     []MemoryHotspot
 
 	// Memory hotspots
 	memoryHotspots []MemoryHotspot
@@ -35,6 +43,9 @@ type OpsAnalyzer struct {
 	// Data type efficiency
 	typeEfficiency map[string]TypeEfficiency
 
+	// Sizes of every key by type, and of all keys as "all"
+	sizeDistribution map[string]SizeDistribution
+
 	// Fragmentation analysis
 	fragmentationScore float64
 
@@ -73,16 +84,19 @@ type MemoryHotspot struct {
 
 // KeyPattern represents common key naming patterns
 type KeyPattern struct {
-	Pattern     string  `json:"pattern"`
-	Count       uint64  `json:"count"`
-	TotalMemory uint64  `json:"total_memory"`
-	AvgMemory   uint64  `json:"avg_memory"`
-	Percentage  float64 `json:"percentage"`
-	Example     string  `json:"example"`
+	Pattern     string            `json:"pattern"`
+	Count       uint64            `json:"count"`
+	TotalMemory uint64            `json:"total_memory"`
+	AvgMemory   uint64            `json:"avg_memory"`
+	Percentage  float64           `json:"percentage"`
+	Example     string            `json:"example"`
+	TTL         map[string]uint64 `json:"ttl"` // number of keys by ttl bucket
 }
 
-// TypeEfficiency analyzes efficiency of data type usage
+// TypeEfficiency analyzes efficiency of data type usage, over every key of
+// the type
 type TypeEfficiency struct {
+	Keys           uint64  `json:"keys"`
 	AvgSize        uint64  `json:"avg_size"`
 	MedianSize     uint64  `json:"median_size"`
 	P95Size        uint64  `json:"p95_size"`
@@ -92,6 +106,13 @@ type TypeEfficiency struct {
 	OptimalType    string  `json:"optimal_type"`    // suggested type
 }
 
+// SizeDistribution summarizes bytes and element counts of keys
+type SizeDistribution struct {
+	Keys     uint64         `json:"keys"`
+	Bytes    *SizeQuantiles `json:"bytes"`
+	Elements *SizeQuantiles `json:"elements"`
+}
+
 // SlotUsage for cluster analysis
 type SlotUsage struct {
 	Slot       int     `json:"slot"`
@@ -120,7 +141,11 @@ func NewOpsAnalyzer(counter *Counter) *OpsAnalyzer {
 		memoryHotspots:     []MemoryHotspot{},
 		keyPatterns:        []KeyPattern{},
 		typeEfficiency:     make(map[string]TypeEfficiency),
+		sizeDistribution:   make(map[string]SizeDistribution),
 		expiryDistribution: make(map[string]uint64),
+		idleDistribution:   make(map[string]uint64),
+		freqDistribution:   make(map[string]uint64),
+		coldPrefixes:       []MemoryHotspot{},
 		topSlotsUsage:      []SlotUsage{},
 		recommendations:    []Recommendation{},
 	}
@@ -133,6 +158,8 @@ func NewOpsAnalyzer(counter *Counter) *OpsAnalyzer {
 func (oa *OpsAnalyzer) analyze() {
 	// Calculate basic stats
 	oa.calculateBasicStats()
+	oa.analyzeTTL()
+	oa.analyzeAccess()
 
 	// Detect anomalies
 	oa.detectLargeKeys()
@@ -141,6 +168,7 @@ func (oa *OpsAnalyzer) analyze() {
 	oa.detectTypeImbalance()
 	oa.analyzeKeyPatterns()
 	oa.analyzeTypeEfficiency()
+	oa.analyzeSizeDistribution()
 	oa.analyzeClusterBalance()
 
 	// Calculate health score
@@ -164,6 +192,115 @@ func (oa *OpsAnalyzer) calculateBasicStats() {
 	}
 }
 
+// analyzeTTL summarizes key expiry relative to the rdb ctime
+func (oa *OpsAnalyzer) analyzeTTL() {
+	for key, num := range oa.counter.ttlNum {
+		oa.expiryDistribution[key.Key] += num
+		switch key.Key {
+		case "none":
+			oa.keysWithoutTTL += num
+		case "expired":
+			oa.expiredKeys += num
+			oa.keysWithTTL += num
+		default:
+			oa.keysWithTTL += num
+		}
+	}
+
+	if oa.totalKeys == 0 {
+		return
+	}
+
+	expiredPercentage := float64(oa.expiredKeys) / float64(oa.totalKeys) * 100
+	if expiredPercentage > 10 {
+		oa.anomalies = append(oa.anomalies, Anomaly{
+			Level:       "warning",
+			Category:    "ttl",
+			Title:       "Many Expired Keys Not Reclaimed",
+			Description: fmt.Sprintf("%.1f%% of keys were already expired when the snapshot was taken", expiredPercentage),
+			Impact:      "Expired keys still hold memory until they are accessed or sampled by active expiry",
+			Suggestion:  "Consider raising 'hz' or enabling 'active-expire-effort' to reclaim expired keys faster",
+			Value:       formatNumber(oa.expiredKeys),
+			DetectedAt:  time.Now(),
+		})
+	}
+
+	noTTLPercentage := float64(oa.keysWithoutTTL) / float64(oa.totalKeys) * 100
+	if oa.totalKeys > 10000 && noTTLPercentage > 90 {
+		oa.anomalies = append(oa.anomalies, Anomaly{
+			Level:       "info",
+			Category:    "ttl",
+			Title:       "Most Keys Have No TTL",
+			Description: fmt.Sprintf("%.1f%% of keys have no expiration set", noTTLPercentage),
+			Impact:      "Keys without TTL are never reclaimed automatically and may grow without bound",
+			Suggestion:  "Review which key prefixes are cache data and set appropriate TTL values",
+			Value:       fmt.Sprintf("%.1f%%", noTTLPercentage),
+			DetectedAt:  time.Now(),
+		})
+	}
+}
+
+// analyzeAccess summarizes lru idle time and lfu counters of keys, keys idle
+// for longer than the counter's cold threshold are reported as cold data
+func (oa *OpsAnalyzer) analyzeAccess() {
+	for key, num := range oa.counter.idleNum {
+		oa.idleDistribution[key.Key] += num
+		if key.Key == "unknown" {
+			continue
+		}
+		oa.hasAccessInfo = true
+	}
+	oa.coldKeys = oa.counter.coldNum
+	oa.coldBytes = oa.counter.coldBytes
+	for key, num := range oa.counter.freqNum {
+		oa.freqDistribution[key.Key] += num
+		if key.Key != "unknown" {
+			oa.hasAccessInfo = true
+		}
+	}
+
+	for _, prefix := range oa.counter.GetLargestKeyPrefixes() {
+		if prefix.ColdBytes == 0 {
+			continue
+		}
+		hotspot := MemoryHotspot{
+			Type:       "key_prefix",
+			Identifier: fmt.Sprintf("%s (%s)", prefix.Key, prefix.Type),
+			MemoryUsed: prefix.ColdBytes,
+			KeyCount:   prefix.ColdNum,
+			AvgKeySize: prefix.ColdBytes / prefix.ColdNum,
+		}
+		if oa.totalBytes > 0 {
+			hotspot.Percentage = float64(prefix.ColdBytes) / float64(oa.totalBytes) * 100
+		}
+		oa.coldPrefixes = append(oa.coldPrefixes, hotspot)
+	}
+	sort.Slice(oa.coldPrefixes, func(i, j int) bool {
+		return oa.coldPrefixes[i].MemoryUsed > oa.coldPrefixes[j].MemoryUsed
+	})
+	if len(oa.coldPrefixes) > 10 {
+		oa.coldPrefixes = oa.coldPrefixes[:10]
+	}
+
+	if !oa.hasAccessInfo || oa.totalBytes == 0 {
+		return
+	}
+
+	coldPercentage := float64(oa.coldBytes) / float64(oa.totalBytes) * 100
+	if coldPercentage > 30 {
+		oa.anomalies = append(oa.anomalies, Anomaly{
+			Level:       "warning",
+			Category:    "memory",
+			Title:       "Large Amount of Cold Data",
+			Description: fmt.Sprintf("%.1f%% of memory is held by keys not accessed for more than %d days", coldPercentage, oa.counter.opts.ColdIdleDays),
+			Impact:      "Cold keys occupy memory that could serve frequently accessed data",
+			Suggestion:  "Review the cold key prefixes, set TTL or move them to cheaper storage",
+			Value:       formatBytes(oa.coldBytes),
+			DetectedAt:  time.Now(),
+		})
+	}
+}
+
 // detectLargeKeys identifies abnormally large keys
 func (oa *OpsAnalyzer) detectLargeKeys() {
 	largestKeys := oa.counter.GetLargestEntries(100)
@@ -307,14 +444,11 @@ func (oa *OpsAnalyzer) detectKeyExplosion() {
 
 	// Check for tiny keys (potential key explosion)
 	tinyKeyCount := uint64(0)
-	largestKeys := oa.counter.GetLargestEntries(500)
-	for _, entry := range largestKeys {
-		if entry.Bytes < 100 { // Less than 100 bytes
-			tinyKeyCount++
-		}
+	for _, sizes := range oa.counter.typeSizes {
+		tinyKeyCount += sizes.CountBelow(100) // Less than 100 bytes
 	}
 
-	if tinyKeyCount > 100 && float64(tinyKeyCount)/float64(len(largestKeys)) > 0.3 {
+	if tinyKeyCount > 100 && float64(tinyKeyCount)/float64(oa.totalKeys) > 0.3 {
 		oa.anomalies = append(oa.anomalies, Anomaly{
 			Level:       "warning",
 			Category:    "keys",
@@ -354,6 +488,11 @@ func (oa *OpsAnalyzer) detectTypeImbalance() {
 // analyzeKeyPatterns identifies common key naming patterns
 func (oa *OpsAnalyzer) analyzeKeyPatterns() {
 	prefixes := oa.counter.GetLargestKeyPrefixes()
+	largestKeys := oa.counter.GetLargestEntries(500)
+	keyPrefixes := make([][]string, len(largestKeys))
+	for i, entry := range largestKeys {
+		keyPrefixes[i] = oa.counter.keyPrefixes(entry.Key)
+	}
 
 	for i, prefix := range prefixes {
 		if i >= 50 { // Top 50 patterns
@@ -366,11 +505,10 @@ func (oa *OpsAnalyzer) analyzeKeyPatterns() {
 			avgMemory = prefix.Bytes / prefix.Num
 		}
 
-		// Get an example key
+		// Get an example key of the pattern
 		example := ""
-		largestKeys := oa.counter.GetLargestEntries(500)
-		for _, entry := range largestKeys {
-			if len(entry.Key) >= len(prefix.Key) && entry.Key[:len(prefix.Key)] == prefix.Key {
+		for j, entry := range largestKeys {
+			if entry.Type == prefix.Type && containsString(keyPrefixes[j], prefix.Key) {
 				example = entry.Key
 				break
 			}
@@ -383,6 +521,7 @@ func (oa *OpsAnalyzer) analyzeKeyPatterns() {
 			AvgMemory:   avgMemory,
 			Percentage:  percentage,
 			Example:     example,
+			TTL:         prefix.TTLNum,
 		}
 		oa.keyPatterns = append(oa.keyPatterns, pattern)
 	}
@@ -390,40 +529,18 @@ func (oa *OpsAnalyzer) analyzeKeyPatterns() {
 
 // analyzeTypeEfficiency analyzes data type usage efficiency
 func (oa *OpsAnalyzer) analyzeTypeEfficiency() {
-	largestKeys := oa.counter.GetLargestEntries(500)
-
-	// Group by type
-	typeKeys := make(map[string][]uint64)
-	for _, entry := range largestKeys {
-		typeKeys[entry.Type] = append(typeKeys[entry.Type], entry.Bytes)
-	}
-
-	for typ, sizes := range typeKeys {
-		if len(sizes) == 0 {
+	for typ, sizes := range oa.counter.typeSizes {
+		if sizes.Count == 0 {
 			continue
 		}
 
-		sort.Slice(sizes, func(i, j int) bool { return sizes[i] < sizes[j] })
-
-		avg := uint64(0)
-		sum := uint64(0)
-		for _, size := range sizes {
-			sum += size
-		}
-		avg = sum / uint64(len(sizes))
-
-		median := sizes[len(sizes)/2]
-		p95 := sizes[int(float64(len(sizes))*0.95)]
-		p99 := sizes[int(float64(len(sizes))*0.99)]
+		avg := sizes.Sum / sizes.Count
+		median := sizes.Quantile(0.5)
+		p95 := sizes.Quantile(0.95)
+		p99 := sizes.Quantile(0.99)
 
 		// Calculate efficiency (inverse of variance, scaled)
-		variance := float64(0)
-		for _, size := range sizes {
-			diff := float64(size) - float64(avg)
-			variance += diff * diff
-		}
-		variance /= float64(len(sizes))
-		stddev := math.Sqrt(variance)
+		stddev := sizes.Stddev()
 
 		efficiency := 100.0
 		if avg > 0 {
@@ -432,6 +549,7 @@ func (oa *OpsAnalyzer) analyzeTypeEfficiency() {
 		}
 
 		oa.typeEfficiency[typ] = TypeEfficiency{
+			Keys:         sizes.Count,
 			AvgSize:      avg,
 			MedianSize:   median,
 			P95Size:      p95,
@@ -457,6 +575,29 @@ func (oa *OpsAnalyzer) analyzeTypeEfficiency() {
 	}
 }
 
+// analyzeSizeDistribution summarizes the size sketches of every type and
+// merges them into the distribution of all keys
+func (oa *OpsAnalyzer) analyzeSizeDistribution() {
+	allSizes, allElems := NewQuantileSketch(), NewQuantileSketch()
+	for typ, sizes := range oa.counter.typeSizes {
+		elems := oa.counter.typeElems[typ]
+		oa.sizeDistribution[typ] = SizeDistribution{
+			Keys:     sizes.Count,
+			Bytes:    sizes.Summary(),
+			Elements: elems.Summary(),
+		}
+		allSizes.Merge(sizes)
+		allElems.Merge(elems)
+	}
+	if allSizes.Count > 0 {
+		oa.sizeDistribution["all"] = SizeDistribution{
+			Keys:     allSizes.Count,
+			Bytes:    allSizes.Summary(),
+			Elements: allElems.Summary(),
+		}
+	}
+}
+
 // analyzeClusterBalance analyzes slot distribution for clusters
 func (oa *OpsAnalyzer) analyzeClusterBalance() {
 	if len(oa.counter.slotBytes) == 0 {
@@ -588,9 +729,10 @@ func (oa *OpsAnalyzer) generateRecommendations() {
 	// Key expiration
 	largestKeys := oa.counter.GetLargestEntries(100)
 	keysNeedingTTL := 0
-	for range largestKeys {
-		// In real implementation, check if key has TTL from Entry.Expiry
-		keysNeedingTTL++
+	for _, entry := range largestKeys {
+		if entry.Expiry <= 0 {
+			keysNeedingTTL++
+		}
 	}
 
 	if keysNeedingTTL > 50 {
@@ -598,7 +740,7 @@ func (oa *OpsAnalyzer) generateRecommendations() {
 			Priority:    1,
 			Category:    "ttl",
 			Title:       "Implement TTL for Large Keys",
-			Description: "Many large keys appear to have no expiration set",
+			Description: fmt.Sprintf("%d of the %d largest keys have no expiration set", keysNeedingTTL, len(largestKeys)),
 			Action:      "Review and set appropriate TTL values for large keys",
 			Impact:      "Prevents unbounded memory growth and automatic cleanup",
 			Effort:      "medium",
@@ -606,6 +748,20 @@ func (oa *OpsAnalyzer) generateRecommendations() {
 		})
 	}
 
+	// Cold data
+	if oa.hasAccessInfo && len(oa.coldPrefixes) > 0 && oa.coldBytes > oa.totalBytes/5 {
+		oa.recommendations = append(oa.recommendations, Recommendation{
+			Priority:    2,
+			Category:    "memory",
+			Title:       "Evict or Expire Cold Keys",
+			Description: fmt.Sprintf("Cold keys hold %s, the largest cold prefix is %s", formatBytes(oa.coldBytes), oa.coldPrefixes[0].Identifier),
+			Action:      "Set TTL on cold key prefixes or use an allkeys-lru/allkeys-lfu maxmemory-policy",
+			Impact:      "Frees memory held by data that is rarely accessed",
+			Effort:      "medium",
+			CreatedAt:   time.Now(),
+		})
+	}
+
 	// Type optimization
 	for typ, efficiency := range oa.typeEfficiency {
 		if efficiency.Efficiency < 60 && typ == "string" {
@@ -674,3 +830,12 @@ func truncateKey(key string) string {
 	}
 	return key[:47] + "..."
 }
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -12,7 +12,7 @@ func opsAnalysisHandler(w http.ResponseWriter, r *http.Request, p httprouter.Par
 	w.Header().Set("Content-Type", "application/json")
 
 	path := p.ByName("path")
-	c := counters.Get(path)
+	c, _ := getCounter(path)
 	if c == nil {
 		w.WriteHeader(http.StatusNotFound)
 		json.NewEncoder(w).Encode(map[string]interface{}{
@@ -21,7 +21,7 @@ func opsAnalysisHandler(w http.ResponseWriter, r *http.Request, p httprouter.Par
 		return
 	}
 
-	counter := c.(*Counter)
+	counter := c.selectDB(requestDB(r))
 	analyzer := NewOpsAnalyzer(counter)
 
 	response := map[string]interface{}{
@@ -30,13 +30,48 @@ func opsAnalysisHandler(w http.ResponseWriter, r *http.Request, p httprouter.Par
 		"memory_hotspots":      analyzer.memoryHotspots,
 		"key_patterns":         analyzer.keyPatterns,
 		"type_efficiency":      analyzer.typeEfficiency,
+		"size_distribution":    analyzer.sizeDistribution,
 		"slot_imbalance":       analyzer.slotImbalance,
 		"top_slots_usage":      analyzer.topSlotsUsage,
 		"recommendations":      analyzer.recommendations,
+		"key_templates":        counter.GetKeyTemplates(),
+		"element_analysis": map[string]interface{}{
+			"largest_elements": counter.GetLargestElements(counter.opts.TopN),
+			"skewed_keys":      counter.GetSkewedEntries(counter.opts.TopN),
+			"skewed_num":       counter.skewedNum,
+			"skewed_bytes":     counter.skewedBytes,
+			"skew_threshold":   counter.opts.SkewThreshold,
+		},
+		"content_analysis": map[string]interface{}{
+			"by_class":       counter.GetContentClasses(),
+			"by_prefix":      counter.GetContentPrefixes(counter.opts.TopN),
+			"inspect_values": counter.opts.InspectValues,
+		},
+		"encoding_analysis": map[string]interface{}{
+			"by_encoding":     counter.GetEncodingCount(),
+			"near_thresholds": counter.GetNearThresholds(),
+		},
+		"ttl_analysis": map[string]interface{}{
+			"keys_with_ttl":       analyzer.keysWithTTL,
+			"keys_without_ttl":    analyzer.keysWithoutTTL,
+			"expired_keys":        analyzer.expiredKeys,
+			"expiry_distribution": analyzer.expiryDistribution,
+			"by_type":             counter.GetTTLCount(),
+		},
+		"access_analysis": map[string]interface{}{
+			"has_access_info":   analyzer.hasAccessInfo,
+			"cold_keys":         analyzer.coldKeys,
+			"cold_bytes":        analyzer.coldBytes,
+			"idle_distribution": analyzer.idleDistribution,
+			"freq_distribution": analyzer.freqDistribution,
+			"cold_prefixes":     analyzer.coldPrefixes,
+			"cold_idle_days":    counter.opts.ColdIdleDays,
+		},
 		"basic_stats": map[string]interface{}{
-			"total_keys":     analyzer.totalKeys,
-			"total_bytes":    analyzer.totalBytes,
-			"avg_key_size":   analyzer.avgKeySize,
+			"total_keys":         analyzer.totalKeys,
+			"total_bytes":        analyzer.totalBytes,
+			"avg_key_size":       analyzer.avgKeySize,
+			"prefix_error_bound": counter.prefixErrorBound,
 		},
 	}
 
@@ -48,7 +83,7 @@ func opsAnomaliesHandler(w http.ResponseWriter, r *http.Request, p httprouter.Pa
 	w.Header().Set("Content-Type", "application/json")
 
 	path := p.ByName("path")
-	c := counters.Get(path)
+	c, _ := getCounter(path)
 	if c == nil {
 		w.WriteHeader(http.StatusNotFound)
 		json.NewEncoder(w).Encode(map[string]interface{}{
@@ -57,7 +92,7 @@ func opsAnomaliesHandler(w http.ResponseWriter, r *http.Request, p httprouter.Pa
 		return
 	}
 
-	counter := c.(*Counter)
+	counter := c.selectDB(requestDB(r))
 	analyzer := NewOpsAnalyzer(counter)
 
 	// Group anomalies by level
@@ -92,7 +127,7 @@ func opsRecommendationsHandler(w http.ResponseWriter, r *http.Request, p httprou
 	w.Header().Set("Content-Type", "application/json")
 
 	path := p.ByName("path")
-	c := counters.Get(path)
+	c, _ := getCounter(path)
 	if c == nil {
 		w.WriteHeader(http.StatusNotFound)
 		json.NewEncoder(w).Encode(map[string]interface{}{
@@ -101,7 +136,7 @@ func opsRecommendationsHandler(w http.ResponseWriter, r *http.Request, p httprou
 		return
 	}
 
-	counter := c.(*Counter)
+	counter := c.selectDB(requestDB(r))
 	analyzer := NewOpsAnalyzer(counter)
 
 	response := map[string]interface{}{
@@ -112,12 +147,40 @@ func opsRecommendationsHandler(w http.ResponseWriter, r *http.Request, p httprou
 	json.NewEncoder(w).Encode(response)
 }
 
+// opsTemplatesHandler returns the mined key templates, enabled reports
+// whether the instance was counted with --mine-templates
+func opsTemplatesHandler(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	path := p.ByName("path")
+	c, _ := getCounter(path)
+	if c == nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"error": "Instance not found",
+		})
+		return
+	}
+
+	templates := c.selectDB(requestDB(r)).GetKeyTemplates()
+	if templates == nil {
+		templates = []*KeyTemplate{}
+	}
+	response := map[string]interface{}{
+		"enabled":   c.opts.MineTemplates,
+		"templates": templates,
+		"total":     len(templates),
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
 // opsHealthHandler returns quick health check
 func opsHealthHandler(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 	w.Header().Set("Content-Type", "application/json")
 
 	path := p.ByName("path")
-	c := counters.Get(path)
+	c, _ := getCounter(path)
 	if c == nil {
 		w.WriteHeader(http.StatusNotFound)
 		json.NewEncoder(w).Encode(map[string]interface{}{
@@ -126,7 +189,7 @@ func opsHealthHandler(w http.ResponseWriter, r *http.Request, p httprouter.Param
 		return
 	}
 
-	counter := c.(*Counter)
+	counter := c.selectDB(requestDB(r))
 	analyzer := NewOpsAnalyzer(counter)
 
 	healthStatus := "excellent"
@@ -0,0 +1,356 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// AnalysisOptions tune how a Counter aggregates keys and how much of it is
+// reported
+type AnalysisOptions struct {
+	// LengthLevels are ascending element counts, a key with more elements
+	// than a level is counted in the highest such level
+	LengthLevels []uint64 `json:"length_levels" yaml:"length_levels"`
+	// Separators split keys into prefixes
+	Separators string `json:"separators" yaml:"separators"`
+	// LargestKeys is the number of largest keys kept
+	LargestKeys int `json:"largest_keys" yaml:"largest_keys"`
+	// MaxPrefixes is the number of largest key prefixes kept
+	MaxPrefixes int `json:"max_prefixes" yaml:"max_prefixes"`
+	// PrefixMemory is the memory budget in bytes of counting key prefixes,
+	// shared by the instance and the database counted in full by
+	// Counter.SetFullDB, the size sketches of MaxPrefixes prefixes included.
+	// 0 counts every prefix exactly in unbounded memory.
+	PrefixMemory uint64 `json:"prefix_memory" yaml:"prefix_memory"`
+	// TopN is the number of largest keys and slots reported
+	TopN int `json:"top_n" yaml:"top_n"`
+	// PrefixMinBytes and PrefixesPerType trim the prefixes reported, a type
+	// lists prefixes of less than PrefixMinBytes only while it has no more
+	// than PrefixesPerType prefixes
+	PrefixMinBytes  uint64 `json:"prefix_min_bytes" yaml:"prefix_min_bytes"`
+	PrefixesPerType int    `json:"prefixes_per_type" yaml:"prefixes_per_type"`
+	// GroupRules are tried in order, the first matching a key names the
+	// group its prefixes are counted by
+	GroupRules []GroupRule `json:"group_rules,omitempty" yaml:"group_rules"`
+	// DetectTokens replaces uuids, emails, timestamps, dates, hex and
+	// base64 ids in keys matching no rule by placeholders like {uuid}
+	DetectTokens bool `json:"detect_tokens" yaml:"detect_tokens"`
+	// MineTemplates learns templates of keys, replacing parts that vary
+	// by *, for keyspaces of unknown naming
+	MineTemplates bool `json:"mine_templates" yaml:"mine_templates"`
+	// MaxTemplates bounds the templates kept while mining
+	MaxTemplates int `json:"max_templates" yaml:"max_templates"`
+	// EncodingLimits are the packed encoding configs of the instance, and
+	// collections within a fraction NearThreshold of them are listed
+	EncodingLimits EncodingLimits `json:"encoding_limits" yaml:"encoding_limits"`
+	NearThreshold  float64        `json:"near_threshold" yaml:"near_threshold"`
+	// SkewThreshold is the share of the bytes of a collection its largest
+	// element takes for it to be reported as skewed, 0 reports none
+	SkewThreshold float64 `json:"skew_threshold" yaml:"skew_threshold"`
+	// ColdIdleDays is the lru idle time in days beyond which keys are cold
+	ColdIdleDays int `json:"cold_idle_days" yaml:"cold_idle_days"`
+	// InspectValues classifies the values of one in InspectValues strings
+	// and hashes and estimates what compressing them saves, 0 inspects none
+	InspectValues int `json:"inspect_values" yaml:"inspect_values"`
+}
+
+// DefaultAnalysisOptions get the options used when none are given
+func DefaultAnalysisOptions() *AnalysisOptions {
+	return &AnalysisOptions{
+		LengthLevels:    []uint64{100, 1000, 10000, 100000, 1000000},
+		Separators:      ":;,_- ",
+		LargestKeys:     500,
+		MaxPrefixes:     1000,
+		PrefixMemory:    512 << 20,
+		TopN:            100,
+		PrefixMinBytes:  1000 * 1000,
+		PrefixesPerType: 50,
+		MaxTemplates:    1000,
+		EncodingLimits:  defaultEncodingLimits(),
+		NearThreshold:   0.2,
+		SkewThreshold:   0.5,
+		ColdIdleDays:    30,
+	}
+}
+
+// largestElements get the number of largest elements of collections kept,
+// enough for LargestKeys and TopN of them
+func (o *AnalysisOptions) largestElements() int {
+	if o.TopN > o.LargestKeys {
+		return o.TopN
+	}
+	return o.LargestKeys
+}
+
+// validate checks the options are usable by a Counter
+func (o *AnalysisOptions) validate() error {
+	for i := 1; i < len(o.LengthLevels); i++ {
+		if o.LengthLevels[i] <= o.LengthLevels[i-1] {
+			return errors.New("length levels must be ascending")
+		}
+	}
+	if o.LargestKeys < 1 || o.MaxPrefixes < 1 || o.TopN < 1 {
+		return errors.New("largest keys, max prefixes and top n must be positive")
+	}
+	if o.MineTemplates && o.MaxTemplates < 1 {
+		return errors.New("max templates must be positive")
+	}
+	if o.PrefixMemory != 0 && o.PrefixMemory/2 < prefixMemoryFor(o.MaxPrefixes) {
+		return fmt.Errorf("prefix memory must be 0 or at least %s for max prefixes", humanize.IBytes(2*prefixMemoryFor(o.MaxPrefixes)))
+	}
+	if o.NearThreshold < 0 || o.NearThreshold >= 1 {
+		return errors.New("near threshold must be at least 0 and less than 1")
+	}
+	if o.SkewThreshold < 0 || o.SkewThreshold > 1 {
+		return errors.New("skew threshold must be between 0 and 1")
+	}
+	if o.ColdIdleDays < 1 {
+		return errors.New("cold idle days must be positive")
+	}
+	if o.InspectValues < 0 {
+		return errors.New("inspect values must not be negative")
+	}
+	if o.PrefixesPerType < 0 {
+		return errors.New("prefixes per type must not be negative")
+	}
+	_, err := newKeyGrouper(o)
+	return err
+}
+
+// LoadAnalysisOptions reads options from a YAML or JSON file, by its
+// extension. Options missing in the file keep their defaults.
+func LoadAnalysisOptions(path string) (*AnalysisOptions, error) {
+	o := DefaultAnalysisOptions()
+	if err := readConfig(path, o); err != nil {
+		return nil, err
+	}
+	if err := o.validate(); err != nil {
+		return nil, fmt.Errorf("config %s: %v", path, err)
+	}
+	return o, nil
+}
+
+// LoadGroupRules reads a YAML or JSON list of group rules, by the extension
+// of path
+func LoadGroupRules(path string) ([]GroupRule, error) {
+	rules := []GroupRule{}
+	if err := readConfig(path, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// readConfig decodes the YAML or JSON file at path into v
+func readConfig(path string, v interface{}) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		// unknown keys are errors as in yaml, a misspelled option is not
+		// ignored
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(v)
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(b, v)
+	default:
+		return fmt.Errorf("config %s is neither .yaml nor .json", path)
+	}
+	if err != nil {
+		return fmt.Errorf("config %s: %v", path, err)
+	}
+	return nil
+}
+
+// AnalysisFlags set AnalysisOptions of the commands counting keys. Their
+// values are those of DefaultAnalysisOptions, only flags given override the
+// config file.
+var AnalysisFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "config",
+		Usage: "Read analysis options from a YAML or JSON file, flags below override it",
+	},
+	cli.StringFlag{
+		Name:  "length-levels",
+		Value: "100,1000,10000,100000,1000000",
+		Usage: "Comma separated element counts of length levels",
+	},
+	cli.StringFlag{
+		Name:  "separators",
+		Value: ":;,_- ",
+		Usage: "Characters splitting keys into prefixes",
+	},
+	cli.IntFlag{
+		Name:  "largest-keys",
+		Value: 500,
+		Usage: "Number of largest keys kept",
+	},
+	cli.IntFlag{
+		Name:  "max-prefixes",
+		Value: 1000,
+		Usage: "Number of largest key prefixes kept",
+	},
+	cli.StringFlag{
+		Name:  "prefix-memory",
+		Value: "512MiB",
+		Usage: "Memory budget of counting key prefixes, beyond which only the prefixes of the most bytes are kept, 0 counts every prefix exactly",
+	},
+	cli.IntFlag{
+		Name:  "top",
+		Value: 100,
+		Usage: "Number of largest keys and slots reported",
+	},
+	cli.Uint64Flag{
+		Name:  "prefix-min-bytes",
+		Value: 1000 * 1000,
+		Usage: "Prefixes smaller than this are reported only up to --prefixes-per-type per type",
+	},
+	cli.IntFlag{
+		Name:  "prefixes-per-type",
+		Value: 50,
+		Usage: "Number of prefixes smaller than --prefix-min-bytes reported per type",
+	},
+	cli.StringFlag{
+		Name:  "group-rules",
+		Usage: "Group keys by the ordered templates or regexes of a YAML or JSON file, instead of group_rules of the config",
+	},
+	cli.BoolFlag{
+		Name:  "detect-tokens",
+		Usage: "Replace uuids, emails, timestamps, dates, hex and base64 ids in keys by placeholders",
+	},
+	cli.Float64Flag{
+		Name:  "near-threshold",
+		Value: 0.2,
+		Usage: "List collections within this fraction of encoding limits like hash-max-ziplist-entries",
+	},
+	cli.Float64Flag{
+		Name:  "skew-threshold",
+		Value: 0.5,
+		Usage: "Report collections whose largest element takes more than this share of their bytes, 0 reports none",
+	},
+	cli.IntFlag{
+		Name:  "cold-idle-days",
+		Value: 30,
+		Usage: "Count keys idle for longer than this many days as cold, by the lru idle time of the rdb",
+	},
+	cli.IntFlag{
+		Name:  "inspect-values",
+		Usage: "Classify values of one in this many strings and hashes, as json, gzip, base64 etc., and estimate what compressing them saves, 0 inspects none",
+	},
+	cli.BoolFlag{
+		Name:  "mine-templates",
+		Usage: "Learn key templates, replacing parts of keys that vary by *",
+	},
+	cli.IntFlag{
+		Name:  "max-templates",
+		Value: 1000,
+		Usage: "Number of key templates kept while mining",
+	},
+}
+
+// analysisOptions get the options of the config file and flags of c
+func analysisOptions(c *cli.Context) (*AnalysisOptions, error) {
+	o := DefaultAnalysisOptions()
+	if path := c.String("config"); path != "" {
+		var err error
+		if o, err = LoadAnalysisOptions(path); err != nil {
+			return nil, err
+		}
+	}
+	if c.IsSet("length-levels") {
+		o.LengthLevels = nil
+		for _, s := range strings.Split(c.String("length-levels"), ",") {
+			if s = strings.TrimSpace(s); s == "" {
+				continue
+			}
+			n, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid length level %q", s)
+			}
+			o.LengthLevels = append(o.LengthLevels, n)
+		}
+	}
+	if c.IsSet("separators") {
+		o.Separators = c.String("separators")
+	}
+	if c.IsSet("largest-keys") {
+		o.LargestKeys = c.Int("largest-keys")
+	}
+	if c.IsSet("max-prefixes") {
+		o.MaxPrefixes = c.Int("max-prefixes")
+	}
+	if c.IsSet("prefix-memory") {
+		n, err := humanize.ParseBytes(c.String("prefix-memory"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid prefix memory %q", c.String("prefix-memory"))
+		}
+		o.PrefixMemory = n
+	}
+	if c.IsSet("top") {
+		o.TopN = c.Int("top")
+	}
+	if c.IsSet("prefix-min-bytes") {
+		o.PrefixMinBytes = c.Uint64("prefix-min-bytes")
+	}
+	if c.IsSet("prefixes-per-type") {
+		o.PrefixesPerType = c.Int("prefixes-per-type")
+	}
+	if path := c.String("group-rules"); path != "" {
+		rules, err := LoadGroupRules(path)
+		if err != nil {
+			return nil, err
+		}
+		o.GroupRules = rules
+	}
+	if c.IsSet("detect-tokens") {
+		o.DetectTokens = c.Bool("detect-tokens")
+	}
+	if c.IsSet("near-threshold") {
+		o.NearThreshold = c.Float64("near-threshold")
+	}
+	if c.IsSet("skew-threshold") {
+		o.SkewThreshold = c.Float64("skew-threshold")
+	}
+	if c.IsSet("cold-idle-days") {
+		o.ColdIdleDays = c.Int("cold-idle-days")
+	}
+	if c.IsSet("inspect-values") {
+		o.InspectValues = c.Int("inspect-values")
+	}
+	if c.IsSet("mine-templates") {
+		o.MineTemplates = c.Bool("mine-templates")
+	}
+	if c.IsSet("max-templates") {
+		o.MaxTemplates = c.Int("max-templates")
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
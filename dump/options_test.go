@@ -0,0 +1,123 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func TestLoadAnalysisOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "options")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+
+	o, err := LoadAnalysisOptions(write("a.yaml", "length_levels: [10, 20]\nseparators: \":\"\n"))
+	assert.NoError(t, err)
+	expect := DefaultAnalysisOptions()
+	expect.LengthLevels = []uint64{10, 20}
+	expect.Separators = ":"
+	assert.Equal(t, expect, o)
+
+	o, err = LoadAnalysisOptions(write("a.json", `{"top_n": 10, "prefixes_per_type": 5}`))
+	assert.NoError(t, err)
+	expect = DefaultAnalysisOptions()
+	expect.TopN = 10
+	expect.PrefixesPerType = 5
+	assert.Equal(t, expect, o)
+
+	_, err = LoadAnalysisOptions(write("typo.yaml", "top: 10\n"))
+	assert.Error(t, err)
+	_, err = LoadAnalysisOptions(write("typo.json", `{"top": 10}`))
+	assert.EqualError(t, err, "config "+filepath.Join(dir, "typo.json")+`: json: unknown field "top"`)
+	_, err = LoadAnalysisOptions(write("desc.yaml", "length_levels: [20, 10]\n"))
+	assert.Error(t, err)
+	_, err = LoadAnalysisOptions(write("a.toml", ""))
+	assert.Error(t, err)
+}
+
+func TestAnalysisOptionsFlags(t *testing.T) {
+	dir, err := ioutil.TempDir("", "options")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	config := filepath.Join(dir, "rdr.yaml")
+	assert.NoError(t, ioutil.WriteFile(config, []byte("separators: \":\"\ntop_n: 10\n"), 0644))
+
+	var got *AnalysisOptions
+	app := cli.NewApp()
+	app.Commands = []cli.Command{{
+		Name:  "dump",
+		Flags: AnalysisFlags,
+		Action: func(c *cli.Context) error {
+			got, err = analysisOptions(c)
+			return nil
+		},
+	}}
+	assert.NoError(t, app.Run([]string{"rdr", "dump", "--config", config, "--top", "20", "--length-levels", "5,50"}))
+	assert.NoError(t, err)
+	expect := DefaultAnalysisOptions()
+	expect.Separators = ":"
+	expect.TopN = 20
+	expect.LengthLevels = []uint64{5, 50}
+	assert.Equal(t, expect, got)
+
+	assert.NoError(t, app.Run([]string{"rdr", "dump", "--largest-keys", "0"}))
+	assert.Error(t, err)
+}
+
+func TestCounterOptions(t *testing.T) {
+	opts := DefaultAnalysisOptions()
+	opts.LengthLevels = []uint64{1, 10}
+	opts.Separators = "."
+	opts.LargestKeys = 2
+	c := NewCounter(opts)
+	in := make(chan *decoder.Entry, 3)
+	in <- &decoder.Entry{Key: "a.b:1", Type: "hash", Bytes: 10, NumOfElem: 2}
+	in <- &decoder.Entry{Key: "a.b:2", Type: "hash", Bytes: 20, NumOfElem: 20, DB: 1}
+	in <- &decoder.Entry{Key: "c", Type: "hash", Bytes: 30, NumOfElem: 1}
+	close(in)
+	c.Count(in)
+
+	assert.ElementsMatch(t, []*PrefixEntry{
+		{typeKey: typeKey{Type: "hash", Key: "1"}, Bytes: 10, Num: 1},
+		{typeKey: typeKey{Type: "hash", Key: "10"}, Bytes: 20, Num: 1},
+	}, c.GetLenLevelCount())
+	prefixes := []string{}
+	for _, p := range c.GetLargestKeyPrefixes() {
+		prefixes = append(prefixes, p.Key)
+	}
+	assert.ElementsMatch(t, []string{"a", "c"}, prefixes)
+	assert.Len(t, c.GetLargestEntries(10), 2)
+	assert.Equal(t, opts, c.selectDB(1).opts)
+
+	// the options are kept with the result
+	r := c.result()
+	assert.Equal(t, opts, r.Options)
+	assert.Equal(t, opts, r.counter().opts)
+	assert.Equal(t, opts, r.counter().selectDB(1).opts)
+	r.Options = nil
+	assert.Equal(t, DefaultAnalysisOptions(), r.counter().opts)
+}
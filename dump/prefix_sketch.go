@@ -0,0 +1,266 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"container/heap"
+
+	"github.com/xueqiu/rdr/decoder"
+)
+
+// prefixSketch aggregates bytes, keys, ttl buckets and cold keys of key
+// prefixes. Without a capacity every prefix is counted exactly. With one,
+// only that many prefixes are kept by the Space-Saving algorithm weighted
+// by bytes: a new prefix replaces the one of least bytes and starts from its
+// bytes, so Bytes of a prefix is never less than its true bytes and more by
+// at most its Err. Keys, ttl buckets, cold keys and size quantiles are
+// counted from when a prefix is kept, they never overcount.
+//
+// Size quantiles are only sketched for the quantiled prefixes of the most
+// bytes, as each sketch is far larger than a count. A prefix outgrowing the
+// least of them takes its place with empty sketches, so its quantiles are
+// of the keys counted since.
+type prefixSketch struct {
+	capacity int
+	entries  map[typeKey]*sketchEntry
+	// heap orders kept prefixes by bytes, only with a capacity
+	heap    sketchHeap
+	evicted uint64
+	// quantiled is the most prefixes with sketches, top orders them by bytes
+	quantiled int
+	top       topHeap
+}
+
+const (
+	// prefixEntryBytes is about the memory a counted prefix takes: its
+	// entry, its key, and its slots in the map and the heap
+	prefixEntryBytes = 300
+	// prefixSketchBytes is the most memory the two size sketches of a
+	// quantiled prefix take
+	prefixSketchBytes = 2 * maxSketchBins * 16
+)
+
+// prefixMemoryFor get the least memory counting quantiled prefixes takes
+func prefixMemoryFor(quantiled int) uint64 {
+	return uint64(quantiled) * (prefixEntryBytes + prefixSketchBytes)
+}
+
+// prefixCapacity get the prefixes kept within a memory budget of bytes, once
+// the size sketches of quantiled of them are taken from it. It is 0, every
+// prefix, without a budget.
+func prefixCapacity(budget uint64, quantiled int) int {
+	if budget == 0 {
+		return 0
+	}
+	n := quantiled
+	if reserved := uint64(quantiled) * prefixSketchBytes; budget > reserved {
+		n = int((budget - reserved) / prefixEntryBytes)
+	}
+	if n < quantiled {
+		return quantiled
+	}
+	return n
+}
+
+// newBudgetPrefixSketch creates a prefix sketch within budget bytes for the
+// key prefixes opts reports
+func newBudgetPrefixSketch(opts *AnalysisOptions, budget uint64) *prefixSketch {
+	return newPrefixSketch(prefixCapacity(budget, opts.MaxPrefixes), opts.MaxPrefixes)
+}
+
+type sketchEntry struct {
+	*PrefixEntry
+	ttl   ttlCount
+	index int
+	// topIndex is the index in top, -1 if the prefix has no sketches
+	topIndex int
+	// content counts the inspected values of keys of the prefix
+	content *ContentEntry
+}
+
+func newPrefixSketch(capacity, quantiled int) *prefixSketch {
+	return &prefixSketch{capacity: capacity, quantiled: quantiled, entries: map[typeKey]*sketchEntry{}}
+}
+
+func (p *prefixSketch) add(key typeKey, e *decoder.Entry, bucket int, cold bool) {
+	s, ok := p.entries[key]
+	if !ok {
+		s = &sketchEntry{PrefixEntry: &PrefixEntry{typeKey: key}, topIndex: -1}
+		if p.capacity > 0 && len(p.entries) >= p.capacity {
+			min := p.heap[0]
+			delete(p.entries, min.typeKey)
+			if min.topIndex >= 0 {
+				heap.Remove(&p.top, min.topIndex)
+			}
+			s.Bytes, s.Err = min.Bytes, min.Bytes
+			s.index = 0
+			p.heap[0] = s
+			p.evicted++
+		} else if p.capacity > 0 {
+			heap.Push(&p.heap, s)
+		}
+		p.entries[key] = s
+	}
+	s.Bytes += e.Bytes
+	s.Num++
+	p.sketch(s)
+	if s.sizes != nil {
+		s.sizes.Add(e.Bytes)
+		s.elems.Add(e.NumOfElem)
+	}
+	s.ttl[bucket]++
+	if cold {
+		s.ColdBytes += e.Bytes
+		s.ColdNum++
+	}
+	if p.capacity > 0 {
+		heap.Fix(&p.heap, s.index)
+	}
+}
+
+// sketch keeps s in top after its bytes grow, giving it sketches if it is
+// now one of the quantiled prefixes of the most bytes
+func (p *prefixSketch) sketch(s *sketchEntry) {
+	switch {
+	case s.topIndex >= 0:
+		heap.Fix(&p.top, s.topIndex)
+		return
+	case len(p.top) < p.quantiled:
+		heap.Push(&p.top, s)
+	case len(p.top) > 0 && s.Bytes > p.top[0].Bytes:
+		min := p.top[0]
+		min.sizes, min.elems = nil, nil
+		min.topIndex = -1
+		p.top[0] = s
+		s.topIndex = 0
+		heap.Fix(&p.top, 0)
+	default:
+		return
+	}
+	s.sizes, s.elems = NewQuantileSketch(), NewQuantileSketch()
+}
+
+// content get the inspected values of the prefix key, nil if it is not
+// kept
+func (p *prefixSketch) content(key typeKey) *ContentEntry {
+	s, ok := p.entries[key]
+	if !ok {
+		return nil
+	}
+	if s.content == nil {
+		s.content = &ContentEntry{Type: key.Type, Key: key.Key, classLen: map[string]uint64{}}
+	}
+	return s.content
+}
+
+// contents get the inspected values of every kept prefix with any
+func (p *prefixSketch) contents() []*ContentEntry {
+	res := []*ContentEntry{}
+	for _, s := range p.entries {
+		if s.content != nil {
+			res = append(res, s.content)
+		}
+	}
+	return res
+}
+
+// errorBound is the most Bytes of any prefix overcount, and the most bytes
+// a prefix not kept has. It is 0 if no prefix was ever replaced.
+func (p *prefixSketch) errorBound() uint64 {
+	if p.evicted == 0 || len(p.heap) == 0 {
+		return 0
+	}
+	return p.heap[0].Bytes
+}
+
+// drain get the counted prefixes, emptying the sketch
+func (p *prefixSketch) drain() []*PrefixEntry {
+	res := make([]*PrefixEntry, 0, len(p.entries))
+	for _, s := range p.entries {
+		s.TTLNum = map[string]uint64{}
+		for i, num := range s.ttl {
+			if num > 0 {
+				s.TTLNum[ttlBuckets[i]] = num
+			}
+		}
+		res = append(res, s.PrefixEntry)
+	}
+	p.entries = map[typeKey]*sketchEntry{}
+	p.heap = nil
+	p.top = nil
+	return res
+}
+
+type sketchHeap []*sketchEntry
+
+func (h sketchHeap) Len() int {
+	return len(h)
+}
+
+func (h sketchHeap) Less(i, j int) bool {
+	return h[i].Bytes < h[j].Bytes
+}
+
+func (h sketchHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *sketchHeap) Push(x interface{}) {
+	s := x.(*sketchEntry)
+	s.index = len(*h)
+	*h = append(*h, s)
+}
+
+func (h *sketchHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	s := old[n-1]
+	*h = old[:n-1]
+	return s
+}
+
+// topHeap is a sketchHeap indexed by topIndex
+type topHeap []*sketchEntry
+
+func (h topHeap) Len() int {
+	return len(h)
+}
+
+func (h topHeap) Less(i, j int) bool {
+	return h[i].Bytes < h[j].Bytes
+}
+
+func (h topHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].topIndex = i
+	h[j].topIndex = j
+}
+
+func (h *topHeap) Push(x interface{}) {
+	s := x.(*sketchEntry)
+	s.topIndex = len(*h)
+	*h = append(*h, s)
+}
+
+func (h *topHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	s := old[n-1]
+	s.topIndex = -1
+	*h = old[:n-1]
+	return s
+}
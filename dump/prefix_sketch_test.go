@@ -0,0 +1,132 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func TestPrefixSketch(t *testing.T) {
+	exact := newPrefixSketch(0, 10)
+	bounded := newPrefixSketch(20, 10)
+	truth := map[typeKey]uint64{}
+	for i := 0; i < 5000; i++ {
+		// a few large prefixes among many small ones
+		key := typeKey{Type: "string", Key: fmt.Sprintf("small%d", i)}
+		e := &decoder.Entry{Bytes: uint64(i%7 + 1)}
+		if i%10 == 0 {
+			key.Key = fmt.Sprintf("large%d", i%50)
+			e.Bytes = 100
+		}
+		truth[key] += e.Bytes
+		exact.add(key, e, 0, i%2 == 0)
+		bounded.add(key, e, 0, false)
+	}
+
+	assert.Equal(t, uint64(0), exact.errorBound())
+	entries := exact.drain()
+	assert.Len(t, entries, len(truth))
+	sketched := 0
+	for _, e := range entries {
+		assert.Equal(t, truth[e.typeKey], e.Bytes)
+		assert.Equal(t, uint64(0), e.Err)
+		assert.Equal(t, e.Num, e.TTLNum["expired"])
+		if e.sizes != nil {
+			sketched++
+			assert.True(t, e.Bytes >= 7, e.Key)
+		}
+		if e.Bytes > 7 {
+			// the largest prefixes are sketched from their first key
+			assert.Equal(t, e.Num, e.sizes.Count, e.Key)
+		}
+	}
+	assert.Equal(t, 10, sketched)
+	assert.Empty(t, exact.entries)
+
+	bound := bounded.errorBound()
+	assert.True(t, bound > 0)
+	kept := map[typeKey]bool{}
+	for _, e := range bounded.drain() {
+		kept[e.typeKey] = true
+		assert.True(t, e.Bytes >= truth[e.typeKey], e.Key)
+		assert.True(t, e.Bytes-e.Err <= truth[e.typeKey], e.Key)
+		assert.True(t, e.Err <= bound, e.Key)
+	}
+	assert.Len(t, kept, 20)
+	for key, bytes := range truth {
+		if !kept[key] {
+			assert.True(t, bytes <= bound, key.Key)
+		}
+	}
+	// prefixes larger than the bound are always kept
+	for i := 0; i < 5; i++ {
+		key := typeKey{Type: "string", Key: fmt.Sprintf("large%d", i*10)}
+		assert.True(t, truth[key] > bound)
+		assert.True(t, kept[key], key.Key)
+	}
+}
+
+func TestCounterTrackedPrefixes(t *testing.T) {
+	count := func(opts *AnalysisOptions) *Counter {
+		c := NewCounter(opts)
+		in := make(chan *decoder.Entry)
+		go func() {
+			for i := 0; i < 3000; i++ {
+				in <- &decoder.Entry{Key: fmt.Sprintf("app%c:user:%d", 'a'+i%3, i), Type: "string", Bytes: uint64(10 * (i%3 + 1))}
+				in <- &decoder.Entry{Key: fmt.Sprintf("tmp%c%c%c", 'a'+i%26, 'a'+i/26%26, 'a'+i/676), Type: "string", Bytes: 1}
+			}
+			close(in)
+		}()
+		c.Count(in)
+		return c
+	}
+	top := func(c *Counter) map[string]uint64 {
+		res := map[string]uint64{}
+		for _, e := range c.GetLargestKeyPrefixes()[:6] {
+			res[e.Key] = e.Bytes - e.Err
+		}
+		return res
+	}
+
+	exact := count(nil)
+	assert.Equal(t, uint64(0), exact.prefixErrorBound)
+	opts := DefaultAnalysisOptions()
+	opts.MaxPrefixes = 10
+	opts.PrefixMemory = 10*prefixSketchBytes + 100*prefixEntryBytes
+	bounded := count(opts)
+	assert.True(t, bounded.prefixErrorBound > 0)
+	assert.Len(t, bounded.GetLargestKeyPrefixes(), 10)
+	assert.Equal(t, top(exact), top(bounded))
+
+	// the bound is kept with the result
+	assert.Equal(t, bounded.prefixErrorBound, bounded.result().counter().prefixErrorBound)
+
+	assert.Equal(t, 100, bounded.prefixSketch.capacity)
+
+	// the database of --db shares the budget
+	shared := NewCounter(opts)
+	shared.SetFullDB(0)
+	assert.Equal(t, 10, shared.prefixSketch.capacity)
+	assert.Equal(t, 0, NewCounter(&AnalysisOptions{MaxPrefixes: 10}).prefixSketch.capacity)
+
+	opts.PrefixMemory = prefixMemoryFor(10)
+	assert.Error(t, opts.validate())
+	opts.PrefixMemory = 2 * prefixMemoryFor(10)
+	assert.NoError(t, opts.validate())
+}
@@ -15,23 +15,27 @@
 package dump
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/dongmx/rdb"
 	"github.com/julienschmidt/httprouter"
 )
 
 // ParseProgress tracks the parsing progress of a file
 type ParseProgress struct {
 	Filename    string
-	Status      string // "pending", "parsing", "completed", "error"
+	Status      string // "pending", "parsing", "saving", "completed", "error", "cancelled"
 	StartTime   time.Time
 	Progress    int // 0-100
 	CurrentStep string
 	Logs        []string
 	Error       string
+	meter       *meter
+	salvage     *rdb.SalvageReport
 	mu          sync.RWMutex
 }
 
@@ -56,6 +60,13 @@ func NewParseProgress(filename string) *ParseProgress {
 	return pp
 }
 
+// removeProgress drops the progress tracker of a file
+func removeProgress(filename string) {
+	progressMutex.Lock()
+	defer progressMutex.Unlock()
+	delete(progressTrackers, filename)
+}
+
 // GetProgress retrieves progress for a file
 func GetProgress(filename string) *ParseProgress {
 	progressMutex.RLock()
@@ -79,6 +90,18 @@ func (pp *ParseProgress) SetStatus(status string) {
 	pp.Status = status
 }
 
+// compareAndSetStatus sets the status to status if it is old, and reports
+// whether it did
+func (pp *ParseProgress) compareAndSetStatus(old, status string) bool {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	if pp.Status != old {
+		return false
+	}
+	pp.Status = status
+	return true
+}
+
 // SetProgress updates the progress percentage
 func (pp *ParseProgress) SetProgress(progress int) {
 	pp.mu.Lock()
@@ -101,13 +124,51 @@ func (pp *ParseProgress) SetError(err string) {
 	pp.Status = "error"
 }
 
+// SetMeter sets the meter of the decoding, progress is reported by bytes
+// read from then on
+func (pp *ParseProgress) SetMeter(m *meter) {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	pp.meter = m
+}
+
+// SetSalvageReport sets what salvage decoding skipped
+func (pp *ParseProgress) SetSalvageReport(report *rdb.SalvageReport) {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	pp.salvage = report
+}
+
+// finishMeter stops the clock of the meter if there is one
+func (pp *ParseProgress) finishMeter() {
+	pp.mu.RLock()
+	defer pp.mu.RUnlock()
+	if pp.meter != nil {
+		pp.meter.finish()
+	}
+}
+
+// Failed reports whether an error has been set or the parse is cancelled
+func (pp *ParseProgress) Failed() bool {
+	pp.mu.RLock()
+	defer pp.mu.RUnlock()
+	return pp.Status == "error" || pp.Status == "cancelled"
+}
+
+// Cancelled reports whether the parse is cancelled
+func (pp *ParseProgress) Cancelled() bool {
+	pp.mu.RLock()
+	defer pp.mu.RUnlock()
+	return pp.Status == "cancelled"
+}
+
 // GetData returns progress data for JSON
 func (pp *ParseProgress) GetData() map[string]interface{} {
 	pp.mu.RLock()
 	defer pp.mu.RUnlock()
 
 	duration := time.Since(pp.StartTime)
-	return map[string]interface{}{
+	data := map[string]interface{}{
 		"filename":    pp.Filename,
 		"status":      pp.Status,
 		"progress":    pp.Progress,
@@ -116,6 +177,31 @@ func (pp *ParseProgress) GetData() map[string]interface{} {
 		"error":       pp.Error,
 		"duration":    duration.Seconds(),
 	}
+
+	stats := meterStats{ETA: -1}
+	if pp.meter != nil {
+		stats = pp.meter.stats()
+		// decoding goes from 20% to 90% by bytes read
+		if p := 20 + int(70*stats.fraction()); pp.Status == "parsing" && p > pp.Progress {
+			data["progress"] = p
+		}
+	}
+	if pp.Status != "parsing" {
+		stats.ETA = -1
+	}
+	data["bytesRead"] = stats.BytesRead
+	data["totalBytes"] = stats.TotalBytes
+	data["keys"] = stats.Keys
+	data["keysPerSec"] = stats.KeysPerSec
+	data["bytesPerSec"] = stats.BytesPerSec
+	data["eta"] = stats.ETA
+	if pp.salvage != nil {
+		data["partial"] = pp.salvage.Partial()
+		data["truncated"] = pp.salvage.Truncated
+		data["skippedBytes"] = pp.salvage.SkippedBytes()
+		data["skipped"] = pp.salvage.Skipped
+	}
+	return data
 }
 
 // progressHandler returns progress data as JSON
@@ -131,17 +217,8 @@ func progressHandler(w http.ResponseWriter, r *http.Request, p httprouter.Params
 
 	w.Header().Set("Content-Type", "application/json")
 	data := pp.GetData()
-
-	// Convert to JSON manually for simplicity
-	w.Write([]byte(fmt.Sprintf(`{
-		"filename": "%s",
-		"status": "%s",
-		"progress": %d,
-		"currentStep": "%s",
-		"duration": %.1f,
-		"error": "%s"
-	}`, data["filename"], data["status"], data["progress"],
-		data["currentStep"], data["duration"], data["error"])))
+	delete(data, "logs")
+	json.NewEncoder(w).Encode(data)
 }
 
 // streamLogsHandler streams logs using Server-Sent Events
@@ -180,27 +257,44 @@ func streamLogsHandler(w http.ResponseWriter, r *http.Request, p httprouter.Para
 		case <-r.Context().Done():
 			return
 		case <-ticker.C:
-			pp.mu.RLock()
-			currentLogCount := len(pp.Logs)
-			status := pp.Status
-			progress := pp.Progress
+			data := pp.GetData()
+			logs := data["logs"].([]string)
+			status := data["status"]
 
 			// Send new logs
-			if currentLogCount > lastLogIndex {
-				for i := lastLogIndex; i < currentLogCount; i++ {
-					fmt.Fprintf(w, "data: {\"type\":\"log\",\"message\":\"%s\"}\n\n", pp.Logs[i])
+			if len(logs) > lastLogIndex {
+				for i := lastLogIndex; i < len(logs); i++ {
+					event, _ := json.Marshal(map[string]string{"type": "log", "message": logs[i]})
+					fmt.Fprintf(w, "data: %s\n\n", event)
 				}
-				lastLogIndex = currentLogCount
+				lastLogIndex = len(logs)
 				flusher.Flush()
 			}
 
 			// Send progress update
-			fmt.Fprintf(w, "data: {\"type\":\"progress\",\"status\":\"%s\",\"progress\":%d}\n\n", status, progress)
+			event := map[string]interface{}{
+				"type":        "progress",
+				"status":      status,
+				"progress":    data["progress"],
+				"bytesRead":   data["bytesRead"],
+				"totalBytes":  data["totalBytes"],
+				"keys":        data["keys"],
+				"keysPerSec":  data["keysPerSec"],
+				"bytesPerSec": data["bytesPerSec"],
+				"eta":         data["eta"],
+			}
+			// a salvaged parse shows what it skipped
+			for _, k := range []string{"partial", "truncated", "skippedBytes"} {
+				if v, ok := data[k]; ok {
+					event[k] = v
+				}
+			}
+			progress, _ := json.Marshal(event)
+			fmt.Fprintf(w, "data: %s\n\n", progress)
 			flusher.Flush()
-			pp.mu.RUnlock()
 
-			// Stop if completed or error
-			if status == "completed" || status == "error" {
+			// Stop if completed, error or cancelled
+			if status == "completed" || status == "error" || status == "cancelled" {
 				time.Sleep(2 * time.Second)
 				return
 			}
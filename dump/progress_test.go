@@ -0,0 +1,70 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dongmx/rdb"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamLogs(t *testing.T) {
+	router := httprouter.New()
+	router.GET("/api/stream/:path", streamLogsHandler)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	pp := NewParseProgress("stream_test")
+	defer removeProgress("stream_test")
+	pp.SetStatus("parsing")
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		pp.AddLog(`WARNING: skipped 10 bytes at offset 5, key "a\b": bad "length"`)
+		pp.SetSalvageReport(&rdb.SalvageReport{Truncated: true, Skipped: []rdb.SkippedRange{{Offset: 5, Bytes: 10}}})
+		pp.SetStatus("completed")
+	}()
+
+	resp, err := http.Get(server.URL + "/api/stream/stream_test")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	events := []map[string]interface{}{}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		event := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal([]byte(line[len("data: "):]), &event), line)
+		events = append(events, event)
+	}
+
+	assert.Len(t, events, 2)
+	assert.Equal(t, "log", events[0]["type"])
+	assert.Contains(t, events[0]["message"], `key "a\b": bad "length"`)
+	assert.Equal(t, "progress", events[1]["type"])
+	assert.Equal(t, "completed", events[1]["status"])
+	assert.Equal(t, true, events[1]["partial"])
+	assert.Equal(t, true, events[1]["truncated"])
+	assert.Equal(t, float64(10), events[1]["skippedBytes"])
+}
@@ -0,0 +1,244 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// relativeAccuracy of the quantiles of a QuantileSketch
+	relativeAccuracy = 0.01
+	// maxSketchBins bounds the bins of a QuantileSketch, the lowest bins are
+	// collapsed beyond it. Sizes up to 2^64 need less than 2300 bins.
+	maxSketchBins = 2048
+)
+
+var (
+	sketchGamma    = (1 + relativeAccuracy) / (1 - relativeAccuracy)
+	sketchLogGamma = math.Log(sketchGamma)
+)
+
+// QuantileSketch is a DDSketch of sizes: values are counted in bins growing
+// exponentially, so any quantile is within relativeAccuracy of the true one
+// and sketches of parts of a population merge into the sketch of all of it.
+// Count, Sum, Min and Max are exact.
+type QuantileSketch struct {
+	Count uint64
+	Sum   uint64
+	// SumSq is the sum of squared values, for the standard deviation
+	SumSq float64
+	Min   uint64
+	Max   uint64
+	// Zero is the number of values of 0, which have no bin
+	Zero uint64      `json:",omitempty"`
+	Bins []sketchBin `json:",omitempty"`
+}
+
+// sketchBin counts values v with gamma^(Index-1) < v <= gamma^Index
+type sketchBin struct {
+	Index int32  `json:"i"`
+	Num   uint64 `json:"n"`
+}
+
+// NewQuantileSketch return an empty sketch
+func NewQuantileSketch() *QuantileSketch {
+	return &QuantileSketch{}
+}
+
+func binIndex(v uint64) int32 {
+	return int32(math.Ceil(math.Log(float64(v)) / sketchLogGamma))
+}
+
+// binValue is the value all values of bin i are taken for, within
+// relativeAccuracy of each of them
+func binValue(i int32) float64 {
+	return 2 * math.Pow(sketchGamma, float64(i)) / (sketchGamma + 1)
+}
+
+// Add a value to the sketch
+func (s *QuantileSketch) Add(v uint64) {
+	if s.Count == 0 || v < s.Min {
+		s.Min = v
+	}
+	if v > s.Max {
+		s.Max = v
+	}
+	s.Count++
+	s.Sum += v
+	s.SumSq += float64(v) * float64(v)
+	if v == 0 {
+		s.Zero++
+		return
+	}
+	idx := binIndex(v)
+	i := sort.Search(len(s.Bins), func(i int) bool { return s.Bins[i].Index >= idx })
+	if i < len(s.Bins) && s.Bins[i].Index == idx {
+		s.Bins[i].Num++
+		return
+	}
+	s.Bins = append(s.Bins, sketchBin{})
+	copy(s.Bins[i+1:], s.Bins[i:])
+	s.Bins[i] = sketchBin{Index: idx, Num: 1}
+	s.collapse()
+}
+
+// Merge adds every value of o to s
+func (s *QuantileSketch) Merge(o *QuantileSketch) {
+	if o == nil || o.Count == 0 {
+		return
+	}
+	if s.Count == 0 || o.Min < s.Min {
+		s.Min = o.Min
+	}
+	if o.Max > s.Max {
+		s.Max = o.Max
+	}
+	s.Count += o.Count
+	s.Sum += o.Sum
+	s.SumSq += o.SumSq
+	s.Zero += o.Zero
+
+	bins := make([]sketchBin, 0, len(s.Bins)+len(o.Bins))
+	i, j := 0, 0
+	for i < len(s.Bins) || j < len(o.Bins) {
+		switch {
+		case j == len(o.Bins) || i < len(s.Bins) && s.Bins[i].Index < o.Bins[j].Index:
+			bins = append(bins, s.Bins[i])
+			i++
+		case i == len(s.Bins) || o.Bins[j].Index < s.Bins[i].Index:
+			bins = append(bins, o.Bins[j])
+			j++
+		default:
+			bins = append(bins, sketchBin{Index: s.Bins[i].Index, Num: s.Bins[i].Num + o.Bins[j].Num})
+			i++
+			j++
+		}
+	}
+	s.Bins = bins
+	s.collapse()
+}
+
+// collapse the lowest bins into one while there are too many, losing the
+// accuracy of low quantiles rather than of high ones
+func (s *QuantileSketch) collapse() {
+	if len(s.Bins) <= maxSketchBins {
+		return
+	}
+	n := len(s.Bins) - maxSketchBins
+	for _, b := range s.Bins[:n] {
+		s.Bins[n].Num += b.Num
+	}
+	s.Bins = append(s.Bins[:0], s.Bins[n:]...)
+}
+
+// Quantile get the value of quantile q, 0 <= q <= 1
+func (s *QuantileSketch) Quantile(q float64) uint64 {
+	if s.Count == 0 {
+		return 0
+	}
+	if q <= 0 {
+		return s.Min
+	}
+	if q >= 1 {
+		return s.Max
+	}
+	rank := q * float64(s.Count-1)
+	n := s.Zero
+	if rank < float64(n) {
+		return 0
+	}
+	for _, b := range s.Bins {
+		n += b.Num
+		if float64(n) > rank {
+			v := uint64(math.Round(binValue(b.Index)))
+			if v < s.Min {
+				return s.Min
+			}
+			if v > s.Max {
+				return s.Max
+			}
+			return v
+		}
+	}
+	return s.Max
+}
+
+// CountBelow get the number of values less than v, values less than v by
+// no more than relativeAccuracy may not be counted
+func (s *QuantileSketch) CountBelow(v uint64) uint64 {
+	if v == 0 {
+		return 0
+	}
+	n := s.Zero
+	for _, b := range s.Bins {
+		if math.Pow(sketchGamma, float64(b.Index)) >= float64(v) {
+			break
+		}
+		n += b.Num
+	}
+	return n
+}
+
+// Mean of the values
+func (s *QuantileSketch) Mean() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.Sum) / float64(s.Count)
+}
+
+// Stddev is the standard deviation of the values
+func (s *QuantileSketch) Stddev() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	mean := s.Mean()
+	return math.Sqrt(math.Max(0, s.SumSq/float64(s.Count)-mean*mean))
+}
+
+// SizeQuantiles summarize the sizes of a population of keys
+type SizeQuantiles struct {
+	Min  uint64
+	P50  uint64
+	P90  uint64
+	P95  uint64
+	P99  uint64
+	Max  uint64
+	Mean float64
+}
+
+// Summary get the usual quantiles of s
+func (s *QuantileSketch) Summary() *SizeQuantiles {
+	return &SizeQuantiles{
+		Min:  s.Min,
+		P50:  s.Quantile(0.5),
+		P90:  s.Quantile(0.9),
+		P95:  s.Quantile(0.95),
+		P99:  s.Quantile(0.99),
+		Max:  s.Max,
+		Mean: s.Mean(),
+	}
+}
+
+// summaries get the quantiles of every sketch of m
+func summaries(m map[string]*QuantileSketch) map[string]*SizeQuantiles {
+	res := map[string]*SizeQuantiles{}
+	for k, s := range m {
+		res[k] = s.Summary()
+	}
+	return res
+}
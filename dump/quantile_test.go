@@ -0,0 +1,113 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func TestQuantileSketch(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	a, b := NewQuantileSketch(), NewQuantileSketch()
+	values := []uint64{}
+	for i := 0; i < 20000; i++ {
+		// log-normal sizes, like those of keys
+		v := uint64(math.Exp(r.NormFloat64()*2 + 5))
+		values = append(values, v)
+		if i%2 == 0 {
+			a.Add(v)
+		} else {
+			b.Add(v)
+		}
+	}
+	a.Merge(b)
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	assert.Equal(t, uint64(len(values)), a.Count)
+	assert.Equal(t, values[0], a.Min)
+	assert.Equal(t, values[len(values)-1], a.Max)
+	for _, q := range []float64{0.1, 0.5, 0.9, 0.95, 0.99} {
+		exact := float64(values[int(q*float64(len(values)-1))])
+		assert.InDelta(t, exact, float64(a.Quantile(q)), exact*relativeAccuracy+1, "q%v", q)
+	}
+	below := sort.Search(len(values), func(i int) bool { return values[i] >= 100 })
+	assert.InDelta(t, below, a.CountBelow(100), float64(len(values))*0.01)
+
+	// a stored sketch answers the same
+	buf, err := json.Marshal(a)
+	assert.NoError(t, err)
+	c := NewQuantileSketch()
+	assert.NoError(t, json.Unmarshal(buf, c))
+	assert.Equal(t, a.Summary(), c.Summary())
+}
+
+func TestQuantileSketchBounded(t *testing.T) {
+	s := NewQuantileSketch()
+	s.Add(0)
+	for v := uint64(1); v < 1<<60; v = v*11/10 + 1 {
+		s.Add(v)
+	}
+	assert.True(t, len(s.Bins) <= maxSketchBins)
+	assert.Equal(t, uint64(0), s.Quantile(0))
+	assert.Equal(t, uint64(0), s.CountBelow(0))
+	assert.Equal(t, uint64(1), s.CountBelow(1))
+	assert.Equal(t, &SizeQuantiles{}, NewQuantileSketch().Summary())
+}
+
+func TestSizeQuantilesOverPopulation(t *testing.T) {
+	opts := DefaultAnalysisOptions()
+	opts.LargestKeys = 10
+	c := NewCounter(opts)
+	in := make(chan *decoder.Entry, 1020)
+	// many tiny keys the largest keys never see
+	for i := 0; i < 1000; i++ {
+		in <- &decoder.Entry{Key: "tiny:x", Type: "string", Bytes: 50, NumOfElem: 1}
+	}
+	for i := 0; i < 20; i++ {
+		in <- &decoder.Entry{Key: "big:x", Type: "string", Bytes: 100000, NumOfElem: 1}
+	}
+	close(in)
+	c.Count(in)
+
+	oa := NewOpsAnalyzer(c)
+	e := oa.typeEfficiency["string"]
+	assert.Equal(t, uint64(1020), e.Keys)
+	assert.InDelta(t, 50, e.MedianSize, 1)
+	assert.InDelta(t, 100000, e.P99Size, 100000*relativeAccuracy)
+	found := false
+	for _, a := range oa.anomalies {
+		found = found || a.Title == "Many Tiny Keys Detected"
+	}
+	assert.True(t, found)
+	assert.Equal(t, uint64(1020), oa.sizeDistribution["all"].Keys)
+
+	for _, p := range c.GetLargestKeyPrefixes() {
+		if p.Key == "tiny" {
+			assert.InDelta(t, 50, p.Sizes.P99, 1)
+			assert.Equal(t, uint64(1), p.Elems.Max)
+		}
+	}
+	// sketches are kept with the result
+	r := c.result().counter()
+	assert.Equal(t, c.typeSizes["string"].Summary(), r.typeSizes["string"].Summary())
+	assert.Equal(t, c.GetLargestKeyPrefixes()[0].Sizes, r.GetLargestKeyPrefixes()[0].Sizes)
+}
@@ -0,0 +1,138 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+// recountable reports whether the databases of the instance name can be
+// counted in full again, which needs the file it was parsed from
+func recountable(name string) bool {
+	entry, ok := GetHistoryManager().Get(name)
+	if !ok || strings.HasPrefix(entry.FilePath, syncSource) {
+		return false
+	}
+	_, err := os.Stat(entry.FilePath)
+	return err == nil
+}
+
+// recountHandler parses the file of an instance again to count the
+// database given by the db form value in every dimension, as databases are
+// only summarized while parsing the whole instance
+func recountHandler(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	name := p.ByName("path")
+	c, parsing := getCounter(name)
+	if c == nil {
+		if parsing {
+			respondWithError(w, "实例正在解析", http.StatusConflict)
+			return
+		}
+		respondWithError(w, "实例不存在", http.StatusNotFound)
+		return
+	}
+	db, err := strconv.Atoi(r.FormValue("db"))
+	if _, ok := c.dbs[db]; err != nil || !ok {
+		respondWithError(w, "数据库不存在: "+r.FormValue("db"), http.StatusBadRequest)
+		return
+	}
+	if !recountable(name) {
+		respondWithError(w, "源文件已不存在，无法重新统计", http.StatusConflict)
+		return
+	}
+	if getJob(name) != nil {
+		respondWithError(w, "实例正在解析", http.StatusConflict)
+		return
+	}
+	entry, _ := GetHistoryManager().Get(name)
+
+	pp := NewParseProgress(name)
+	pp.AddLog(fmt.Sprintf("Counting db%d of %s in full", db, entry.FilePath))
+	pp.SetStatus("parsing")
+	job := startJob(name, "", pp)
+	job.db = db
+	go func() {
+		defer job.finish()
+		dec := decodeJobFile(job, entry.FilePath)
+		countDB(job, c, dec)
+	}()
+
+	json.NewEncoder(w).Encode(UploadResponse{
+		Success:   true,
+		Message:   "开始统计",
+		Instances: []string{name},
+	})
+}
+
+// countDB counts the entries of the database of job decoded by dec in
+// every dimension, then saves c with it as the counter of the database
+func countDB(job *Job, c *Counter, dec *decoder.Decoder) {
+	name, pp := job.ID, job.progress
+	pp.AddLog(fmt.Sprintf("Counting and analyzing entries of db%d...", job.db))
+	pp.SetProgress(30)
+
+	dc := NewCounter(c.opts)
+	dc.dbs = nil
+	for e := range dec.Entries {
+		if e.DB == job.db {
+			dc.count(e)
+		}
+	}
+	dc.calcuLargestKeyPrefix(dc.opts.MaxPrefixes)
+	dc.calcuKeyTemplates()
+	elems := []*decoder.Element{}
+	for _, elem := range dec.GetLargestElements() {
+		if elem.DB == job.db {
+			elems = append(elems, elem)
+		}
+	}
+	dc.SetLargestElements(elems)
+	pp.finishMeter()
+	if pp.Failed() {
+		return
+	}
+	if job.ctx.Err() != nil || !pp.compareAndSetStatus("parsing", "saving") {
+		return
+	}
+	pp.AddLog("Saving statistics...")
+	pp.SetProgress(90)
+
+	// the counter shown may be read meanwhile, a copy takes the database
+	full := *c
+	full.dbs = map[int]*Counter{}
+	for n, d := range c.dbs {
+		full.dbs[n] = d
+	}
+	full.dbs[job.db] = dc
+	counters.Set(name, &full)
+	if err := saveResult(name, &full); err != nil {
+		log.Printf("Error saving result of %v: %v", name, err)
+	}
+
+	pp.AddLog("Analysis complete!")
+	pp.SetProgress(100)
+	pp.SetStatus("completed")
+}
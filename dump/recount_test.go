@@ -0,0 +1,83 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+)
+
+// waitJob waits until the job of id has finished
+func waitJob(t *testing.T, id string) {
+	for i := 0; getJob(id) != nil; i++ {
+		if i > 500 {
+			t.Fatalf("job %s does not finish", id)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestRecountDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recount")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	InitHistoryManager(filepath.Join(dir, "history.json"))
+	defer func() { historyManager = nil }()
+	path, err := filepath.Abs("../third_party/rdb/fixtures/multiple_databases.rdb")
+	assert.NoError(t, err)
+
+	const name = "recount_test"
+	defer counters.Delete(name)
+	defer removeProgress(name)
+	pp := NewParseProgress(name)
+	pp.SetStatus("parsing")
+	startParseJob(startJob(name, "", pp), path, 0)
+	waitJob(t, name)
+	c, _ := getCounter(name)
+	assert.True(t, c.selectDB(2).light)
+	assert.Empty(t, c.selectDB(2).GetLargestKeyPrefixes())
+
+	router := httprouter.New()
+	router.POST("/api/recount/:path", recountHandler)
+	post := func(db string) int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/api/recount/"+name, strings.NewReader("db="+db))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		router.ServeHTTP(w, r)
+		return w.Code
+	}
+	assert.Equal(t, http.StatusBadRequest, post("5"))
+	assert.Equal(t, http.StatusOK, post("2"))
+	waitJob(t, name)
+
+	full, _ := getCounter(name)
+	assert.Equal(t, "completed", GetProgress(name).GetData()["status"])
+	dc := full.selectDB(2)
+	assert.False(t, dc.light)
+	assert.NotEmpty(t, dc.GetLargestKeyPrefixes())
+	assert.Equal(t, c.selectDB(2).typeBytes, dc.typeBytes)
+	assert.True(t, full.selectDB(0).light)
+	// the counter shown before is left as it was
+	assert.True(t, c.selectDB(2).light)
+}
@@ -16,6 +16,7 @@ package dump
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/julienschmidt/httprouter"
 )
@@ -32,6 +33,15 @@ func showTerminal(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 	http.ServeFile(w, r, "views/terminal.html")
 }
 
+// requestDB get the database selected by the db query, -1 for all databases
+func requestDB(r *http.Request) int {
+	n, err := strconv.Atoi(r.URL.Query().Get("db"))
+	if err != nil || n < 0 {
+		return -1
+	}
+	return n
+}
+
 func rdbReveal(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 	// deep copy  tplCommonData into data
 	data := map[string]interface{}{}
@@ -41,29 +51,48 @@ func rdbReveal(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 
 	path := p.ByName("path")
 
-	c := counters.Get(path)
+	c, parsing := getCounter(path)
 	if c == nil {
+		if !parsing {
+			http.Error(w, "instance not found: "+path, http.StatusNotFound)
+			return
+		}
 		// Counter not ready yet, redirect to terminal page
 		http.Redirect(w, r, "/terminal/"+path, http.StatusFound)
 		return
 	}
-	counter := c.(*Counter)
+	db := requestDB(r)
+	counter := c.selectDB(db)
 
 	data["CurrentInstance"] = path
-	data["LargestKeys"] = counter.GetLargestEntries(100)
+	if entry, ok := GetHistoryManager().Get(path); ok {
+		data["ChecksumMismatch"] = entry.ChecksumMismatch
+		data["Partial"] = entry.Partial
+		data["SkippedBytes"] = entry.SkippedBytes
+	}
+	data["Databases"] = c.GetDBCount()
+	data["CurrentDB"] = db
+	data["DBSummaryOnly"] = counter.light
+	data["DBRecountable"] = counter.light && recountable(path)
+	data["LargestKeys"] = counter.GetLargestEntries(counter.opts.TopN)
+	data["Options"] = counter.opts
+	data["KeyTemplates"] = counter.GetKeyTemplates()
 
 	largestKeyPrefixesByType := map[string][]*PrefixEntry{}
 	for _, entry := range counter.GetLargestKeyPrefixes() {
-		// mem use less than 1M, and list is long enough, not necessary to add
-		if entry.Bytes < 1000*1000 && len(largestKeyPrefixesByType[entry.Type]) > 50 {
+		// mem use less than PrefixMinBytes, and list is long enough, not necessary to add
+		if entry.Bytes < counter.opts.PrefixMinBytes && len(largestKeyPrefixesByType[entry.Type]) > counter.opts.PrefixesPerType {
 			continue
 		}
 		largestKeyPrefixesByType[entry.Type] = append(largestKeyPrefixesByType[entry.Type], entry)
 	}
 	data["LargestKeyPrefixes"] = largestKeyPrefixesByType
+	data["PrefixErrorBound"] = counter.prefixErrorBound
 
 	data["TypeBytes"] = counter.typeBytes
 	data["TypeNum"] = counter.typeNum
+	data["TypeSizes"] = summaries(counter.typeSizes)
+	data["TypeElems"] = summaries(counter.typeElems)
 	totleNum := uint64(0)
 	for _, v := range counter.typeNum {
 		totleNum += v
@@ -80,5 +109,36 @@ func rdbReveal(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		lenLevelCount[entry.Type] = append(lenLevelCount[entry.Type], entry)
 	}
 	data["LenLevelCount"] = lenLevelCount
+
+	encodingCount := map[string][]*PrefixEntry{}
+	for _, entry := range counter.GetEncodingCount() {
+		encodingCount[entry.Type] = append(encodingCount[entry.Type], entry)
+	}
+	data["EncodingCount"] = encodingCount
+	data["NearThresholds"] = counter.GetNearThresholds()
+	data["LargestElements"] = counter.GetLargestElements(counter.opts.TopN)
+	data["SkewedKeys"] = counter.GetSkewedEntries(counter.opts.TopN)
+	data["SkewedNum"] = counter.skewedNum
+	data["SkewedBytes"] = counter.skewedBytes
+	data["ContentClasses"] = counter.GetContentClasses()
+	data["ContentPrefixes"] = counter.GetContentPrefixes(counter.opts.TopN)
+
+	ttlCount := map[string][]*PrefixEntry{}
+	for _, entry := range counter.GetTTLCount() {
+		ttlCount[entry.Type] = append(ttlCount[entry.Type], entry)
+	}
+	data["TTLCount"] = ttlCount
+
+	idleCount := map[string][]*PrefixEntry{}
+	for _, entry := range counter.GetIdleCount() {
+		idleCount[entry.Type] = append(idleCount[entry.Type], entry)
+	}
+	data["IdleCount"] = idleCount
+
+	freqCount := map[string][]*PrefixEntry{}
+	for _, entry := range counter.GetFreqCount() {
+		freqCount[entry.Type] = append(freqCount[entry.Type], entry)
+	}
+	data["FreqCount"] = freqCount
 	ServeHTML(w, "base.html", "ops_enhanced_revel.html", data)
 }
@@ -0,0 +1,308 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"compress/gzip"
+	"container/heap"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/xueqiu/rdr/decoder"
+)
+
+// resultVersion is bumped when the stored result changes incompatibly,
+// results of other versions are parsed again
+const resultVersion = 4
+
+// resultsDir is where the web server stores counters of finished parses,
+// set by the --results flag of web. Results are not stored if it is empty.
+var resultsDir string
+
+// loadMutex serializes loading of counters so an instance is loaded or
+// parsed again only once
+var loadMutex sync.Mutex
+
+// counterResult is what a Counter keeps after counting, stored as gzipped
+// json in resultsDir. Maps keyed by typeKey are stored as lists of entries.
+type counterResult struct {
+	Version            int                        `json:",omitempty"`
+	LargestEntries     []*decoder.Entry           `json:",omitempty"`
+	LargestKeyPrefixes []*PrefixEntry             `json:",omitempty"`
+	LengthLevel        []*PrefixEntry             `json:",omitempty"`
+	Encoding           []*PrefixEntry             `json:",omitempty"`
+	NearThresholds     []*NearThreshold           `json:",omitempty"`
+	LargestElements    []*decoder.Element         `json:",omitempty"`
+	SkewedEntries      []*decoder.Entry           `json:",omitempty"`
+	SkewedNum          uint64                     `json:",omitempty"`
+	SkewedBytes        uint64                     `json:",omitempty"`
+	ColdBytes          uint64                     `json:",omitempty"`
+	ColdNum            uint64                     `json:",omitempty"`
+	ContentClasses     []*ContentEntry            `json:",omitempty"`
+	ContentPrefixes    []*ContentEntry            `json:",omitempty"`
+	TTL                []*PrefixEntry             `json:",omitempty"`
+	Idle               []*PrefixEntry             `json:",omitempty"`
+	Freq               []*PrefixEntry             `json:",omitempty"`
+	TypeBytes          map[string]uint64          `json:",omitempty"`
+	TypeNum            map[string]uint64          `json:",omitempty"`
+	TypeSizes          map[string]*QuantileSketch `json:",omitempty"`
+	TypeElems          map[string]*QuantileSketch `json:",omitempty"`
+	SlotBytes          map[int]uint64             `json:",omitempty"`
+	SlotNum            map[int]uint64             `json:",omitempty"`
+	DBs                map[int]*counterResult     `json:",omitempty"`
+	DBSizes            map[int]*decoder.DBSize    `json:",omitempty"`
+	KeyTemplates       []*KeyTemplate             `json:",omitempty"`
+	PrefixErrorBound   uint64                     `json:",omitempty"`
+	Light              bool                       `json:",omitempty"`
+	// Options are those the counter counted with, only in the top level
+	// result
+	Options *AnalysisOptions `json:",omitempty"`
+}
+
+// result get the stored form of a counted Counter
+func (c *Counter) result() *counterResult {
+	r := &counterResult{
+		LargestEntries:     append([]*decoder.Entry{}, *c.largestEntries...),
+		LargestKeyPrefixes: append([]*PrefixEntry{}, *c.largestKeyPrefixes...),
+		LengthLevel:        typeKeyEntries(c.lengthLevelBytes, c.lengthLevelNum),
+		Encoding:           typeKeyEntries(c.encodingBytes, c.encodingNum),
+		NearThresholds:     c.GetNearThresholds(),
+		LargestElements:    c.largestElements,
+		SkewedEntries:      append([]*decoder.Entry{}, *c.skewedEntries...),
+		SkewedNum:          c.skewedNum,
+		SkewedBytes:        c.skewedBytes,
+		ColdBytes:          c.coldBytes,
+		ColdNum:            c.coldNum,
+		ContentClasses:     c.GetContentClasses(),
+		ContentPrefixes:    c.GetContentPrefixes(c.opts.TopN),
+		TTL:                typeKeyEntries(c.ttlBytes, c.ttlNum),
+		Idle:               typeKeyEntries(c.idleBytes, c.idleNum),
+		Freq:               typeKeyEntries(c.freqBytes, c.freqNum),
+		TypeBytes:          c.typeBytes,
+		TypeNum:            c.typeNum,
+		TypeSizes:          c.typeSizes,
+		TypeElems:          c.typeElems,
+		SlotBytes:          c.slotBytes,
+		SlotNum:            c.slotNum,
+		DBSizes:            c.dbSizes,
+		KeyTemplates:       c.keyTemplates,
+		PrefixErrorBound:   c.prefixErrorBound,
+		Light:              c.light,
+	}
+	if c.dbs != nil {
+		r.Options = c.opts
+		r.DBs = map[int]*counterResult{}
+		for n, dc := range c.dbs {
+			r.DBs[n] = dc.result()
+		}
+	}
+	return r
+}
+
+// counter restores a Counter from its stored form
+func (r *counterResult) counter() *Counter {
+	return r.counterWith(r.Options)
+}
+
+// counterWith restores a Counter from its stored form, results stored
+// before options were recorded get the default options
+func (r *counterResult) counterWith(opts *AnalysisOptions) *Counter {
+	c := NewCounter(opts)
+	for _, e := range r.LargestEntries {
+		heap.Push(c.largestEntries, e)
+	}
+	for _, e := range r.LargestKeyPrefixes {
+		heap.Push(c.largestKeyPrefixes, e)
+	}
+	setTypeKeyEntries(r.LengthLevel, c.lengthLevelBytes, c.lengthLevelNum)
+	setTypeKeyEntries(r.Encoding, c.encodingBytes, c.encodingNum)
+	for _, nt := range r.NearThresholds {
+		c.nearThresholds[nearKey{Type: nt.Type, Encoding: nt.Encoding, Limit: nt.Limit, Above: nt.Above}] = nt
+	}
+	c.largestElements = r.LargestElements
+	for _, e := range r.SkewedEntries {
+		heap.Push(c.skewedEntries, e)
+	}
+	c.skewedNum, c.skewedBytes = r.SkewedNum, r.SkewedBytes
+	c.coldNum, c.coldBytes = r.ColdNum, r.ColdBytes
+	for _, ce := range r.ContentClasses {
+		c.contentClasses[typeKey{Type: ce.Type, Key: ce.Class}] = ce
+	}
+	for _, p := range r.ContentPrefixes {
+		c.contentPrefixes[typeKey{Type: p.Type, Key: p.Key}] = p
+	}
+	setTypeKeyEntries(r.TTL, c.ttlBytes, c.ttlNum)
+	setTypeKeyEntries(r.Idle, c.idleBytes, c.idleNum)
+	setTypeKeyEntries(r.Freq, c.freqBytes, c.freqNum)
+	for k, v := range r.TypeBytes {
+		c.typeBytes[k] = v
+	}
+	for k, v := range r.TypeNum {
+		c.typeNum[k] = v
+	}
+	for k, v := range r.TypeSizes {
+		c.typeSizes[k] = v
+	}
+	for k, v := range r.TypeElems {
+		c.typeElems[k] = v
+	}
+	for k, v := range r.SlotBytes {
+		c.slotBytes[k] = v
+	}
+	for k, v := range r.SlotNum {
+		c.slotNum[k] = v
+	}
+	if r.DBSizes != nil {
+		c.dbSizes = r.DBSizes
+	}
+	c.keyTemplates = r.KeyTemplates
+	c.prefixErrorBound = r.PrefixErrorBound
+	c.light = r.Light
+	c.miner = nil
+	if r.DBs == nil {
+		c.dbs = nil
+	}
+	for n, dr := range r.DBs {
+		dc := dr.counterWith(c.opts)
+		dc.dbs = nil
+		c.dbs[n] = dc
+	}
+	return c
+}
+
+func typeKeyEntries(bytes, num map[typeKey]uint64) []*PrefixEntry {
+	res := []*PrefixEntry{}
+	for key, n := range num {
+		res = append(res, &PrefixEntry{typeKey: key, Bytes: bytes[key], Num: n})
+	}
+	return res
+}
+
+func setTypeKeyEntries(entries []*PrefixEntry, bytes, num map[typeKey]uint64) {
+	for _, e := range entries {
+		bytes[e.typeKey] = e.Bytes
+		num[e.typeKey] = e.Num
+	}
+}
+
+// resultPath get path of the stored result of an instance
+func resultPath(name string) string {
+	return filepath.Join(resultsDir, filepath.Base(name)+".json.gz")
+}
+
+// saveResult stores the counter of an instance in resultsDir
+func saveResult(name string, c *Counter) error {
+	if resultsDir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(resultsDir, 0755); err != nil {
+		return err
+	}
+	r := c.result()
+	r.Version = resultVersion
+
+	// write to a temporary file first so a crash never leaves a broken result
+	path := resultPath(name)
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	gw := gzip.NewWriter(f)
+	err = json.NewEncoder(gw).Encode(r)
+	if e := gw.Close(); err == nil {
+		err = e
+	}
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		os.Remove(path + ".tmp")
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// loadResult loads the stored counter of an instance
+func loadResult(name string) (*Counter, error) {
+	f, err := os.Open(resultPath(name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+
+	r := &counterResult{}
+	if err := json.NewDecoder(gr).Decode(r); err != nil {
+		return nil, err
+	}
+	if r.Version != resultVersion {
+		return nil, fmt.Errorf("result of %s is of version %d, expect %d", name, r.Version, resultVersion)
+	}
+	return r.counter(), nil
+}
+
+// getCounter get the counter of an instance. A counter not in memory is
+// loaded from resultsDir, or if there is no stored result, the file of its
+// history entry is parsed again. parsing reports whether the instance is
+// being parsed when there is no counter. Without resultsDir, as for show,
+// every instance not counted yet is being parsed.
+func getCounter(name string) (c *Counter, parsing bool) {
+	if v := counters.Get(name); v != nil {
+		return v.(*Counter), false
+	}
+	if resultsDir == "" {
+		return nil, true
+	}
+
+	loadMutex.Lock()
+	defer loadMutex.Unlock()
+	if v := counters.Get(name); v != nil {
+		return v.(*Counter), false
+	}
+	if getJob(name) != nil {
+		return nil, true
+	}
+
+	c, err := loadResult(name)
+	if err == nil {
+		counters.Set(name, c)
+		return c, false
+	}
+	if !os.IsNotExist(err) {
+		log.Printf("Error loading result of %v: %v", name, err)
+	}
+
+	entry, ok := GetHistoryManager().Get(name)
+	if !ok {
+		return nil, false
+	}
+	if _, err := os.Stat(entry.FilePath); err != nil {
+		return nil, false
+	}
+	log.Printf("No stored result of %v, parsing %v again", name, entry.FilePath)
+	pp := NewParseProgress(name)
+	pp.AddLog(fmt.Sprintf("No stored result, parsing %s again", entry.FilePath))
+	pp.SetStatus("parsing")
+	startParseJob(startJob(name, "", pp), entry.FilePath, entry.FileSize)
+	return nil, true
+}
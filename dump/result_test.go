@@ -0,0 +1,93 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func TestSaveAndLoadResult(t *testing.T) {
+	dir, err := ioutil.TempDir("", "results")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	resultsDir = dir
+	defer func() { resultsDir = "" }()
+
+	c := NewCounter(nil)
+	in := make(chan *decoder.Entry, 4)
+	in <- &decoder.Entry{Key: "user:1", Type: "string", Bytes: 10, Idle: -1, Freq: -1}
+	in <- &decoder.Entry{Key: "user:2", Type: "string", Bytes: 20, TTL: 1000, Idle: 100, Freq: 5, DB: 5}
+	in <- &decoder.Entry{Key: "order:1", Type: "hash", Bytes: 3000, NumOfElem: 200, Idle: -1, Freq: -1, DB: 5}
+	in <- &decoder.Entry{Key: "order:2", Type: "hash", Bytes: 30, NumOfElem: 2, Idle: -1, Freq: -1}
+	close(in)
+	c.Count(in)
+	c.SetDBSizes(map[int]*decoder.DBSize{5: {DBSize: 2, ExpiresSize: 1}})
+
+	assert.NoError(t, saveResult("dump.rdb", c))
+	loaded, err := loadResult("dump.rdb")
+	assert.NoError(t, err)
+
+	// the restored counter renders the same
+	for _, db := range []int{-1, 0, 5} {
+		expect, actual := c.selectDB(db), loaded.selectDB(db)
+		assert.ElementsMatch(t, expect.GetLargestEntries(100), actual.GetLargestEntries(100))
+		assert.ElementsMatch(t, expect.GetLargestKeyPrefixes(), actual.GetLargestKeyPrefixes())
+		assert.ElementsMatch(t, expect.GetLenLevelCount(), actual.GetLenLevelCount())
+		assert.ElementsMatch(t, expect.GetTTLCount(), actual.GetTTLCount())
+		assert.ElementsMatch(t, expect.GetIdleCount(), actual.GetIdleCount())
+		assert.ElementsMatch(t, expect.GetFreqCount(), actual.GetFreqCount())
+		assert.Equal(t, expect.typeBytes, actual.typeBytes)
+		assert.Equal(t, expect.typeNum, actual.typeNum)
+		assert.Equal(t, expect.slotBytes, actual.slotBytes)
+		assert.Equal(t, expect.slotNum, actual.slotNum)
+	}
+	assert.Equal(t, c.GetDBCount(), loaded.GetDBCount())
+
+	counters.Set("dump.rdb", loaded)
+	defer counters.Delete("dump.rdb")
+	got, parsing := getCounter("dump.rdb")
+	assert.Equal(t, loaded, got)
+	assert.False(t, parsing)
+
+	_, err = loadResult("missing.rdb")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestGetCounterLoadsResult(t *testing.T) {
+	dir, err := ioutil.TempDir("", "results")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	resultsDir = dir
+	defer func() { resultsDir = "" }()
+
+	c := NewCounter(nil)
+	in := make(chan *decoder.Entry, 1)
+	in <- &decoder.Entry{Key: "a", Type: "string", Bytes: 10}
+	close(in)
+	c.Count(in)
+	assert.NoError(t, saveResult("stored.rdb", c))
+
+	got, parsing := getCounter("stored.rdb")
+	defer counters.Delete("stored.rdb")
+	assert.False(t, parsing)
+	assert.NotNil(t, got)
+	assert.True(t, counters.Check("stored.rdb"))
+	assert.Equal(t, c.typeBytes, got.typeBytes)
+}
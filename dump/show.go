@@ -26,7 +26,7 @@ func listPathFiles(pathname string) []string {
 	if err != nil {
 		return filenames
 	}
-	if fi.IsDir() {
+	if fi.IsDir() && !isAOF(pathname) {
 		files, err := ioutil.ReadDir(pathname)
 		if err != nil {
 			log.Fatal(err)
@@ -49,6 +49,12 @@ func Show(c *cli.Context) {
 		return
 	}
 
+	opts, err := analysisOptions(c)
+	if err != nil {
+		fmt.Fprintln(c.App.ErrWriter, err)
+		return
+	}
+
 	// Initialize history manager
 	InitHistoryManager("history.json")
 
@@ -63,11 +69,13 @@ func Show(c *cli.Context) {
 					filename := filepath.Base(v)
 
 					if !counters.Check(filename) {
-						decoder := decoder.NewDecoder()
+						decoder := newDecoder(opts)
 						fmt.Fprintf(c.App.Writer, "start to parse %v \n", filename)
 						go Decode(c, decoder, v)
-						counter := NewCounter()
+						counter := NewCounter(opts)
 						counter.Count(decoder.Entries)
+						counter.SetDBSizes(decoder.GetDBSizes())
+						counter.SetLargestElements(decoder.GetLargestElements())
 						counters.Set(filename, counter)
 						fmt.Fprintf(c.App.Writer, "parse %v  done\n", filename)
 
@@ -123,8 +131,23 @@ func ShowWeb(c *cli.Context) {
 		instances = append(instances, entry.Filename)
 	}
 
+	redisVersion = c.String("redis-version")
+	if _, err := decoder.MemModelFor(redisVersion); redisVersion != "" && err != nil {
+		fmt.Fprintln(c.App.ErrWriter, err)
+		return
+	}
+	opts, err := analysisOptions(c)
+	if err != nil {
+		fmt.Fprintln(c.App.ErrWriter, err)
+		return
+	}
+	jobOptions = opts
+
 	InitHTMLTmpl()
 	tplCommonData["Instances"] = instances
+	cleanupCancelled = c.Bool("cleanup")
+	salvageMode = c.Bool("salvage")
+	resultsDir = c.String("results")
 
 	// start http server
 	startHTTPServer(c, instances)
@@ -142,6 +165,9 @@ func startHTTPServer(c *cli.Context, instances []string) {
 	router.GET("/instance/:path", rdbReveal)
 	router.GET("/terminal/:path", showTerminal)
 	router.POST("/api/upload", uploadHandler)
+	router.POST("/api/sync", syncHandler)
+	router.DELETE("/api/jobs/:id", cancelJobHandler)
+	router.POST("/api/recount/:path", recountHandler)
 	router.GET("/list", listInstances)
 	router.GET("/api/progress/:path", progressHandler)
 	router.GET("/api/stream/:path", streamLogsHandler)
@@ -151,6 +177,7 @@ func startHTTPServer(c *cli.Context, instances []string) {
 	router.GET("/api/ops/analysis/:path", opsAnalysisHandler)
 	router.GET("/api/ops/anomalies/:path", opsAnomaliesHandler)
 	router.GET("/api/ops/recommendations/:path", opsRecommendationsHandler)
+	router.GET("/api/ops/templates/:path", opsTemplatesHandler)
 	router.GET("/api/ops/health/:path", opsHealthHandler)
 
 	// Create HTTP server with custom timeouts for large file uploads
@@ -0,0 +1,325 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/urfave/cli"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+// SimulateFlags are the encoding limits simulate re-encodes collections
+// with, limits not given are those of encoding_limits of the config, which
+// default to those of redis.conf
+var SimulateFlags = []cli.Flag{
+	cli.Uint64Flag{
+		Name:  "hash-max-listpack-entries",
+		Value: 128,
+		Usage: "Simulate hash-max-listpack-entries, or hash-max-ziplist-entries before 7.0",
+	},
+	cli.Uint64Flag{
+		Name:  "hash-max-listpack-value",
+		Value: 64,
+		Usage: "Simulate hash-max-listpack-value, or hash-max-ziplist-value before 7.0",
+	},
+	cli.Uint64Flag{
+		Name:  "zset-max-listpack-entries",
+		Value: 128,
+		Usage: "Simulate zset-max-listpack-entries, or zset-max-ziplist-entries before 7.0",
+	},
+	cli.Uint64Flag{
+		Name:  "zset-max-listpack-value",
+		Value: 64,
+		Usage: "Simulate zset-max-listpack-value, or zset-max-ziplist-value before 7.0",
+	},
+	cli.Uint64Flag{
+		Name:  "set-max-intset-entries",
+		Value: 512,
+		Usage: "Simulate set-max-intset-entries",
+	},
+	cli.Uint64Flag{
+		Name:  "set-max-listpack-entries",
+		Value: 128,
+		Usage: "Simulate set-max-listpack-entries of 7.2",
+	},
+	cli.Uint64Flag{
+		Name:  "set-max-listpack-value",
+		Value: 64,
+		Usage: "Simulate set-max-listpack-value of 7.2",
+	},
+}
+
+// simulatedLimits get l with the limits given by the flags of c
+func simulatedLimits(c *cli.Context, l EncodingLimits) EncodingLimits {
+	for _, f := range []struct {
+		name  string
+		limit *uint64
+	}{
+		{"hash-max-listpack-entries", &l.HashEntries},
+		{"hash-max-listpack-value", &l.HashValue},
+		{"zset-max-listpack-entries", &l.ZsetEntries},
+		{"zset-max-listpack-value", &l.ZsetValue},
+		{"set-max-intset-entries", &l.SetIntsetEntries},
+		{"set-max-listpack-entries", &l.SetEntries},
+		{"set-max-listpack-value", &l.SetValue},
+	} {
+		if c.IsSet(f.name) {
+			*f.limit = c.Uint64(f.name)
+		}
+	}
+	return l
+}
+
+// Simulation is the memory of keys once collections are encoded as the rdb
+// would be loaded by an instance configured with other encoding limits
+type Simulation struct {
+	CurrentInstance string
+	MemoryModel     string
+	Limits          EncodingLimits
+	Keys            uint64
+	// ChecksumMismatch is set if the checksum of the rdb does not match, the
+	// keys are all simulated still
+	ChecksumMismatch bool `json:",omitempty"`
+	// ChangedKeys are the keys loaded in another encoding than in the rdb
+	ChangedKeys uint64
+	deltaCount
+	// Changes count changed keys by type and encodings, Prefixes by key
+	// prefix, ordered by the most memory changed
+	Changes  []*EncodingChange
+	Prefixes []*PrefixDelta
+}
+
+// deltaCount counts memory of keys before and after the simulation
+type deltaCount struct {
+	Num            uint64 `json:",omitempty"`
+	Bytes          uint64
+	SimulatedBytes uint64
+	Delta          int64
+}
+
+func (d *deltaCount) add(bytes, simulated uint64) {
+	d.Bytes += bytes
+	d.SimulatedBytes += simulated
+	d.Delta = int64(d.SimulatedBytes) - int64(d.Bytes)
+}
+
+// EncodingChange counts the keys of a type changing from one encoding to
+// another
+type EncodingChange struct {
+	Type string
+	From string
+	To   string
+	deltaCount
+}
+
+// PrefixDelta counts the keys of a key prefix changing encoding
+type PrefixDelta struct {
+	typeKey
+	deltaCount
+}
+
+type encodingChangeKey struct {
+	Type string
+	From string
+	To   string
+}
+
+// simulatedEncoding get the encoding e is loaded in with limits l. Redis
+// encodes a collection when it is loaded by its length and the longest of
+// its elements whatever encoding it is saved in.
+func simulatedEncoding(e *decoder.Entry, l *EncodingLimits) string {
+	if e.Sizes == nil {
+		return e.Encoding
+	}
+	_, intset := e.Sizes.Bytes["intset"]
+	_, listpack := e.Sizes.Bytes["listpack"]
+	packed := "ziplist"
+	if listpack {
+		packed = "listpack"
+	}
+	fits := func(entries, value uint64) bool {
+		return e.NumOfElem <= entries && e.Sizes.Longest <= value
+	}
+	switch e.Type {
+	case "hash":
+		if fits(l.HashEntries, l.HashValue) {
+			return packed
+		}
+		return "hashtable"
+	case "sortedset":
+		if fits(l.ZsetEntries, l.ZsetValue) {
+			return packed
+		}
+		return "skiplist"
+	case "set":
+		if intset && e.NumOfElem <= l.SetIntsetEntries {
+			return "intset"
+		}
+		if listpack && fits(l.SetEntries, l.SetValue) {
+			return "listpack"
+		}
+		return "hashtable"
+	}
+	return e.Encoding
+}
+
+// simulator re-encodes entries with limits, counting memory by key prefix
+// as counter does
+type simulator struct {
+	limits   EncodingLimits
+	counter  *Counter
+	sim      *Simulation
+	changes  map[encodingChangeKey]*EncodingChange
+	prefixes map[typeKey]*PrefixDelta
+}
+
+func newSimulator(opts *AnalysisOptions, limits EncodingLimits) *simulator {
+	return &simulator{
+		limits:   limits,
+		counter:  NewCounter(opts),
+		sim:      &Simulation{Limits: limits},
+		changes:  map[encodingChangeKey]*EncodingChange{},
+		prefixes: map[typeKey]*PrefixDelta{},
+	}
+}
+
+func (s *simulator) add(e *decoder.Entry) {
+	s.sim.Keys++
+	enc := simulatedEncoding(e, &s.limits)
+	if enc == e.Encoding {
+		s.sim.add(e.Bytes, e.Bytes)
+		return
+	}
+	bytes, ok := e.Sizes.Bytes[enc]
+	if !ok {
+		// the key keeps its bytes if its size in enc is not estimated
+		s.sim.add(e.Bytes, e.Bytes)
+		return
+	}
+	s.sim.ChangedKeys++
+	s.sim.add(e.Bytes, bytes)
+
+	ck := encodingChangeKey{Type: e.Type, From: e.Encoding, To: enc}
+	change, ok := s.changes[ck]
+	if !ok {
+		change = &EncodingChange{Type: e.Type, From: e.Encoding, To: enc}
+		s.changes[ck] = change
+	}
+	change.Num++
+	change.add(e.Bytes, bytes)
+
+	key := typeKey{Type: e.Type}
+	for _, prefix := range s.counter.keyPrefixes(e.Key) {
+		if len(prefix) == 0 {
+			continue
+		}
+		key.Key = prefix
+		p, ok := s.prefixes[key]
+		if !ok {
+			p = &PrefixDelta{typeKey: key}
+			s.prefixes[key] = p
+		}
+		p.Num++
+		p.add(e.Bytes, bytes)
+	}
+}
+
+func absDelta(d int64) int64 {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// result get the simulation, with the top prefixes changing most
+func (s *simulator) result(top int) *Simulation {
+	sim := s.sim
+	sim.Changes = []*EncodingChange{}
+	for _, c := range s.changes {
+		sim.Changes = append(sim.Changes, c)
+	}
+	sort.Slice(sim.Changes, func(i, j int) bool {
+		a, b := sim.Changes[i], sim.Changes[j]
+		if absDelta(a.Delta) != absDelta(b.Delta) {
+			return absDelta(a.Delta) > absDelta(b.Delta)
+		}
+		return a.Num > b.Num
+	})
+	sim.Prefixes = []*PrefixDelta{}
+	for _, p := range s.prefixes {
+		sim.Prefixes = append(sim.Prefixes, p)
+	}
+	sort.Slice(sim.Prefixes, func(i, j int) bool {
+		a, b := sim.Prefixes[i], sim.Prefixes[j]
+		if absDelta(a.Delta) != absDelta(b.Delta) {
+			return absDelta(a.Delta) > absDelta(b.Delta)
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Key < b.Key
+	})
+	if top < len(sim.Prefixes) {
+		sim.Prefixes = sim.Prefixes[:top]
+	}
+	return sim
+}
+
+// Simulate re-estimates the memory of every hash, sorted set and set of the
+// rdb files with the encoding limits of the flags, and writes the memory
+// change and the keys changing encoding to STDOUT
+func Simulate(c *cli.Context) error {
+	if c.NArg() < 1 {
+		fmt.Fprintln(c.App.ErrWriter, "simulate requires at least 1 argument")
+		cli.ShowCommandHelp(c, "simulate")
+		return cli.NewExitError("", 2)
+	}
+	if v := c.String("redis-version"); v != "" {
+		if _, err := decoder.MemModelFor(v); err != nil {
+			return cli.NewExitError(err.Error(), 2)
+		}
+	}
+	opts, err := analysisOptions(c)
+	if err != nil {
+		return cli.NewExitError(err.Error(), 2)
+	}
+	limits := simulatedLimits(c, opts.EncodingLimits)
+
+	res := []*Simulation{}
+	for _, file := range c.Args() {
+		decoder := decoder.NewDecoder()
+		decoder.EstimateEncodings()
+		go Decode(c, decoder, file)
+		s := newSimulator(opts, limits)
+		db := c.Int("db")
+		for e := range decoder.Entries {
+			if db < 0 || e.DB == db {
+				s.add(e)
+			}
+		}
+		sim := s.result(opts.TopN)
+		sim.CurrentInstance = filepath.Base(file)
+		sim.MemoryModel = decoder.GetMemModel()
+		sim.ChecksumMismatch = decoder.GetChecksumError() != nil
+		res = append(res, sim)
+	}
+	jsonBytes, _ := json.MarshalIndent(res, "", "    ")
+	fmt.Fprintln(c.App.Writer, string(jsonBytes))
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+	"github.com/xueqiu/rdr/decoder"
+)
+
+func sizes(longest uint64, bytes map[string]uint64) *decoder.EncodingSizes {
+	return &decoder.EncodingSizes{Bytes: bytes, Longest: longest}
+}
+
+func TestSimulatedEncoding(t *testing.T) {
+	l := defaultEncodingLimits()
+	hash := map[string]uint64{"listpack": 1, "hashtable": 2}
+	cases := []struct {
+		e        decoder.Entry
+		encoding string
+	}{
+		{decoder.Entry{Type: "hash", Encoding: "hashtable", NumOfElem: 100, Sizes: sizes(10, hash)}, "listpack"},
+		{decoder.Entry{Type: "hash", Encoding: "listpack", NumOfElem: 200, Sizes: sizes(10, hash)}, "hashtable"},
+		{decoder.Entry{Type: "hash", Encoding: "listpack", NumOfElem: 10, Sizes: sizes(65, hash)}, "hashtable"},
+		{decoder.Entry{Type: "hash", Encoding: "hashtable", NumOfElem: 10, Sizes: sizes(10, map[string]uint64{"ziplist": 1, "hashtable": 2})}, "ziplist"},
+		{decoder.Entry{Type: "sortedset", Encoding: "listpack", NumOfElem: 200, Sizes: sizes(10, hash)}, "skiplist"},
+		{decoder.Entry{Type: "set", Encoding: "hashtable", NumOfElem: 500, Sizes: sizes(3, map[string]uint64{"intset": 1, "listpack": 1, "hashtable": 2})}, "intset"},
+		{decoder.Entry{Type: "set", Encoding: "intset", NumOfElem: 600, Sizes: sizes(3, map[string]uint64{"intset": 1, "listpack": 1, "hashtable": 2})}, "hashtable"},
+		{decoder.Entry{Type: "set", Encoding: "hashtable", NumOfElem: 100, Sizes: sizes(30, map[string]uint64{"listpack": 1, "hashtable": 2})}, "listpack"},
+		{decoder.Entry{Type: "set", Encoding: "hashtable", NumOfElem: 100, Sizes: sizes(30, map[string]uint64{"hashtable": 2})}, "hashtable"},
+		{decoder.Entry{Type: "list", Encoding: "quicklist", NumOfElem: 100}, "quicklist"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.encoding, simulatedEncoding(&c.e, &l), "%+v", c.e)
+	}
+}
+
+func TestSimulator(t *testing.T) {
+	l := defaultEncodingLimits()
+	l.HashEntries = 256
+	s := newSimulator(nil, l)
+	for _, e := range []*decoder.Entry{
+		{Key: "user:1", Type: "hash", Encoding: "hashtable", NumOfElem: 200, Bytes: 1000, Sizes: sizes(10, map[string]uint64{"listpack": 400, "hashtable": 1000})},
+		{Key: "user:2", Type: "hash", Encoding: "hashtable", NumOfElem: 200, Bytes: 2000, Sizes: sizes(10, map[string]uint64{"listpack": 800, "hashtable": 2000})},
+		{Key: "user:3", Type: "hash", Encoding: "hashtable", NumOfElem: 300, Bytes: 3000, Sizes: sizes(10, map[string]uint64{"listpack": 1000, "hashtable": 3000})},
+		{Key: "rank", Type: "sortedset", Encoding: "listpack", NumOfElem: 10, Bytes: 100, Sizes: sizes(100, map[string]uint64{"listpack": 100, "skiplist": 500})},
+		{Key: "name", Type: "string", Encoding: "embstr", Bytes: 50},
+	} {
+		s.add(e)
+	}
+	sim := s.result(10)
+	assert.Equal(t, uint64(5), sim.Keys)
+	assert.Equal(t, uint64(3), sim.ChangedKeys)
+	assert.Equal(t, uint64(6150), sim.Bytes)
+	assert.Equal(t, uint64(4750), sim.SimulatedBytes)
+	assert.Equal(t, int64(-1400), sim.Delta)
+
+	assert.Len(t, sim.Changes, 2)
+	assert.Equal(t, EncodingChange{Type: "hash", From: "hashtable", To: "listpack",
+		deltaCount: deltaCount{Num: 2, Bytes: 3000, SimulatedBytes: 1200, Delta: -1800}}, *sim.Changes[0])
+	assert.Equal(t, "skiplist", sim.Changes[1].To)
+	assert.Equal(t, int64(400), sim.Changes[1].Delta)
+
+	assert.Equal(t, "user", sim.Prefixes[0].Key)
+	assert.Equal(t, uint64(2), sim.Prefixes[0].Num)
+	assert.Equal(t, int64(-1800), sim.Prefixes[0].Delta)
+	assert.Len(t, s.result(1).Prefixes, 1)
+
+	// a key keeps its bytes in an encoding whose size is not estimated
+	s = newSimulator(nil, l)
+	s.add(&decoder.Entry{Key: "h", Type: "hash", Encoding: "hashtable", NumOfElem: 10, Bytes: 1000, Sizes: sizes(10, map[string]uint64{"hashtable": 1000})})
+	sim = s.result(10)
+	assert.Equal(t, uint64(0), sim.ChangedKeys)
+	assert.Equal(t, uint64(1000), sim.SimulatedBytes)
+	assert.Equal(t, int64(0), sim.Delta)
+}
+
+func TestSimulateCommand(t *testing.T) {
+	out := &bytes.Buffer{}
+	app := cli.NewApp()
+	app.Writer = out
+	app.Commands = []cli.Command{{
+		Name: "simulate",
+		Flags: append(append([]cli.Flag{
+			cli.IntFlag{Name: "db", Value: -1},
+			cli.StringFlag{Name: "redis-version"},
+		}, SimulateFlags...), AnalysisFlags...),
+		Action: Simulate,
+	}}
+	fixture := "../third_party/rdb/fixtures/dictionary.rdb"
+	run := func(args ...string) *Simulation {
+		out.Reset()
+		assert.NoError(t, app.Run(append(append([]string{"rdr", "simulate", "--redis-version", "7.2"}, args...), fixture)))
+		res := []*Simulation{}
+		assert.NoError(t, json.Unmarshal(out.Bytes(), &res))
+		if !assert.Len(t, res, 1) {
+			return &Simulation{}
+		}
+		return res[0]
+	}
+
+	sim := run()
+	assert.Equal(t, "dictionary.rdb", sim.CurrentInstance)
+	assert.Equal(t, uint64(1), sim.Keys)
+	assert.Equal(t, uint64(0), sim.ChangedKeys)
+	assert.Equal(t, int64(0), sim.Delta)
+
+	sim = run("--hash-max-listpack-entries", "4000")
+	assert.Equal(t, uint64(4000), sim.Limits.HashEntries)
+	assert.Equal(t, uint64(1), sim.ChangedKeys)
+	assert.True(t, sim.Delta < 0)
+	assert.Equal(t, "listpack", sim.Changes[0].To)
+	assert.Equal(t, sim.Delta, sim.Prefixes[0].Delta)
+}
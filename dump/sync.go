@@ -0,0 +1,161 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dongmx/rdb"
+	"github.com/julienschmidt/httprouter"
+	"github.com/urfave/cli"
+	"github.com/xueqiu/rdr/replica"
+)
+
+// syncSource is the prefix of the history path of a snapshot pulled from a
+// running redis
+const syncSource = "redis://"
+
+// Sync pulls a snapshot from a running redis by the replication handshake
+// and dumps its statistical information to STDOUT.
+func Sync(cli *cli.Context) {
+	addr := cli.String("addr")
+	if addr == "" {
+		fmt.Fprintln(cli.App.ErrWriter, "sync requires --addr")
+		return
+	}
+	opts, err := analysisOptions(cli)
+	if err != nil {
+		fmt.Fprintln(cli.App.ErrWriter, err)
+		return
+	}
+
+	decoder := newDecoder(opts)
+	if v := cli.String("redis-version"); v != "" {
+		if err := decoder.SetRedisVersion(v); err != nil {
+			fmt.Fprintln(cli.App.ErrWriter, err)
+			return
+		}
+	}
+	go func() {
+		m := newMeter(decoder.GetKeys)
+		done := make(chan struct{})
+		defer close(done)
+		go reportProgress(cli.App.ErrWriter, addr, m, done)
+
+		if err := syncDecode(addr, cli.String("password"), decoder, m); err != nil {
+			if decoder.ChecksumFailed(err) {
+				fmt.Fprintf(cli.App.ErrWriter, "%s: warning: %v\n", addr, err)
+			} else {
+				fmt.Fprintf(cli.App.ErrWriter, "sync err: %v\n", err)
+			}
+			close(decoder.Entries)
+		}
+	}()
+	data := countData(cli, opts, addr, decoder)
+	jsonBytes, _ := json.MarshalIndent(data, "", "    ")
+	fmt.Fprintln(cli.App.Writer, "[")
+	fmt.Fprintln(cli.App.Writer, string(jsonBytes))
+	fmt.Fprintln(cli.App.Writer, "]")
+}
+
+// syncDecode streams the snapshot of the redis at addr into d, bytes
+// received are counted into m
+func syncDecode(addr, password string, d rdb.Decoder, m *meter) error {
+	s, err := replica.Sync(addr, password)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	if s.Size > 0 {
+		m.setTotal(s.Size)
+	}
+	return rdb.Decode(m.reader(s), d)
+}
+
+// syncInstanceName names a snapshot of addr taken now
+func syncInstanceName(addr string) string {
+	return fmt.Sprintf("%s-%s", strings.Replace(addr, ":", "_", -1), time.Now().Format("20060102150405"))
+}
+
+// syncHandler starts pulling a snapshot from the redis given by the addr
+// and password form values
+func syncHandler(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	addr := strings.TrimSpace(r.FormValue("addr"))
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		respondWithError(w, "无效的Redis地址: "+addr, http.StatusBadRequest)
+		return
+	}
+	password := r.FormValue("password")
+
+	name := syncInstanceName(addr)
+	progress := NewParseProgress(name)
+	progress.AddLog(fmt.Sprintf("Syncing snapshot from %s", addr))
+	progress.SetStatus("parsing")
+
+	job := startJob(name, "", progress)
+	go func(pp *ParseProgress) {
+		defer job.finish()
+		pp.AddLog("Starting replication handshake...")
+		pp.SetProgress(5)
+
+		s, err := replica.SyncContext(job.ctx, addr, password)
+		if err != nil {
+			job.fail("Sync", err)
+			return
+		}
+		defer s.Close()
+		if s.Size >= 0 {
+			pp.AddLog(fmt.Sprintf("Receiving snapshot (%.2f MB)", float64(s.Size)/(1024*1024)))
+		} else {
+			pp.AddLog("Receiving diskless snapshot...")
+		}
+		pp.SetProgress(20)
+
+		dec := newJobDecoder()
+		m := newMeter(dec.GetKeys)
+		if s.Size > 0 {
+			m.setTotal(s.Size)
+		}
+		pp.SetMeter(m)
+		go func() {
+			if err := rdb.DecodeContext(job.ctx, m.reader(s), dec); err != nil {
+				job.decodeFailed(err)
+				close(dec.Entries)
+				return
+			}
+			pp.AddLog("RDB decode completed successfully")
+			pp.SetProgress(70)
+		}()
+
+		size := s.Size
+		if size < 0 {
+			size = 0
+		}
+		countAndSave(job, dec, syncSource+addr, size)
+	}(progress)
+
+	json.NewEncoder(w).Encode(UploadResponse{
+		Success:   true,
+		Message:   "开始同步",
+		Instances: []string{name},
+	})
+}
@@ -75,8 +75,8 @@ func uploadHandler(w http.ResponseWriter, r *http.Request, _ httprouter.Params)
 
 	// Process each uploaded file
 	for _, fileHeader := range files {
-		// Validate file extension
-		if filepath.Ext(fileHeader.Filename) != ".rdb" {
+		// Validate file extension, compressed rdb files are accepted as well
+		if !isRDBFile(fileHeader.Filename) {
 			log.Printf("Skipping non-rdb file: %v", fileHeader.Filename)
 			continue
 		}
@@ -122,89 +122,8 @@ func uploadHandler(w http.ResponseWriter, r *http.Request, _ httprouter.Params)
 			progress.AddLog(fmt.Sprintf("File uploaded: %s (%.2f MB)", filename, float64(written)/(1024*1024)))
 			progress.SetStatus("parsing")
 
-			// Start decoding in background
-			go func(path, name string, pp *ParseProgress, fileSize int64) {
-				dec := decoder.NewDecoder()
-				pp.AddLog("Initializing RDB decoder...")
-				pp.SetProgress(5)
-
-				// Start decoding in a goroutine
-				go func() {
-					// Note: rdb.Decode() will close dec.Entries internally, so we don't need to close it here
-					pp.AddLog("Opening RDB file...")
-					pp.SetProgress(10)
-
-					f, err := os.Open(path)
-					if err != nil {
-						log.Printf("Error opening file %v: %v", name, err)
-						pp.SetError(fmt.Sprintf("Failed to open file: %v", err))
-						pp.AddLog(fmt.Sprintf("ERROR: %v", err))
-						return
-					}
-					defer f.Close()
-
-					pp.AddLog("Starting RDB decode process...")
-					pp.SetProgress(20)
-
-					err = rdb.Decode(f, dec)
-					if err != nil {
-						log.Printf("Error decoding file %v: %v", name, err)
-						pp.SetError(fmt.Sprintf("Decode failed: %v", err))
-						pp.AddLog(fmt.Sprintf("ERROR: %v", err))
-						return
-					}
-					pp.AddLog("RDB decode completed successfully")
-					pp.SetProgress(70)
-				}()
-
-				// Count entries (this will block until channel is closed)
-				pp.AddLog("Counting and analyzing entries...")
-				pp.SetProgress(30)
-
-				counter := NewCounter()
-				counter.Count(dec.Entries)
-
-				pp.AddLog("Saving statistics...")
-				pp.SetProgress(90)
-
-				counters.Set(name, counter)
-				log.Printf("Parse completed and counter saved: %v", name)
-
-				// Save to history
-				hm := GetHistoryManager()
-
-				// Calculate totals
-				var totalKeys, totalBytes uint64
-				for _, v := range counter.typeNum {
-					totalKeys += v
-				}
-				for _, v := range counter.typeBytes {
-					totalBytes += v
-				}
-
-				historyEntry := HistoryEntry{
-					Filename:    name,
-					FilePath:    path,
-					UploadTime:  time.Now(),
-					FileSize:    fileSize,
-					TotalKeys:   totalKeys,
-					TotalMemory: totalBytes,
-				}
-				if err := hm.Add(historyEntry); err != nil {
-					log.Printf("Error saving to history: %v", err)
-				}
-
-				pp.AddLog("Analysis complete!")
-				pp.SetProgress(100)
-				pp.SetStatus("completed")
-
-				// Update template data
-				if instances, ok := tplCommonData["Instances"].([]string); ok {
-					tplCommonData["Instances"] = append(instances, name)
-				} else {
-					tplCommonData["Instances"] = []string{name}
-				}
-			}(destPath, filename, progress, written)
+			// Start decoding in background, as a job which can be cancelled
+			startParseJob(startJob(filename, destPath, progress), destPath, written)
 		} else {
 			log.Printf("File already parsed: %v", filename)
 			instances = append(instances, filename)
@@ -226,6 +145,159 @@ func uploadHandler(w http.ResponseWriter, r *http.Request, _ httprouter.Params)
 	json.NewEncoder(w).Encode(response)
 }
 
+// startParseJob parses the rdb or aof file at path in the background, the
+// counter is saved under the id of job when it is done
+func startParseJob(job *Job, path string, fileSize int64) {
+	go func() {
+		defer job.finish()
+		dec := decodeJobFile(job, path)
+		countAndSave(job, dec, path, fileSize)
+	}()
+}
+
+// decodeJobFile decodes the rdb or aof file at path for job in the
+// background, the entries of the decoder returned are closed when it is done
+func decodeJobFile(job *Job, path string) *decoder.Decoder {
+	pp := job.progress
+	dec := newJobDecoder()
+	m := newMeter(dec.GetKeys)
+	m.setTotal(inputSize(path))
+	pp.SetMeter(m)
+	pp.AddLog("Initializing RDB decoder...")
+	pp.SetProgress(5)
+
+	go func() {
+		// Note: rdb.Decode() closes dec.Entries when it succeeds, it is
+		// closed here on failure so that counting ends
+		if isAOF(path) {
+			pp.AddLog("Replaying AOF file...")
+			pp.SetProgress(20)
+			if err := decodeAOF(job.ctx, path, dec, m); err != nil {
+				job.fail("Replay", err)
+				close(dec.Entries)
+				return
+			}
+			pp.AddLog("AOF replay completed successfully")
+			pp.SetProgress(70)
+			return
+		}
+
+		pp.AddLog("Opening RDB file...")
+		pp.SetProgress(10)
+
+		f, err := openMeteredRDB(path, m)
+		if err != nil {
+			job.fail("Open", err)
+			close(dec.Entries)
+			return
+		}
+		defer f.Close()
+
+		pp.AddLog("Starting RDB decode process...")
+		pp.SetProgress(20)
+
+		if salvageMode {
+			err = dec.DecodeSalvage(job.ctx, f)
+		} else {
+			err = rdb.DecodeContext(job.ctx, f, dec)
+		}
+		if err != nil {
+			job.decodeFailed(err)
+			close(dec.Entries)
+			return
+		}
+		pp.AddLog("RDB decode completed successfully")
+		pp.SetProgress(70)
+	}()
+	return dec
+}
+
+// countAndSave counts entries decoded by dec until it is done, then saves the
+// counter of the job and records it in the history
+func countAndSave(job *Job, dec *decoder.Decoder, path string, fileSize int64) {
+	name, pp := job.ID, job.progress
+	// Count entries (this will block until channel is closed)
+	pp.AddLog("Counting and analyzing entries...")
+	pp.SetProgress(30)
+
+	counter := NewCounter(jobOptions)
+	counter.Count(dec.Entries)
+	counter.SetDBSizes(dec.GetDBSizes())
+	counter.SetLargestElements(dec.GetLargestElements())
+	pp.finishMeter()
+	if pp.Failed() {
+		return
+	}
+	report := dec.GetSalvageReport()
+	if report != nil {
+		pp.SetSalvageReport(report)
+		for _, s := range report.Skipped {
+			pp.AddLog(fmt.Sprintf("WARNING: skipped %d corrupted bytes at offset %d, key %q: %s", s.Bytes, s.Offset, s.Key, s.Err))
+		}
+		if report.Truncated {
+			pp.AddLog("WARNING: the rdb is truncated")
+		}
+		if report.Checksum != nil {
+			job.decodeFailed(report.Checksum)
+		}
+	}
+
+	// a job saving its result can no longer be cancelled, nor is a
+	// cancelled one saved
+	if job.ctx.Err() != nil || !pp.compareAndSetStatus("parsing", "saving") {
+		return
+	}
+	pp.AddLog("Saving statistics...")
+	pp.SetProgress(90)
+
+	counters.Set(name, counter)
+	if err := saveResult(name, counter); err != nil {
+		log.Printf("Error saving result of %v: %v", name, err)
+	}
+	log.Printf("Parse completed and counter saved: %v", name)
+
+	// Save to history
+	hm := GetHistoryManager()
+
+	// Calculate totals
+	var totalKeys, totalBytes uint64
+	for _, v := range counter.typeNum {
+		totalKeys += v
+	}
+	for _, v := range counter.typeBytes {
+		totalBytes += v
+	}
+
+	historyEntry := HistoryEntry{
+		Filename:    name,
+		FilePath:    path,
+		UploadTime:  time.Now(),
+		FileSize:    fileSize,
+		TotalKeys:   totalKeys,
+		TotalMemory: totalBytes,
+
+		ChecksumMismatch: job.checksumErr != nil,
+	}
+	if report != nil && report.Partial() {
+		historyEntry.Partial = true
+		historyEntry.SkippedBytes = report.SkippedBytes()
+	}
+	if err := hm.Add(historyEntry); err != nil {
+		log.Printf("Error saving to history: %v", err)
+	}
+
+	pp.AddLog("Analysis complete!")
+	pp.SetProgress(100)
+	pp.SetStatus("completed")
+
+	// Update template data
+	if instances, ok := tplCommonData["Instances"].([]string); ok {
+		tplCommonData["Instances"] = append(instances, name)
+	} else {
+		tplCommonData["Instances"] = []string{name}
+	}
+}
+
 // showUploadPage renders the upload page
 func showUploadPage(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	http.ServeFile(w, r, "views/upload.html")
@@ -0,0 +1,121 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dongmx/rdb"
+	"github.com/dongmx/rdb/nopdecoder"
+	"github.com/urfave/cli"
+)
+
+// verifier decodes a rdb without keeping anything, only the rdb version and
+// the number of keys are recorded
+type verifier struct {
+	nopdecoder.NopDecoder
+	rdbVer int
+	keys   uint64
+}
+
+func (v *verifier) StartRDB(ver int) {
+	v.rdbVer = ver
+}
+
+func (v *verifier) Set(key, value []byte, expiry int64, info *rdb.Info) {
+	v.keys++
+}
+
+func (v *verifier) StartHash(key []byte, length, expiry int64, info *rdb.Info) {
+	v.keys++
+}
+
+func (v *verifier) StartSet(key []byte, cardinality, expiry int64, info *rdb.Info) {
+	v.keys++
+}
+
+func (v *verifier) StartList(key []byte, length, expiry int64, info *rdb.Info) {
+	v.keys++
+}
+
+func (v *verifier) StartZSet(key []byte, cardinality, expiry int64, info *rdb.Info) {
+	v.keys++
+}
+
+func (v *verifier) StartStream(key []byte, cardinality, expiry int64, info *rdb.Info) {
+	v.keys++
+}
+
+func (v *verifier) Module(key []byte, module string, size uint64, expiry int64, info *rdb.Info) {
+	v.keys++
+}
+
+// verifyFile decodes the rdb or aof at path, checking the header, every
+// opcode and the CRC64 checksum. Offsets of errors are of the decompressed
+// stream for compressed files.
+func verifyFile(path string) (*verifier, error) {
+	v := &verifier{}
+	if isAOF(path) {
+		return v, decodeAOF(context.Background(), path, v, nil)
+	}
+	f, err := openRDB(path)
+	if err != nil {
+		return v, err
+	}
+	defer f.Close()
+	return v, rdb.Decode(f, v)
+}
+
+// Verify checks the integrity of every file given, the exit status is not 0
+// if any of them is corrupted
+func Verify(c *cli.Context) error {
+	if c.NArg() < 1 {
+		fmt.Fprintln(c.App.ErrWriter, "verify requires at least 1 argument")
+		cli.ShowCommandHelp(c, "verify")
+		return cli.NewExitError("", 2)
+	}
+
+	failed := 0
+	for _, path := range c.Args() {
+		v, err := verifyFile(path)
+		if err != nil {
+			failed++
+		}
+		printVerifyResult(c.App.Writer, path, v, err)
+	}
+	if failed > 0 {
+		return cli.NewExitError(fmt.Sprintf("%d of %d files corrupted", failed, c.NArg()), 1)
+	}
+	return nil
+}
+
+func printVerifyResult(w io.Writer, path string, v *verifier, err error) {
+	switch e := err.(type) {
+	case nil:
+		fmt.Fprintf(w, "%s: OK, rdb version %d, %d keys\n", path, v.rdbVer, v.keys)
+	case *rdb.ChecksumError:
+		fmt.Fprintf(w, "%s: CORRUPTED, checksum mismatch, stored %016x, computed %016x\n", path, e.Stored, e.Computed)
+	case *rdb.DecodeError:
+		if e.Key == "" {
+			fmt.Fprintf(w, "%s: CORRUPTED at offset %d: %v\n", path, e.Offset, e.Err)
+		} else {
+			fmt.Fprintf(w, "%s: CORRUPTED at offset %d, key %q: %v\n", path, e.Offset, e.Key, e.Err)
+		}
+	default:
+		fmt.Fprintf(w, "%s: FAILED, %v\n", path, err)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dongmx/rdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func TestVerifyFile(t *testing.T) {
+	b, err := ioutil.ReadFile("../third_party/rdb/fixtures/rdb_version_5_with_checksum.rdb")
+	assert.NoError(t, err)
+	dir, err := ioutil.TempDir("", "verify")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	good := filepath.Join(dir, "good.rdb")
+	assert.NoError(t, ioutil.WriteFile(good, b, 0644))
+	v, err := verifyFile(good)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, v.rdbVer)
+	assert.Equal(t, uint64(6), v.keys)
+
+	i := bytes.LastIndex(b, []byte("abc"))
+	truncated := filepath.Join(dir, "truncated.rdb")
+	assert.NoError(t, ioutil.WriteFile(truncated, b[:i+4], 0644))
+	_, err = verifyFile(truncated)
+	assert.IsType(t, &rdb.DecodeError{}, err)
+	out := &bytes.Buffer{}
+	printVerifyResult(out, truncated, nil, err)
+	assert.Contains(t, out.String(), `CORRUPTED at offset 116, key "abc"`)
+
+	b[i] ^= 1
+	flipped := filepath.Join(dir, "flipped.rdb")
+	assert.NoError(t, ioutil.WriteFile(flipped, b, 0644))
+	_, err = verifyFile(flipped)
+	assert.IsType(t, &rdb.ChecksumError{}, err)
+
+	// dump counts every key of a rdb whose checksum does not match, with a
+	// warning
+	set := flag.NewFlagSet("dump", flag.ContinueOnError)
+	set.Int("db", -1, "")
+	app := cli.NewApp()
+	stderr := &bytes.Buffer{}
+	app.ErrWriter = stderr
+	c := cli.NewContext(app, set, nil)
+	d := newDecoder(nil)
+	go Decode(c, d, flipped)
+	data := countData(c, nil, "flipped.rdb", d)
+	assert.Equal(t, true, data["ChecksumMismatch"])
+	assert.Equal(t, uint64(6), data["Databases"].([]*DBEntry)[0].Num)
+	assert.Contains(t, stderr.String(), "warning: rdb: checksum mismatch")
+}
+
+func TestDecodeFailedChecksum(t *testing.T) {
+	pp := NewParseProgress("checksum_test")
+	j := startJob("checksum_test", "", pp)
+	defer j.finish()
+
+	j.decodeFailed(&rdb.ChecksumError{Stored: 1, Computed: 2})
+	assert.NotNil(t, j.checksumErr)
+	assert.False(t, pp.Failed())
+}
@@ -8,6 +8,11 @@ require (
 	github.com/elazarl/go-bindata-assetfs v1.0.0
 	github.com/juju/errors v1.0.0 // indirect
 	github.com/julienschmidt/httprouter v1.3.0
+	github.com/klauspost/compress v1.11.13
+	github.com/pierrec/lz4 v2.6.1+incompatible
 	github.com/stretchr/testify v1.4.0
 	github.com/urfave/cli v1.22.1
+	gopkg.in/yaml.v2 v2.2.2
 )
+
+replace github.com/dongmx/rdb => ./third_party/rdb
@@ -57,31 +57,99 @@ func main() {
 			Name:      "dump",
 			Usage:     "dump statistical information of rdbfile to STDOUT",
 			ArgsUsage: "FILE1 [FILE2] [FILE3]...",
-			Action:    dump.ToCliWriter,
+			Flags: append([]cli.Flag{
+				cli.IntFlag{
+					Name:  "db",
+					Value: -1,
+					Usage: "Only dump keys in database N, -1 for all databases",
+				},
+				cli.BoolFlag{
+					Name:  "salvage",
+					Usage: "Skip corrupted objects and report a partial result instead of stopping at them",
+				},
+				cli.StringFlag{
+					Name:  "redis-version",
+					Usage: "Estimate memory with the model of this redis version, e.g. 6.2, instead of the version saving the rdb",
+				},
+				cli.BoolFlag{
+					Name:  "calibrate",
+					Usage: "Scale estimated bytes of types and key prefixes to add up to the used-mem saved in the rdb",
+				},
+			}, dump.AnalysisFlags...),
+			Action: dump.ToCliWriter,
+		},
+		cli.Command{
+			Name:  "sync",
+			Usage: "pull a snapshot from a running redis as a replica and dump its statistical information to STDOUT",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  "addr, a",
+					Usage: "Address of the redis, host:port",
+				},
+				cli.StringFlag{
+					Name:  "password",
+					Usage: "Password of the redis",
+				},
+				cli.IntFlag{
+					Name:  "db",
+					Value: -1,
+					Usage: "Only dump keys in database N, -1 for all databases",
+				},
+				cli.StringFlag{
+					Name:  "redis-version",
+					Usage: "Estimate memory with the model of this redis version, e.g. 6.2, instead of the version saving the rdb",
+				},
+				cli.BoolFlag{
+					Name:  "calibrate",
+					Usage: "Scale estimated bytes of types and key prefixes to add up to the used-mem saved in the rdb",
+				},
+			}, dump.AnalysisFlags...),
+			Action: dump.Sync,
 		},
 		cli.Command{
 			Name:      "show",
 			Usage:     "show statistical information of rdbfile by webpage",
 			ArgsUsage: "DIR1 [DIR2] [DIR3] or FILE1 [FILE2] [FILE3]...",
-			Flags: []cli.Flag{
+			Flags: append([]cli.Flag{
 				cli.UintFlag{
 					Name:  "port, p",
 					Value: 8080,
 					Usage: "Port for rdr to listen",
 				},
-			},
+				cli.StringFlag{
+					Name:  "redis-version",
+					Usage: "Estimate memory with the model of this redis version, e.g. 6.2, instead of the version saving the rdb",
+				},
+			}, dump.AnalysisFlags...),
 			Action: dump.Show,
 		},
 		cli.Command{
 			Name:  "web",
 			Usage: "start web server with upload capability for analyzing RDB files",
-			Flags: []cli.Flag{
+			Flags: append([]cli.Flag{
 				cli.UintFlag{
 					Name:  "port, p",
 					Value: 8080,
 					Usage: "Port for rdr to listen",
 				},
-			},
+				cli.StringFlag{
+					Name:  "results",
+					Value: "results",
+					Usage: "Directory to store analysis results in, so they are kept across restarts",
+				},
+				cli.BoolFlag{
+					Name:  "cleanup",
+					Usage: "Remove the progress and uploaded file of cancelled parse jobs",
+				},
+				cli.BoolFlag{
+					Name:  "salvage",
+					Usage: "Skip corrupted objects of uploaded files and report a partial result instead of failing",
+				},
+				cli.StringFlag{
+					Name:  "redis-version",
+					Usage: "Estimate memory with the model of this redis version, e.g. 6.2, instead of the version saving the rdb",
+				},
+			}, dump.AnalysisFlags...),
 			Action: dump.ShowWeb,
 		},
 		cli.Command{
@@ -90,6 +158,29 @@ func main() {
 			ArgsUsage: "FILE1 [FILE2] [FILE3]...",
 			Action:    keys,
 		},
+		cli.Command{
+			Name:      "verify",
+			Usage:     "verify the integrity of rdbfile, exit with non-zero status if any is corrupted",
+			ArgsUsage: "FILE1 [FILE2] [FILE3]...",
+			Action:    dump.Verify,
+		},
+		cli.Command{
+			Name:      "simulate",
+			Usage:     "estimate memory of rdbfile with other encoding limits like hash-max-listpack-entries",
+			ArgsUsage: "FILE1 [FILE2] [FILE3]...",
+			Flags: append(append([]cli.Flag{
+				cli.IntFlag{
+					Name:  "db",
+					Value: -1,
+					Usage: "Only simulate keys in database N, -1 for all databases",
+				},
+				cli.StringFlag{
+					Name:  "redis-version",
+					Usage: "Estimate memory with the model of this redis version, e.g. 6.2, instead of the version saving the rdb",
+				},
+			}, dump.SimulateFlags...), dump.AnalysisFlags...),
+			Action: dump.Simulate,
+		},
 	}
 	app.CommandNotFound = func(c *cli.Context, command string) {
 		fmt.Fprintf(c.App.ErrWriter, "command %q can not be found.\n", command)
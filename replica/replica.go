@@ -0,0 +1,240 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replica pulls a rdb snapshot from a running redis by acting as a
+// replica, the snapshot is streamed from the socket and never written to disk.
+package replica
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// eofMarkLen is the length of the mark ending a diskless snapshot
+const eofMarkLen = 40
+
+// DialTimeout is the timeout of connecting and of each handshake reply
+var DialTimeout = 10 * time.Second
+
+// Snapshot is the rdb payload sent by a redis master for a full resync
+type Snapshot struct {
+	conn net.Conn
+	r    io.Reader
+	stop chan struct{}
+	// Size is the length of the rdb payload, -1 if the master streams it
+	// without saving to disk (repl-diskless-sync) and the size is unknown
+	Size int64
+}
+
+// Sync connects to the redis at addr and starts a full resync by
+// `PSYNC ? -1`, falling back to `SYNC` for servers before 2.8. The returned
+// Snapshot reads the rdb payload and must be closed by the caller.
+func Sync(addr, password string) (*Snapshot, error) {
+	return SyncContext(context.Background(), addr, password)
+}
+
+// SyncContext is Sync closing the connection when ctx is done, which fails
+// the handshake or reading of the snapshot
+func SyncContext(ctx context.Context, addr, password string) (*Snapshot, error) {
+	dialer := &net.Dialer{Timeout: DialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-stop:
+		}
+	}()
+
+	s, err := handshake(conn, password)
+	if err != nil {
+		close(stop)
+		conn.Close()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	s.stop = stop
+	return s, nil
+}
+
+func handshake(conn net.Conn, password string) (*Snapshot, error) {
+	br := bufio.NewReader(conn)
+	call := func(args ...string) (string, error) {
+		conn.SetDeadline(time.Now().Add(DialTimeout))
+		if _, err := conn.Write(command(args...)); err != nil {
+			return "", err
+		}
+		return readLine(br)
+	}
+
+	if password != "" {
+		reply, err := call("AUTH", password)
+		if err != nil {
+			return nil, err
+		}
+		if reply != "+OK" {
+			return nil, fmt.Errorf("replica: auth failed: %s", strings.TrimPrefix(reply, "-"))
+		}
+	}
+
+	// capa eof lets the master stream the snapshot without saving it to
+	// disk, servers not knowing it reply an error which is fine to ignore
+	if _, err := call("REPLCONF", "capa", "eof", "capa", "psync2"); err != nil {
+		return nil, err
+	}
+
+	reply, err := call("PSYNC", "?", "-1")
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(reply, "-") {
+		if strings.HasPrefix(reply, "-NOAUTH") || strings.HasPrefix(reply, "-NOPERM") {
+			return nil, fmt.Errorf("replica: %s", reply[1:])
+		}
+		// PSYNC is unknown to redis before 2.8
+		conn.SetDeadline(time.Now().Add(DialTimeout))
+		if _, err := conn.Write(command("SYNC")); err != nil {
+			return nil, err
+		}
+	} else if !strings.HasPrefix(reply, "+FULLRESYNC") {
+		return nil, fmt.Errorf("replica: unexpected reply to PSYNC: %s", reply)
+	}
+
+	// the master sends newlines as keepalive while producing the snapshot,
+	// which may take long for a large dataset, so there is no deadline
+	conn.SetDeadline(time.Time{})
+	for {
+		reply, err = readLine(br)
+		if err != nil {
+			return nil, err
+		}
+		if reply != "" {
+			break
+		}
+	}
+	if !strings.HasPrefix(reply, "$") {
+		return nil, fmt.Errorf("replica: unexpected snapshot header: %s", reply)
+	}
+
+	s := &Snapshot{conn: conn}
+	if strings.HasPrefix(reply, "$EOF:") {
+		mark := []byte(reply[len("$EOF:"):])
+		if len(mark) != eofMarkLen {
+			return nil, fmt.Errorf("replica: bad eof mark: %s", reply)
+		}
+		s.Size = -1
+		s.r = &eofReader{r: br, mark: mark}
+		return s, nil
+	}
+	size, err := strconv.ParseInt(reply[1:], 10, 64)
+	if err != nil || size < 0 {
+		return nil, fmt.Errorf("replica: bad snapshot size: %s", reply)
+	}
+	s.Size = size
+	s.r = io.LimitReader(br, size)
+	return s, nil
+}
+
+// Read reads the rdb payload, it returns io.EOF at the end of the snapshot
+func (s *Snapshot) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	if err == io.EOF && s.Size > 0 {
+		if lr, ok := s.r.(*io.LimitedReader); ok && lr.N > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+	}
+	return n, err
+}
+
+// Close closes the replication connection, the master drops the replica
+func (s *Snapshot) Close() error {
+	close(s.stop)
+	return s.conn.Close()
+}
+
+// eofReader reads a diskless snapshot which is ended by a random mark
+// instead of a known length. The master sends nothing after the mark until
+// the replica acknowledges, so the mark is always the tail of a read.
+type eofReader struct {
+	r    io.Reader
+	mark []byte
+	buf  []byte
+	done bool
+}
+
+func (e *eofReader) Read(p []byte) (int, error) {
+	for !e.done && len(e.buf) <= eofMarkLen {
+		chunk := make([]byte, 32*1024)
+		n, err := e.r.Read(chunk)
+		e.buf = append(e.buf, chunk[:n]...)
+		if bytes.HasSuffix(e.buf, e.mark) {
+			e.buf = e.buf[:len(e.buf)-eofMarkLen]
+			e.done = true
+		}
+		if err == io.EOF && !e.done {
+			return 0, io.ErrUnexpectedEOF
+		}
+		if err != nil && !e.done {
+			return 0, err
+		}
+	}
+
+	// hold back the last bytes which may be the beginning of the mark
+	avail := len(e.buf)
+	if !e.done {
+		avail -= eofMarkLen
+	}
+	if avail == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, e.buf[:avail])
+	e.buf = e.buf[n:]
+	return n, nil
+}
+
+// command encodes args in RESP multi bulk format
+func command(args ...string) []byte {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(buf, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	return buf.Bytes()
+}
+
+// readLine reads a single line reply without the trailing CRLF
+func readLine(br *bufio.Reader) (string, error) {
+	line, err := br.ReadString('\n')
+	if err != nil {
+		if err == io.EOF {
+			return "", errors.New("replica: connection closed by master")
+		}
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2017 XUEQIU.COM
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var payload = []byte("REDIS0009\xfe\x00\x00\x01a\x011\xff\x00\x00\x00\x00\x00\x00\x00\x00")
+
+// fakeMaster serves a single replica connection, reply maps a command to the
+// raw response written for it
+func fakeMaster(t *testing.T, reply func(cmd []string) string) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		br := bufio.NewReader(conn)
+		for {
+			cmd, err := readCommand(br)
+			if err != nil {
+				return
+			}
+			if _, err := conn.Write([]byte(reply(cmd))); err != nil {
+				return
+			}
+		}
+	}()
+	return l.Addr().String()
+}
+
+func readCommand(br *bufio.Reader) ([]string, error) {
+	line, err := readLine(br)
+	if err != nil {
+		return nil, err
+	}
+	n, _ := strconv.Atoi(line[1:])
+	cmd := []string{}
+	for i := 0; i < n; i++ {
+		if _, err := readLine(br); err != nil {
+			return nil, err
+		}
+		arg, err := readLine(br)
+		if err != nil {
+			return nil, err
+		}
+		cmd = append(cmd, arg)
+	}
+	return cmd, nil
+}
+
+func TestSync(t *testing.T) {
+	addr := fakeMaster(t, func(cmd []string) string {
+		switch strings.ToUpper(cmd[0]) {
+		case "AUTH":
+			if cmd[1] == "secret" {
+				return "+OK\r\n"
+			}
+			return "-WRONGPASS invalid password\r\n"
+		case "REPLCONF":
+			return "+OK\r\n"
+		case "PSYNC":
+			return fmt.Sprintf("+FULLRESYNC 8de1787ba490483314a4d30f1c628bc5025eb761 0\r\n\n\n$%d\r\n%s", len(payload), payload)
+		}
+		return "-ERR unknown command\r\n"
+	})
+
+	s, err := Sync(addr, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(payload)), s.Size)
+	b, err := ioutil.ReadAll(s)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, b)
+	s.Close()
+}
+
+func TestSyncAuthFailed(t *testing.T) {
+	addr := fakeMaster(t, func(cmd []string) string {
+		return "-WRONGPASS invalid password\r\n"
+	})
+	_, err := Sync(addr, "wrong")
+	assert.Error(t, err)
+}
+
+func TestSyncFallback(t *testing.T) {
+	addr := fakeMaster(t, func(cmd []string) string {
+		switch strings.ToUpper(cmd[0]) {
+		case "SYNC":
+			return fmt.Sprintf("$%d\r\n%s", len(payload), payload)
+		}
+		return "-ERR unknown command\r\n"
+	})
+
+	s, err := Sync(addr, "")
+	assert.NoError(t, err)
+	b, err := ioutil.ReadAll(s)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, b)
+	s.Close()
+}
+
+func TestSyncDiskless(t *testing.T) {
+	mark := strings.Repeat("0123456789", 4)
+	addr := fakeMaster(t, func(cmd []string) string {
+		switch strings.ToUpper(cmd[0]) {
+		case "REPLCONF":
+			return "+OK\r\n"
+		case "PSYNC":
+			return fmt.Sprintf("+FULLRESYNC 8de1787ba490483314a4d30f1c628bc5025eb761 0\r\n$EOF:%s\r\n%s%s", mark, payload, mark)
+		}
+		return "-ERR unknown command\r\n"
+	})
+
+	s, err := Sync(addr, "")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), s.Size)
+	b, err := ioutil.ReadAll(s)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, b)
+	s.Close()
+}
+
+func TestSyncCancelled(t *testing.T) {
+	addr := fakeMaster(t, func(cmd []string) string {
+		switch strings.ToUpper(cmd[0]) {
+		case "PSYNC":
+			// keepalive while the snapshot is being produced
+			return "+FULLRESYNC 8de1787ba490483314a4d30f1c628bc5025eb761 0\r\n\n"
+		}
+		return "+OK\r\n"
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	_, err := SyncContext(ctx, addr, "")
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestEOFReader(t *testing.T) {
+	mark := []byte(strings.Repeat("m", eofMarkLen))
+	data := bytes.Repeat([]byte("x"), 100*1024)
+
+	// the master closes the connection without sending the mark
+	e := &eofReader{r: bytes.NewReader(data), mark: mark}
+	_, err := ioutil.ReadAll(e)
+	assert.Error(t, err)
+
+	e = &eofReader{r: bytes.NewReader(append(data, mark...)), mark: mark}
+	b, err := ioutil.ReadAll(e)
+	assert.NoError(t, err)
+	assert.Equal(t, data, b)
+}
@@ -31,6 +31,11 @@ func Digest(b []byte) uint64 {
 	return crc64(0, b)
 }
 
+// Update returns the result of adding the bytes in b to the crc
+func Update(crc uint64, b []byte) uint64 {
+	return crc64(crc, b)
+}
+
 type digest struct {
 	crc uint64
 }
@@ -0,0 +1,11 @@
+module github.com/dongmx/rdb
+
+go 1.14
+
+require (
+	github.com/cupcake/rdb v0.0.0-20161107195141-43ba34106c76
+	github.com/juju/errors v0.0.0-20200330140219-3fe23663418f
+	github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	gopkg.in/check.v1 v1.0.0-20200227125254-8fa46927fb4f
+)
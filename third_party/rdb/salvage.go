@@ -0,0 +1,375 @@
+package rdb
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+)
+
+const (
+	// maxResyncLen limits strings decoded when looking for the next valid
+	// object, so random bytes taken as a length are not read at length
+	maxResyncLen = 16 << 20
+	// maxResyncSteps is the number of opcodes, e.g. SELECTDB and EXPIRETIME,
+	// that may precede the key found when resyncing
+	maxResyncSteps = 4
+)
+
+// SalvageReport tells what DecodeSalvage skipped of a corrupted RDB
+type SalvageReport struct {
+	// Skipped are the corrupted ranges skipped, in order
+	Skipped []SkippedRange
+	// Truncated is set if the RDB ends before the EOF opcode
+	Truncated bool
+	// Checksum is set if the checksum of the RDB does not match
+	Checksum *ChecksumError
+}
+
+// SkippedRange is a range of a RDB skipped by DecodeSalvage
+type SkippedRange struct {
+	// Offset of the first byte skipped
+	Offset int64
+	Bytes  int64
+	// Key of the object that failed to decode, "" if it is not known
+	Key string
+	Err string
+}
+
+// Partial reports whether some objects of the RDB are missing
+func (r *SalvageReport) Partial() bool {
+	return len(r.Skipped) > 0 || r.Truncated
+}
+
+// SkippedBytes get the total number of bytes skipped
+func (r *SalvageReport) SkippedBytes() int64 {
+	n := int64(0)
+	for _, s := range r.Skipped {
+		n += s.Bytes
+	}
+	return n
+}
+
+// DecodeSalvage is DecodeContext for a corrupted or truncated RDB. An object
+// that fails to decode is dropped, none of its hooks are called, and
+// decoding resumes at the next offset where a key decodes. EndRDB is called
+// at the end of r even if the EOF opcode is missing, what is skipped is
+// recorded in report before that. An error is only returned for a bad
+// header or when ctx is done.
+func DecodeSalvage(ctx context.Context, r io.Reader, d Decoder, report *SalvageReport) error {
+	br, ok := r.(byteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	cr := &checksumReader{r: br}
+	rr := &rewindReader{r: cr}
+	decoder := &decode{ctx: ctx, event: d, intBuf: make([]byte, 8), r: rr, cr: cr, salvage: true}
+	if err := decoder.checkHeader(); err != nil {
+		return &DecodeError{Offset: rr.offset(), Err: err}
+	}
+	return decoder.salvageLoop(rr, report)
+}
+
+func (d *decode) salvageLoop(rr *rewindReader, report *SalvageReport) error {
+	out := d.event
+	events := &recorder{}
+	d.event = events
+	out.StartRDB(d.rdbVersion)
+	d.firstDB = true
+	end := func() {
+		report.Checksum = d.checksumErr
+		events.replay(out)
+		if report.Truncated {
+			out.EndDatabase(int(d.db))
+			out.EndRDB()
+		}
+	}
+
+	for {
+		if err := d.ctx.Err(); err != nil {
+			return err
+		}
+		rr.mark()
+		start := rr.offset()
+		db := d.db
+		done, err := d.tryNext()
+		if err == nil {
+			if done {
+				end()
+				return nil
+			}
+			events.replay(out)
+			continue
+		}
+
+		skipped := SkippedRange{Offset: start, Key: string(d.key), Err: err.Error()}
+		d.db = db
+		found, done, err := d.resync(rr, events)
+		if err != nil {
+			return err
+		}
+		skipped.Bytes = rr.offset() - start
+		if found {
+			skipped.Bytes = rr.markOffset() - start
+		}
+		if skipped.Bytes > 0 {
+			report.Skipped = append(report.Skipped, skipped)
+		}
+		if !found {
+			report.Truncated = true
+			end()
+			return nil
+		}
+		if done {
+			end()
+			return nil
+		}
+		events.replay(out)
+	}
+}
+
+// tryNext is next turning a panic on corrupted data into an error
+func (d *decode) tryNext() (done bool, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("rdb: corrupted object: %v", p)
+		}
+	}()
+	return d.next()
+}
+
+// resync looks for the next offset after the mark of rr where a key
+// decodes, followed by a valid opcode. Events of what is decoded there are
+// kept in events, and reading goes on after it.
+func (d *decode) resync(rr *rewindReader, events *recorder) (found, done bool, err error) {
+	d.maxLen = maxResyncLen
+	defer func() { d.maxLen = 0 }()
+	db, firstDB := d.db, d.firstDB
+	for {
+		if err := d.ctx.Err(); err != nil {
+			return false, false, err
+		}
+		events.reset()
+		d.db, d.firstDB, d.expiry = db, firstDB, 0
+		d.lruIdle, d.hasIdle = 0, false
+		d.lfuFreq, d.hasFreq = 0, false
+		d.checksumErr = nil
+
+		rr.rewind()
+		if _, err := rr.ReadByte(); err != nil {
+			return false, false, nil
+		}
+		rr.mark()
+		if done, ok := d.resyncAt(rr); ok {
+			return true, done, nil
+		}
+	}
+}
+
+// resyncAt decodes up to maxResyncSteps opcodes until a key or the end of
+// the RDB is decoded
+func (d *decode) resyncAt(rr *rewindReader) (done, ok bool) {
+	for i := 0; i < maxResyncSteps; i++ {
+		done, err := d.tryNext()
+		if err != nil {
+			return false, false
+		}
+		if done {
+			// nothing follows the end of a RDB
+			_, err := rr.ReadByte()
+			return true, err == io.EOF
+		}
+		if d.key != nil {
+			b, err := rr.ReadByte()
+			if err == io.EOF {
+				return false, true
+			}
+			if err != nil {
+				return false, false
+			}
+			rr.unreadByte()
+			return false, validOpcode(b)
+		}
+	}
+	return false, false
+}
+
+// validOpcode reports whether b is a value type or an opcode
+func validOpcode(b byte) bool {
+	return b <= byte(TypeHashListpackEx) && b != 8 || b >= rdbOpCodeSlotInfo
+}
+
+// rewindReader keeps the bytes read since the last mark, so reading can go
+// back to the mark
+type rewindReader struct {
+	r    byteReader
+	buf  []byte
+	pos  int
+	base int64
+}
+
+func (r *rewindReader) Read(p []byte) (int, error) {
+	if r.pos < len(r.buf) {
+		n := copy(p, r.buf[r.pos:])
+		r.pos += n
+		return n, nil
+	}
+	n, err := r.r.Read(p)
+	r.buf = append(r.buf, p[:n]...)
+	r.pos += n
+	return n, err
+}
+
+func (r *rewindReader) ReadByte() (byte, error) {
+	if r.pos < len(r.buf) {
+		r.pos++
+		return r.buf[r.pos-1], nil
+	}
+	b, err := r.r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	r.buf = append(r.buf, b)
+	r.pos++
+	return b, nil
+}
+
+func (r *rewindReader) unreadByte() {
+	r.pos--
+}
+
+// mark drops the bytes before the read position
+func (r *rewindReader) mark() {
+	r.base += int64(r.pos)
+	r.buf = append(r.buf[:0], r.buf[r.pos:]...)
+	r.pos = 0
+}
+
+// rewind goes back to the mark
+func (r *rewindReader) rewind() {
+	r.pos = 0
+}
+
+func (r *rewindReader) offset() int64 {
+	return r.base + int64(r.pos)
+}
+
+func (r *rewindReader) markOffset() int64 {
+	return r.base
+}
+
+// recorder keeps the events of decoding until the object decoded is known
+// to be valid
+type recorder struct {
+	events []func(d Decoder)
+}
+
+func (r *recorder) add(e func(d Decoder)) {
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) replay(d Decoder) {
+	for _, e := range r.events {
+		e(d)
+	}
+	r.reset()
+}
+
+func (r *recorder) reset() {
+	for i := range r.events {
+		r.events[i] = nil
+	}
+	r.events = r.events[:0]
+}
+
+func (r *recorder) StartRDB(ver int) {
+	r.add(func(d Decoder) { d.StartRDB(ver) })
+}
+
+func (r *recorder) StartDatabase(n int) {
+	r.add(func(d Decoder) { d.StartDatabase(n) })
+}
+
+func (r *recorder) Aux(key, value []byte) {
+	r.add(func(d Decoder) { d.Aux(key, value) })
+}
+
+func (r *recorder) ResizeDatabase(dbSize, expiresSize uint32) {
+	r.add(func(d Decoder) { d.ResizeDatabase(dbSize, expiresSize) })
+}
+
+func (r *recorder) Set(key, value []byte, expiry int64, info *Info) {
+	r.add(func(d Decoder) { d.Set(key, value, expiry, info) })
+}
+
+func (r *recorder) StartHash(key []byte, length, expiry int64, info *Info) {
+	r.add(func(d Decoder) { d.StartHash(key, length, expiry, info) })
+}
+
+func (r *recorder) Hset(key, field, value []byte) {
+	r.add(func(d Decoder) { d.Hset(key, field, value) })
+}
+
+func (r *recorder) EndHash(key []byte) {
+	r.add(func(d Decoder) { d.EndHash(key) })
+}
+
+func (r *recorder) StartSet(key []byte, cardinality, expiry int64, info *Info) {
+	r.add(func(d Decoder) { d.StartSet(key, cardinality, expiry, info) })
+}
+
+func (r *recorder) Sadd(key, member []byte) {
+	r.add(func(d Decoder) { d.Sadd(key, member) })
+}
+
+func (r *recorder) EndSet(key []byte) {
+	r.add(func(d Decoder) { d.EndSet(key) })
+}
+
+func (r *recorder) StartStream(key []byte, cardinality, expiry int64, info *Info) {
+	r.add(func(d Decoder) { d.StartStream(key, cardinality, expiry, info) })
+}
+
+func (r *recorder) Xadd(key, id, listpack []byte) {
+	r.add(func(d Decoder) { d.Xadd(key, id, listpack) })
+}
+
+func (r *recorder) EndStream(key []byte, items uint64, lastEntryID string, cgroupsData StreamGroups) {
+	r.add(func(d Decoder) { d.EndStream(key, items, lastEntryID, cgroupsData) })
+}
+
+func (r *recorder) StartList(key []byte, length, expiry int64, info *Info) {
+	r.add(func(d Decoder) { d.StartList(key, length, expiry, info) })
+}
+
+func (r *recorder) Rpush(key, value []byte) {
+	r.add(func(d Decoder) { d.Rpush(key, value) })
+}
+
+func (r *recorder) EndList(key []byte) {
+	r.add(func(d Decoder) { d.EndList(key) })
+}
+
+func (r *recorder) StartZSet(key []byte, cardinality, expiry int64, info *Info) {
+	r.add(func(d Decoder) { d.StartZSet(key, cardinality, expiry, info) })
+}
+
+func (r *recorder) Zadd(key []byte, score float64, member []byte) {
+	r.add(func(d Decoder) { d.Zadd(key, score, member) })
+}
+
+func (r *recorder) EndZSet(key []byte) {
+	r.add(func(d Decoder) { d.EndZSet(key) })
+}
+
+func (r *recorder) Module(key []byte, module string, size uint64, expiry int64, info *Info) {
+	r.add(func(d Decoder) { d.Module(key, module, size, expiry, info) })
+}
+
+func (r *recorder) EndDatabase(n int) {
+	r.add(func(d Decoder) { d.EndDatabase(n) })
+}
+
+func (r *recorder) EndRDB() {
+	r.add(func(d Decoder) { d.EndRDB() })
+}
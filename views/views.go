@@ -188,7 +188,7 @@ func footerHtml() (*asset, error) {
 	return a, nil
 }
 
-var _headerHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\xc1\x8e\xd3\x30\x10\xbd\x23\xf1\x0f\x83\x39\xbb\xe9\x22\xed\x1e\x50\x1a\x09\xc1\x81\x95\xd0\x1e\x16\xf8\x80\x49\x3c\x75\x07\x39\xe3\xca\x76\x4a\x57\x55\xff\x1d\x25\x69\xd2\x36\xd9\xb2\x97\x24\x1e\xcf\xbc\xf7\xf2\x9e\x9d\x6f\x08\x0d\x05\xa8\x1c\xc6\xb8\x52\x35\xb2\xe8\xbe\xa4\x8a\xf7\xef\x00\x00\xf2\x0f\x5a\xc3\x0f\x6f\x3d\x68\x3d\x94\x10\x36\x81\xd6\x2b\x95\xa9\x61\xd0\x79\xeb\x87\x89\x71\xaa\x66\x61\x68\x77\x60\xed\x03\x44\x36\x54\x62\xe8\xab\xf7\xcb\xfd\xfd\x12\xb6\xbc\x27\x17\xcf\xc0\xdd\x64\xdc\xa2\x5c\xc2\xea\x76\x40\x15\x79\x59\x3c\x7f\x7b\xce\xb3\xb2\xc8\xb3\xb6\x65\x4a\x36\xf2\x04\xb2\x8d\xc3\x00\x31\x61\x22\x40\x31\x50\xfb\x92\x1d\x81\xa1\x1d\x57\xf4\x26\x9d\xb3\x37\xc9\xf2\x0c\x2f\x5d\xf9\xde\x7b\xf7\x84\xbb\x12\xc3\x67\x88\xe9\xc5\x11\x54\x28\x50\x12\xac\x7d\x23\x06\x58\xa0\x77\x73\xe1\x28\x5e\x30\xe7\x82\xbb\x81\x54\xba\x71\xe8\x5f\xba\x55\xcd\x95\x4e\x7e\xab\x20\x78\x47\xdd\x3e\x5b\x4c\xec\x65\x66\xf0\xcf\x93\xa5\xc9\x5b\xeb\x08\xca\x26\x25\x2f\xd7\xbf\x37\x46\xc5\x12\x13\x4a\x45\xd9\xe1\xb0\xf8\xda\x84\x40\x92\x1e\x4f\xa5\xe3\x71\xcc\xf1\x14\x92\xee\x11\x2f\x09\x67\x66\xc5\xa0\xbd\xb8\x17\x55\xfc\xea\xd9\xcf\x3a\x67\x01\xcd\x66\x37\x6c\x0c\x89\xde\x47\x55\xc0\xe1\x00\x53\x41\x70\x3c\xce\x43\x1e\xbd\xef\x56\x86\x27\x06\xea\xaa\x89\xc9\xd7\xba\x26\x69\x66\xba\x1b\x77\xd1\x3c\x58\x2d\xb8\x9b\x36\x8e\xce\xfe\x8e\x14\xe0\x4b\x55\xf9\x46\xd2\xad\x64\x4d\xf0\x5b\xe3\xff\xca\x24\xdb\x2b\x2c\xc7\x03\xf1\xd0\x0d\x4d\x0b\xdd\x3e\x5e\x95\x3a\x0b\xee\xa3\x9a\x22\x0c\xe1\x80\xc1\x84\xa7\xc5\x79\xf7\x16\x60\x07\xca\xb5\x85\x18\xaa\x95\xca\xfa\x73\x96\x19\x8e\x29\xe3\xda\x66\xad\xa0\x4f\xfa\xee\x61\xb9\xbf\x7b\x58\x2e\xfe\x6c\xed\x48\xdb\x49\xe5\x1a\x2d\x29\x40\x97\x56\xaa\xf3\xe6\xb1\x2b\xfc\x8f\xeb\x46\xde\x6d\xdc\x2d\xc2\x13\xd6\xaf\xe6\x7c\x85\x71\x95\xf9\xb9\xea\x78\x1a\x70\xd6\xb8\xab\xb3\x62\x78\x37\xde\x59\xc1\xf6\x3b\xcf\xfa\x9b\x58\xfc\x0b\x00\x00\xff\xff\x16\x79\x1f\x8f\xf3\x04\x00\x00")
+var _headerHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x54\x5b\x6f\xd3\x30\x14\x7e\xdf\xaf\x38\x84\xd7\xb9\x29\x88\xed\x01\x65\x91\x60\x3c\x30\x09\x26\xb4\xc1\x0f\x70\x6c\x37\x35\x75\xec\xc8\x76\xba\x56\x51\xfe\x3b\xc7\xce\xa5\x6d\xd6\x8e\x4a\x4d\xe4\x73\xf9\xbe\xcf\xe7\x92\x6c\x2d\x28\x17\x16\x98\xa2\xce\xdd\x25\x15\x95\x9a\xf4\xa6\x24\xbf\x02\xfc\x65\xef\x08\x81\x1f\xa6\x34\x40\xc8\x60\xa1\xb0\xb6\x62\x75\x97\xa4\xc9\x98\xa6\xd0\x3f\xc4\x4f\x39\x95\xd4\x12\x82\x03\x56\xc6\x82\x93\x5c\x14\xd4\xf6\xd6\x9b\xe5\xee\x66\x09\xb5\xdc\x09\xe5\x26\xd8\x98\xe8\x6a\xaa\x8f\x41\x49\x88\x4f\xf2\xac\xc8\x9f\xbe\x3d\x65\x69\x91\x67\x69\x08\x99\x51\x4d\x2c\x56\x94\x8d\x42\x16\xe7\xa9\x17\x40\x35\x87\xca\x14\x52\x09\xe0\x62\x2b\x99\xf8\x1f\x99\x2a\x2f\x51\x65\x29\x3d\x2a\xc7\xf7\xbe\x66\x8f\x74\x8b\x57\xfa\x8c\x6c\x7b\xa4\x60\x08\x56\x08\x94\xd1\x20\xad\xd4\xd0\x57\x71\xa1\x84\x3b\xd0\x66\x9a\x6e\x47\x46\x1d\xb3\xa1\x7f\x91\xa0\x58\x32\xe2\x4d\x9d\x80\x35\x4a\x44\xbf\x2c\xd1\x68\xf4\xbc\xb2\xcf\x43\x2d\xbd\x29\x4b\x24\x2e\x1a\xef\x8d\x3e\xb9\xd9\xd4\x21\xa9\x11\x58\x33\x91\xb6\xed\xe2\xbe\xb1\x56\x68\xff\x30\x98\xba\x6e\x6a\xdf\xd0\x1c\xd2\x03\x1e\xd1\xbd\x2a\x93\xb3\xc4\x68\xb5\x4f\xf2\xdf\x3d\xf7\x41\xe4\xbc\x31\xaf\x52\xd7\x92\x73\xa1\xc9\xce\x25\x39\xb4\x2d\xcc\xe5\x40\xd7\x9d\x83\x68\x5b\xb9\xc2\xd8\xb5\x60\x1b\xd7\x54\x3f\xa5\xab\xa8\x67\x6b\x8c\x3d\x69\x1e\x2d\x84\x82\xf8\x24\x2f\xd4\x6a\xa9\xcb\x04\xbc\xf4\xa1\x8c\xf7\x4f\xf7\xb7\x9f\x80\x0d\x00\x60\x56\xe0\xd7\xd8\xa5\x38\x14\x06\x27\x42\x1b\x0f\x11\xf3\x3a\x3a\x38\xf5\x14\xcf\xfb\xd0\x4a\x66\xac\x6d\x6a\x2f\x78\x92\x4f\xf9\xd5\xa0\x60\xd0\xda\xb6\x42\xf3\xae\x3b\x23\xf9\x17\xb5\x5e\x52\xf5\x86\x52\x4e\x75\x89\x6b\x36\x0a\xc5\x0e\x3d\x6f\x64\x5d\x0b\xfe\x75\xef\x85\xeb\xba\x03\x3f\x14\xc1\x02\xd4\x0a\x70\x7d\xc8\x35\x6c\xc4\xde\x85\x31\x43\xd1\x55\xf4\xa0\x32\x17\x2e\x9e\xd7\x3d\xf3\x05\x85\xd3\x24\xc7\x03\x97\xb3\x79\x24\xac\x71\xde\x54\xa4\x12\xba\x99\x0f\x42\xa3\x8e\x62\xc7\xc1\xc5\xd7\x2c\x6e\x9a\xd3\x3f\x0e\x97\xe4\x0b\x63\xb8\x11\xfe\xd2\x96\x70\x6b\x6a\x6e\x5e\xf4\xe9\x9e\x9c\x40\x29\x39\xd2\x8e\xc1\xd0\x04\xe4\xf0\x38\xa7\xf3\xd5\x16\xbc\x4f\xe6\x00\xe3\xa4\xc7\x76\x0f\x87\x83\xf7\x02\x5e\xc4\x94\x55\x09\xce\x32\x5c\xad\x7e\x63\x53\x2e\x9d\x4f\xd1\x9a\x06\x39\x1f\xc9\x87\xdb\xe5\x0e\xff\x8b\xbf\x75\x39\xb1\x46\xa1\xb2\xa2\x25\x12\x52\xe5\xef\x92\x58\x98\x87\x68\x78\x83\xea\xc2\xf2\x84\xdd\x09\x00\x8f\xb4\xba\xb4\x34\x67\x7b\x7d\x30\x2a\x39\x6b\x6c\xda\xa8\xa3\x99\xc0\x2b\x6d\xc7\xaf\x1e\x76\x37\xbf\xca\xd2\xfe\x5b\x96\xff\x03\x0f\xc9\xa3\xd4\x2d\x06\x00\x00")
 
 func headerHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -203,12 +203,12 @@ func headerHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "header.html", size: 1267, mode: os.FileMode(438), modTime: time.Unix(1765374958, 0)}
+	info := bindataFileInfo{name: "header.html", size: 1581, mode: os.FileMode(438), modTime: time.Unix(1792192270, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _main_with_uploadHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5c\xeb\x72\x1b\x37\x96\xfe\x3f\x55\xf3\x0e\x67\x98\x38\xa4\x12\x76\xb3\x49\x5d\x6c\x33\xa2\xaa\xe2\x4b\xc6\xd9\x8d\x1d\x97\xac\xec\x4c\x2a\x35\x55\x01\xbb\x0f\x49\xd8\x60\xa3\x07\x00\x25\x31\x1e\xfd\xd8\x67\xd8\x07\xd8\x57\xdc\x47\xd8\x02\xfa\x42\x74\x37\xd0\xa4\x1c\x65\x66\xe8\x2a\x49\x6c\x00\x07\x07\xe7\xf2\x9d\x0b\xba\x7c\xfe\xa7\x17\x3f\x3c\xbf\xfa\xe9\xed\x4b\x58\xa9\x35\xbb\xf8\xe3\x1f\xce\xf5\x6f\x60\x24\x5d\xce\x7a\xbf\xae\x82\xe7\x6f\x7a\xe6\x21\x92\xe4\xe2\x8f\x7f\x00\x00\x38\x5f\xa3\x22\x10\xaf\x88\x90\xa8\x66\xbd\x1f\xaf\xbe\x0d\x9e\xf4\x6a\x63\x29\x59\xe3\xac\x77\x4d\xf1\x26\xe3\x42\xf5\x20\xe6\xa9\xc2\x54\xcd\x7a\x37\x34\x51\xab\x59\x82\xd7\x34\xc6\xc0\x7c\x19\x02\x4d\xa9\xa2\x84\x05\x32\x26\x0c\x67\xe3\x30\xaa\x68\x29\xaa\x18\x5e\x5c\xbe\xb8\x84\x00\x2e\x31\xa1\x12\x2e\x5f\x3c\x83\x6f\x52\xc2\xb6\xbf\xa2\x38\x1f\xe5\xe3\xc5\x64\x46\xd3\x0f\x20\x90\xcd\x7a\x52\x6d\x19\xca\x15\xa2\xea\xc1\x4a\xe0\x62\xd6\x1b\x49\x45\x14\x8d\x47\x73\xce\x95\x54\x82\x64\xa3\x84\x4a\x35\x8a\xa5\xdc\x3d\x0a\xd7\x34\x0d\x63\x29\x7b\x35\x82\xf5\xf5\x19\xdb\x2c\x69\x2a\x47\x34\xe6\xa9\x1c\x2d\x78\xaa\x02\x72\x83\x92\xaf\xb1\x5a\xdd\x66\x41\x6d\x33\x9c\xf5\x14\xde\x9a\x0d\x7b\x30\x2a\x37\x30\x93\x8a\x2f\xfa\x33\xe7\xc9\x16\x3e\xee\xbe\x9b\x67\x24\xfe\xb0\x14\x7c\x93\x26\x53\x60\x34\x45\x22\x82\xa5\x20\x09\xc5\x54\x0d\xc6\xc7\xa7\x09\x2e\x87\xf0\xd9\xd9\xd9\x63\x44\x02\xd1\xa3\x21\x7c\xf6\xf8\xec\x64\x4e\x26\x30\x8e\xa2\x47\x47\x5f\xd7\x69\xad\x69\x1a\xac\x90\x2e\x57\x6a\xaa\xc7\xaf\x57\x8d\xf1\x8c\x24\x09\x4d\x97\x53\x38\x8e\xb2\x5b\x88\x1a\xa3\xe6\xb4\x0b\xb2\xa6\x6c\x3b\x85\xfe\x3b\x5c\x72\x84\x1f\xbf\xeb\x0f\xe1\x8a\xac\xf8\x9a\x0c\xe1\xcf\x98\xe2\x35\x19\xc2\x7f\xa1\x48\x48\x4a\x86\x20\x49\x2a\x03\x89\x82\x2e\x2c\x52\x77\xbb\x3f\xc3\x35\xa1\x69\xa0\x6d\x83\xd0\x14\x45\xf3\xe8\x6b\x72\x9b\x9b\xc8\x14\xc6\x27\x51\x94\xdd\x36\x8f\x43\xc4\x92\xa6\x53\x88\x80\x6c\x14\xf7\x9d\x25\x82\x49\x7d\xa9\xcd\x80\x36\x6a\x14\x81\xc4\x58\x51\x9e\x76\xc9\xfe\x66\x45\x15\x36\xf6\x98\x73\xa1\x57\x6b\x75\x6c\xe4\xb4\xb9\x4f\x3e\xe3\x36\x90\x2b\x92\xf0\x9b\x92\x11\x38\xd3\x3f\xc4\x72\x4e\x06\xd1\xd0\xfc\x0b\x8f\x9b\x7a\xaa\xeb\xe1\xc4\x77\xf2\x60\xce\x95\xe2\xeb\x7c\x5a\x63\x46\x42\x65\xc6\xc8\x76\x0a\x0b\x86\xcd\xb1\xf7\x1b\xa9\xe8\x62\x1b\x14\x4e\x39\x05\x99\x91\x18\x83\x39\xaa\x1b\xc4\xb4\x31\x99\x30\xba\x4c\x03\xaa\x70\x2d\xa7\x10\x63\xaa\x50\x78\x64\xc9\xf8\x92\x07\x44\x20\x69\x8a\xb1\x8b\x97\x6e\xf2\xfa\xb3\x24\x59\x4b\xb4\xad\x6d\xb5\x73\x35\xb7\x35\xf6\x2a\xe9\xaf\x38\x85\x93\x49\x5b\x33\x0f\xe8\x57\xc1\x0d\xce\x3f\x50\x15\xec\x68\x06\x31\xa3\xd9\x14\x34\x5b\x9e\xb9\x7a\x28\x58\x50\xc6\x82\x98\x33\x2e\xa6\xa0\x04\x49\x65\x46\x04\xa6\xcd\x25\xe6\x20\x37\x85\xdf\x3e\x89\x22\x8f\x20\x0c\x18\xee\x93\xc4\xe4\xa4\x25\x89\x62\xff\xcf\x8e\x8f\x8f\xbb\x36\x3e\xf3\x6e\xbc\xc9\x18\x27\x49\x30\xdf\x28\xc5\x53\xa7\x05\x64\x5c\x52\xed\x60\x53\x0d\x8e\x44\xd1\x6b\xf4\x90\x9a\xab\x34\xc8\xc9\xb5\x48\x94\x3e\x31\x9e\x64\xb7\x2e\x8b\xb7\x0e\x39\x3e\x73\x8f\xba\x8f\x02\x6d\x4f\x1e\x3b\x3c\xf9\x01\xed\x25\xdf\x6d\x0a\x29\x4f\x9b\x90\x52\x28\xc3\x85\x36\xc6\x40\x0a\x31\x12\xc6\x20\x0a\x8f\x25\x20\x91\x2d\x12\x1b\x21\x35\x8d\x8c\x53\x87\x3b\x3d\x80\x33\x8e\xfd\xce\xb8\xd3\xdf\x74\xc5\xaf\xdb\x90\x6e\xce\xb0\xe0\x62\x5d\xd8\x3b\x23\x0a\x7f\x1a\x04\x93\xec\xb6\x2d\x23\x1b\x39\xf5\x96\x39\x7c\x1a\xe4\x1c\x47\x93\x21\x8c\x27\x67\x43\x98\x1c\x9f\x0c\x21\x0a\x4f\x8e\x3c\x0c\x2d\x28\xc3\x80\xa6\xd9\xa6\xe5\x14\x95\x20\x1a\x5a\xb0\x57\x17\x08\xe9\x8b\x0f\x15\x89\xa5\xa0\x49\x53\x52\x82\x26\x81\xc2\x75\xa6\x8f\xa8\x7d\x7c\xb3\x4e\x75\x8c\x78\xa2\x0f\x31\x5e\x38\x05\x7b\xec\x17\xac\xa4\x09\xce\xc9\xbf\x6f\xa4\x9a\x9c\xb6\x28\x96\x99\xc6\x82\xaa\x32\xd4\xb4\xc2\xd8\x6d\x95\x8f\xc4\x84\xc5\x03\x93\x94\x40\x00\x93\xd3\xa8\x6d\x11\xda\x9e\x16\x8c\xdf\x04\xdb\x69\x33\xe0\xbb\x24\x65\xe0\xb0\x03\x09\xc7\x4f\xba\x41\xe2\x71\x0b\x24\xfc\x40\xd9\x88\xc7\x0e\xf1\x16\x82\xaa\xa6\x8c\xdb\xf2\x2a\x74\x54\x11\xc9\x6e\x41\x72\x46\x13\xf8\x6c\x11\xe9\x7f\xbe\x14\x86\x4a\xc5\xc5\x36\x60\x54\xb6\x6c\x5c\x3f\x0b\x4c\x96\xe9\x04\x9b\x5d\x8e\xe4\xcb\xac\xf6\xec\xa9\xa1\xa2\x1b\xa8\x1d\xe7\x6c\x08\xab\xad\x86\x86\xb1\xb6\x27\x74\x23\x5c\x1b\x27\x27\x4e\x9c\xb4\x7d\xe6\xb3\xc5\x93\xc5\xd3\x05\xf9\xa7\x62\xa5\x2d\x44\x37\x5a\xd6\x38\xc4\xa7\x18\xe3\xc2\x75\xd6\x06\x9e\xfe\x75\x70\x5a\x77\x1e\xdf\xa6\x21\x89\x75\x28\xfe\x3d\x2b\x8e\x8e\x70\x76\x60\x72\x51\x33\x35\xda\xe5\xcf\x27\x07\x49\x3a\xd0\xa5\x69\x8b\x0c\xc3\xdb\x29\x8c\x3d\x80\x33\x85\x15\x4d\x92\x56\x66\x6c\x32\xb8\xdd\x1c\x64\x8c\x66\x92\xca\xc6\x2c\x73\xf4\xc0\x64\xd8\xda\x07\x6f\x04\xc9\x3a\x12\x17\xff\x19\x70\x9d\xa9\x6d\xa0\xcb\xd0\x16\xf7\x86\x11\x63\x8f\x1e\x4b\xac\x5c\xf2\xa4\x0c\xa3\x1e\x64\x7b\xfa\xf4\xe9\x01\xdb\x77\x69\xe1\xa4\xed\xad\x0d\x7f\x77\x20\x02\xcf\x48\x4c\xd5\x76\x0a\x51\x78\xba\xaf\x52\xec\x08\xc7\xff\xf2\x20\xe8\xaa\xd4\xac\x92\xfb\x2c\xf2\x83\xc1\x0d\xb2\x98\xaf\x31\x90\xb1\x40\x6c\x9d\xec\x70\x0d\x8f\x23\x47\xc9\xe8\xda\x88\xc6\x6d\x01\xda\x96\xd8\x61\x25\xb9\xef\xdf\xab\x24\x75\x71\xb0\x2f\x40\x1f\xb7\x8b\xb6\x87\x0a\xd0\x0d\x1b\x74\x72\xd7\x5d\x47\x39\xb2\x87\x9d\x78\xce\x3e\x59\x36\xbb\xfc\x39\x60\x44\x2c\x5b\xe2\xd9\xe9\xf9\xd4\xdd\x19\xb8\x47\x82\xb3\xbf\x0a\xfa\x7d\xab\xe6\x7f\x8b\x2a\x88\xa6\xfa\x18\xc1\xef\x5c\x0c\xe5\xca\xbc\x57\x49\x74\xbc\xb7\x24\x3a\x2d\xea\xe0\x03\x4b\xa2\xdd\x97\xd1\x97\xf0\x63\x5e\x63\xbf\xe6\x09\x61\xf0\xe5\xc8\x62\xba\x60\x78\x6d\x46\x0e\x2c\x99\xa0\x56\xe2\x2f\xe8\x2d\x36\xab\x21\xc5\xb3\x76\x96\xc9\x70\xa1\xda\x4f\xcb\x8e\x5f\x14\x3d\xf2\x14\x14\x8e\x21\xdb\x2e\x6b\x60\xfd\xb8\x29\xc4\x5f\x03\x9a\x26\x3a\xda\x3f\xad\x07\x3b\x38\x48\xe5\xad\xd6\x59\x67\x47\xcc\x96\xa5\x27\xd9\xf2\xa5\x98\x3e\x32\xe5\xce\x0f\x1f\xfb\x3a\xa3\xd8\xae\x13\xdb\x0c\x62\x96\xca\x9e\xb6\xd4\x62\x17\x78\x4f\xda\xfd\xe6\x03\x2b\xba\xe2\xe0\x8c\x4b\x47\xe2\xc6\x89\x9a\x82\xd0\x5b\xf8\x91\x70\xb2\x07\x09\xe7\x9c\x35\x0d\xd6\x9d\x11\xc1\x5e\x60\x31\x68\x52\x1e\xb9\xa3\x6b\x69\x9d\xc9\x0d\x0b\xee\x58\xe6\x30\x0b\x57\xbf\xad\x84\xd6\xe3\xec\x16\x12\x22\x57\x98\x78\xa2\x76\x13\xf1\xdb\xb9\x59\x65\x16\x67\x9e\xdc\x71\x6f\x6e\xf2\x30\x88\xdd\xae\xd6\x9a\xb5\x50\x11\x65\x0d\xd4\x74\x48\xde\x12\x9b\xa7\xea\xca\x65\x52\x2a\x20\x8f\x60\x9d\xdc\x44\x8b\xc9\xc2\x77\xc5\x61\x6d\x17\x26\x82\x2c\x1f\x64\x47\x7c\x82\xf3\xd6\xf9\xad\x40\x62\x6e\xd2\x06\xe3\x30\x9a\xf8\xea\xc0\x82\xad\x3d\x59\xe0\x99\xbf\x5b\x7c\x48\x12\xd8\xa1\x05\xd3\x97\x73\xb5\x2c\x0e\xd4\x62\xde\xd7\x73\xb4\x1f\xf6\x57\xf5\xb5\x1c\x6a\x8f\x3f\xec\xbd\x7a\x71\xcc\xf8\x17\x5c\xbd\xe8\x4c\x83\xe4\xb7\xa2\x4d\x79\xf8\xc3\x69\x97\x1c\x1e\x34\x8d\xf4\x0a\xd1\xa5\xe4\xa6\x0e\xff\xf9\x59\xa6\x47\xb0\xd3\x84\x4a\x32\x67\xd8\xba\x99\xb0\xaa\xd7\x66\xe2\x5f\x42\x5a\xca\x35\x44\x32\x7e\x53\x4b\x8c\xec\x8d\xcc\x26\x92\x4a\x53\x62\x6f\xe4\x3d\xbc\x62\x9f\x2a\xeb\xc0\x71\xbc\x98\x2c\x9a\xb1\x6e\xbf\xbe\x0e\xe9\x95\x67\x82\x2f\x05\xca\x16\xeb\x55\xfc\xef\xb4\x02\xd7\x8d\x4b\x27\x5b\xc5\xd6\xe7\xa3\xf2\x12\xfd\x7c\x54\xbc\xa1\x70\x3e\xe7\xc9\xb6\xbc\x62\x4f\xe8\x35\xc4\x8c\x48\x39\xeb\xd5\x2f\x9b\x7b\xd6\xbd\xfb\xf9\x9f\x82\x00\x5e\x99\xab\x60\x08\x02\x7b\xc0\x5a\x5e\xbf\x2a\xb6\x97\x37\x67\x56\x17\xa1\xcd\x49\xce\x89\x3a\x7e\xf6\x2e\x2e\x5f\x5c\x9e\x8f\x12\x7a\xbd\x67\xc5\xee\x8e\xaf\x77\xe1\x7a\x21\xa2\x4d\xc1\xf9\xcc\xa2\xd8\xbe\xbc\x73\x72\x9d\x8f\x97\x8b\x76\x95\x4d\x0f\x78\x1a\x33\x1a\x7f\x98\xf5\x78\x86\x69\x5e\x57\x98\xb2\x62\x70\xe4\x22\x64\x88\xd1\x92\xce\x82\xc0\x82\x94\x94\x2e\xce\x47\xd4\xb3\xa2\x28\x57\xf4\x59\xbf\xa5\x0c\x1d\xfc\x8d\x72\x06\xf7\x1c\xbe\xfc\xde\x50\xfd\xf3\x22\xa7\xf6\xea\xbe\xd1\x78\x6a\x29\x5f\x13\x79\x97\xdf\x3b\xd4\x89\x34\x09\x35\xae\x71\xf6\x19\x48\xed\x2e\xe3\x50\x71\x16\xdd\xce\x5c\x9e\xb9\x6d\x48\x2a\xe1\x55\xfe\xd8\x25\x3b\x8f\xdd\x6d\x58\x65\xfb\xd6\x1d\x43\x0f\x68\x52\x3d\xf9\x5e\x3f\xf0\xf1\xc5\x2a\xc6\xac\xee\xa1\x6f\xb6\xeb\x24\x34\x9d\xf3\xdb\x2e\xbb\x28\xe5\x75\xf1\x86\x83\xce\x08\x24\x14\x60\x9d\xc0\x16\x95\xef\x60\xf9\xa1\x99\x8b\xe8\xf9\x68\xc3\x3c\x36\xe4\x50\xf9\x6b\x42\x53\xb7\xf1\x80\x0f\x7b\x6c\x2b\x32\x82\xd4\x23\x05\x89\x7d\xd6\x50\xef\x11\x7a\xc5\xee\x58\xa1\xd3\xbc\xfb\x08\x3e\x21\x8a\xcc\x89\xc4\x2e\xd9\x77\x4a\xd7\xc1\x43\x61\xc3\x7f\xc9\xbf\x82\xe2\xd0\x81\x79\x5e\x22\x06\xf9\xfc\x07\x29\x80\x62\xcb\x37\xc2\x7a\x61\x2c\x37\x0d\xc5\x4b\xeb\x80\x35\xae\xb9\xd8\xc2\x46\x92\x25\x0e\xe1\x03\x6e\x75\x84\x53\x82\xce\x37\x5a\x31\x43\x20\x69\x02\x6b\x2e\x30\xfc\x84\xa3\xfb\x90\x32\xef\x01\x7d\x0a\x5e\xba\x34\xb4\x1f\x33\x2d\x71\xfc\xa4\xc5\xf1\x2d\x15\x52\x75\x40\x28\xf8\x61\xd4\x77\x66\x1f\xbc\x42\xcb\x6d\x8c\xbb\xd4\x9a\x4e\x95\xbb\x38\x82\x91\x29\x8e\x73\xf7\xd8\xec\x04\xd4\xf3\x80\xb3\xab\x3d\xd2\x42\x68\x99\x91\x4a\x27\x56\xed\x6d\xa9\xc3\x7c\x6f\xe8\xe3\x0b\x45\xd7\x28\xbf\x3e\x1f\xe9\xe5\x4d\x92\xab\x09\x98\xdc\x43\xfb\xf0\x2e\x91\x89\xbe\xae\x95\xee\xbd\x8b\x46\xec\x92\xe7\xa3\xd5\xa4\x0d\x27\xed\xf3\x98\x50\x6c\xc9\xe0\x9b\x03\x12\x0a\xab\xaa\xeb\x5d\xfc\xdf\xff\xfe\xcf\x7f\x77\xe6\x14\x05\xf7\x76\xa3\x44\xe7\x58\x35\xfe\x9d\xf5\x4e\xbb\x00\xf0\x99\xee\x0b\x41\x96\xf0\x05\xbc\x10\x3c\xdb\x49\x00\x5e\xa1\x70\xc6\xf0\xfd\xbc\xda\x3d\xf6\xd6\xc5\x59\xef\x82\x0b\x30\xda\xd4\xbe\x2e\x91\x61\xac\x72\xdf\xf7\x92\xce\xdf\x0c\xc9\x5f\xd0\xd4\x33\x73\x81\xeb\xbf\xbe\xd3\x23\xbd\xca\xe9\xaa\xd7\x48\x7a\x40\xe2\x18\x33\x35\xeb\x85\x22\x99\xf7\x60\xbd\x61\x8a\x66\xb5\xd7\x38\xdb\xe6\xef\xd2\x55\x55\x01\xef\xf6\xcc\xa3\xa9\x67\x69\x0b\x57\xc0\x2a\x4e\x72\x1a\xc5\x97\x67\x2a\xed\x41\x59\xad\x5c\xbc\x53\x44\xa8\x2a\x0d\xb0\x3c\xdc\xcf\x5a\xa3\x18\xb1\x88\x4b\x2a\xdf\xe5\xcf\x7c\x8a\xd2\x53\xf3\x65\xaf\x51\x6a\x7c\xed\x35\x75\x37\x7e\xfa\xf8\x2c\x99\x38\x8d\xe8\xad\xc0\x8c\x08\x9a\x2e\xc3\x30\x3c\x24\x1d\x2e\x6b\x8e\x43\x62\x61\x39\x37\xd0\x49\x9a\xfd\x25\xd0\xc8\x9f\x61\x52\x7f\x48\x52\xba\x26\x0a\x93\x9e\x1f\x5d\x41\x70\x7d\xae\x72\xd9\x9c\x88\xea\xac\x45\xd5\x1d\x3d\xca\x65\x57\x4e\x79\x46\xc4\x4e\xbf\x2d\x66\x7f\x1b\xc2\xca\x58\xd0\x4c\x81\x14\xf1\xee\x75\xe5\xf7\x72\xf4\xfe\xef\x1b\x14\x5b\xf3\x76\xf2\x7b\xa9\x37\xcf\xe7\x5d\x74\xac\x6a\xbc\x24\xfd\xbe\xf9\x8e\xb4\x9f\x8e\xc5\xe9\x68\x54\x00\xfd\x62\x93\x9a\x74\x47\xee\xc6\xca\x47\xd0\x0a\x81\xad\xf6\x38\x8f\x37\x6b\x4c\x55\xb8\x44\xf5\x92\xa1\xfe\xf3\xd9\xf6\xbb\x64\xd0\xb7\xe2\x42\xff\x28\x34\x2a\xd6\xde\x13\x92\x24\x19\xf4\xf3\x56\x7b\xdf\x7b\x01\x52\xed\xdf\xc6\xfc\xdf\xcc\x80\xc0\x35\xbf\x46\x27\x0f\x85\x58\x2e\x51\xa2\x82\xe2\x2d\xc7\x05\x17\xeb\xfa\x8c\x1c\xb7\x30\xc9\xa1\x72\x06\x3f\xff\xad\x59\x8c\xfb\x58\x2a\x21\xa4\x7f\x14\xd2\x34\x45\xf1\xea\xea\xf5\xf7\x30\x83\x7e\xff\x50\x02\x3b\xfc\xe8\x1f\x85\x55\xbb\x63\x06\x4a\x6c\x9a\xbd\x93\x6e\x1a\x15\x4c\xf4\x8f\x42\xe3\x11\x61\xd1\x41\xd0\xec\xa4\x3c\xc5\xbe\xf7\x6e\x6a\x04\xdf\x6b\xb9\x14\xf5\x05\xf0\x14\x32\xb2\x44\xd0\xc2\x72\x28\x50\x3f\x2e\x0a\x9b\xb6\xee\x16\xa8\xe2\xd5\xa0\x3f\x62\x46\x26\x6d\x87\x0b\xd5\x0a\xd3\x81\x40\x99\xf1\x54\x22\xcc\x2e\xa0\xfc\x3b\x7c\x2f\x79\x3a\x38\xf2\xae\xd1\xf9\xb1\x9e\xff\xd1\x0d\x0e\x31\x4f\xa5\x02\xab\x44\x82\x99\x5f\x60\xd6\xb4\x96\xb5\x94\x1f\xba\x00\xb3\x67\x48\x53\xa9\x48\x1a\xa3\x84\x2f\xbe\x80\xfa\x93\x90\x61\xba\x54\x2b\xb8\x80\xa8\x25\x09\xfb\x63\xed\xd7\x6d\x26\x35\x75\xd7\xb7\x5a\x70\xf1\x92\xc4\xab\x41\xf9\xa4\x43\x16\x75\x99\x30\x6a\x8b\x22\x16\x48\x14\x16\xd2\x18\xf4\x19\xf5\x0a\xa0\xfc\x30\x9a\xbb\xda\x1b\xb2\x46\xcd\xb3\xfd\x66\x4f\x17\xff\xc5\x5a\xfb\xbc\xbf\x74\xcf\x06\x47\xd2\x6d\x42\x36\xdf\x97\x74\x57\xab\xed\x9c\xb3\xf5\x0a\x52\x0f\x4c\x41\x34\xeb\x7d\xfe\xb1\x94\xe2\x5d\xef\xc2\xfa\xe2\x4c\x3a\x9b\x9f\x5f\xf6\x9f\xb9\x48\x70\x61\x06\x83\xa3\x03\xf4\x04\xa6\x67\x9c\x26\xfc\x26\x64\x3c\x26\xda\xcb\xc2\x95\xc0\x85\x96\xf6\xa8\x64\x6e\xd4\x87\xaf\xa0\xfc\xb2\x87\x85\xbb\x3d\xe3\xb6\x3d\x92\x2c\xc3\x34\x79\xbe\xa2\x2c\x19\x30\xda\x65\x0c\x77\xbe\xc1\xbb\xf6\xe3\x3b\x97\x1f\xc7\x44\x83\x03\x0a\xa1\x65\xa2\x8d\x93\x33\x0c\x51\x08\x2e\x06\xfd\x97\xfa\x97\xc1\x16\x9a\x2e\x4b\x06\xa7\xfd\x21\xa0\x10\x47\xfe\x6b\xf5\x51\x59\xe1\x94\xf0\x44\x18\x55\x56\xc3\x25\x77\x81\x5d\x36\xdf\x85\x0a\xbb\x59\x35\x9f\xc8\x49\x54\xf9\x69\x17\x85\x6a\x92\x87\xc0\x3e\x5c\xda\x45\x93\xd6\xf2\x5d\x9c\xe8\x22\x60\x47\x13\x37\x89\x2a\x4c\xec\x25\x63\x05\x94\x16\xa9\x5a\xb6\xd9\x45\xa9\x36\xd1\x41\xc8\xca\xd2\xba\xc8\x58\xd3\x0c\x91\x1d\x19\x86\xca\x1d\xbe\x77\x53\x76\x7a\xd5\xa9\xca\xcb\x6b\x4c\x95\x16\x32\xa6\x28\x06\x7d\xe3\xa8\xfd\xa1\xdb\x51\x2b\x7d\x86\x66\xda\xa0\x66\x87\x75\x3e\x76\x53\x1d\x7b\xac\x48\xba\x44\xbd\x09\xba\x76\x59\x91\x34\x61\x68\x98\x1f\x60\xa8\x88\x58\xa2\x32\x97\x6d\xb2\x63\xbf\xce\x43\x95\x97\x9e\xde\x2d\x31\xcc\x04\xea\x35\x2f\x70\x41\x36\x4c\x0d\x9a\xae\x6d\x91\x6f\x24\x7a\x15\xed\xdf\xc2\x1c\x43\x72\x8d\x3e\xa9\x3b\xf7\x2e\x73\xbc\x07\xd8\x9e\x67\x0f\x2c\x97\x4e\xde\xda\x1a\xd6\x81\xfd\xca\x5c\x1c\xa3\xd8\xab\xe7\x2a\xed\xb2\x69\xe4\x8b\x9a\xcc\x37\xdd\xe0\x1b\x21\xc8\x36\x5c\x08\xbe\x2e\x16\xe8\xcd\x14\x0a\xf3\x0d\x66\x8e\x30\xa7\x07\x42\x1d\x29\x43\x4c\x13\xf9\x17\xaa\x56\x83\xbe\x2e\xb9\x9b\x99\x5c\x9d\x45\x28\xb2\xa5\xda\xfe\x65\x6a\x34\x9b\xcd\x3c\xc9\x11\x61\x28\xd4\xa0\xff\x96\x21\x91\x58\xb6\x0e\xae\x09\xa3\x09\xe8\x3d\xf3\x2e\x82\x33\x3b\x11\xa8\x36\xa2\x79\x73\x7b\xd7\x64\xa9\x48\x7f\x73\x89\x35\xc9\xec\x80\xd2\x4e\xba\x17\x84\x49\xdc\x5b\xc1\xd4\x09\xbb\x30\x63\x7f\xa2\x57\x97\x55\x99\xdb\x19\xcd\x0c\xc1\xbc\x2d\xe5\x4b\x1c\xac\x70\xa4\x70\xdd\x91\xda\x25\xf4\xda\x29\xbd\x72\x69\x3d\xab\xab\x2e\xf7\x5d\x29\x5d\xb5\xe4\x80\x64\xee\xdc\xdf\xa7\x05\x57\x17\xcc\xee\x46\x34\xda\x78\x9f\x7f\xac\x0c\xf2\xae\xab\xff\xdb\xa4\xbb\xa7\x63\xf5\xf9\x47\x5d\x01\x12\xf5\x6c\xab\x0a\x5f\x0a\xf5\xf8\x51\xe7\x1e\xf7\xeb\x3f\x9b\x3e\x91\x5c\x9b\x5f\x89\x06\x7f\x61\x75\x3d\x73\xb8\xd0\x9a\x1f\xe8\xdc\x33\xc1\xdb\xbb\xa3\xde\xc5\xa5\x79\xda\xd1\x0d\x76\xe5\x9d\x95\xb1\xd9\x59\x5c\xa9\xad\xa6\xf2\xef\xf6\x17\xe7\x16\x6b\x85\x11\x76\x61\x4c\x28\x33\x46\xe3\x62\xea\x10\xc6\xcd\x0d\x3b\x3d\xf0\x53\x10\xc3\xe9\xb4\x8e\x4a\xf9\x6e\xdf\x39\x6d\x03\x98\xeb\x9f\xad\xfd\x34\x7b\x66\xa4\xe4\x27\x07\x1d\xe8\x47\x60\x96\x35\xdd\x24\x77\x4b\x9d\xf2\x8f\xa3\xc9\x89\x73\x50\x1b\x99\x49\x4f\xfa\x39\x85\x21\xf4\xff\xf3\x99\xfe\xf9\xda\xfc\xfc\xf3\xb3\x7e\xb3\xed\x90\xaf\xd3\xf5\xdb\x6b\xa2\x56\xe1\x82\x71\x2e\x06\xe6\x4f\xc6\x97\x25\xe3\x23\xa8\x9e\x7c\x38\x6a\x4a\xb9\xe0\xda\xcc\x30\x2f\x24\x14\x87\x2a\x16\x65\xfc\x66\xf0\x61\x08\xf4\x08\xbe\x84\x71\x14\x69\x62\xe3\x28\x82\xaf\xa0\x0f\xba\xdc\x30\x1c\xff\x4c\xff\xe6\xb3\x1b\x4b\x21\xf7\xcc\xac\xf6\x69\xbf\x82\xf8\x43\x60\xdb\x61\x01\xf5\x0c\xb6\xdd\x10\x99\x33\x1e\x7f\x68\x81\xb2\x9d\xac\x86\x0a\x6f\x55\x79\xd1\x38\x83\x7e\x5e\x67\xe8\xe2\xc4\x04\xa6\x30\x0c\x9b\xcb\xad\x24\xb5\xd8\xd0\xb4\x24\xf5\xe2\xd3\x47\xfd\xd6\x59\x0a\x20\xe7\x62\xfd\xc2\xb4\x36\x20\xc5\x1b\xf8\xb6\xf8\xda\x72\x17\x77\xc0\x28\x22\xb9\xcb\x59\x4a\xc2\x05\x34\xe4\xc5\x85\x36\x3a\xfd\xdb\x09\x0d\x2e\xf6\x6e\x57\xa2\xe0\xec\xaf\xaf\xbf\x7f\xa5\x54\x76\x89\x7f\xdf\xa0\xcc\x13\xa3\xfa\x82\xdb\x95\x28\x5e\x71\x73\x18\x43\x29\x1b\x6f\xe6\x55\x1a\x05\x16\x86\xf0\x9c\xaf\xb3\x8d\xd2\x1a\xf6\x76\x56\x8a\x0a\x02\x45\x8c\xa9\xd2\xf3\x19\x2a\x1d\xcb\x34\x0d\x4e\x12\x4c\x60\x04\x18\x2a\xae\x08\x2b\xec\xdb\x53\xbf\xda\x45\xe2\xdb\x9c\x5c\xe9\x72\x6b\x9a\x0e\x9a\x3b\x7c\x09\x51\xf8\x78\x08\x8f\x23\x5f\x3d\xec\x37\x84\xfa\x1e\x5f\x41\xff\x91\xaf\x83\xd2\x65\x8b\xbf\xb4\x6d\x11\x3e\xff\x68\xf9\x78\x83\xe3\xa3\xbb\x47\xae\xe0\x71\xb7\xd7\x06\xb4\x4a\xdb\xba\xd4\x7b\xfb\xfc\xba\x54\xa3\x5e\x59\xbc\x56\xa5\x3d\x7a\x12\xf9\x1b\x64\x4a\xb4\xfe\x03\x8e\xb6\x72\x04\xca\x0d\xd3\x67\xff\x8f\x77\x3f\xbc\x09\x33\x22\x24\x9a\x3d\xca\xee\xe1\x15\xde\xaa\x36\xff\x4d\xae\x72\x2a\xa1\xdc\xc4\x31\xca\x36\xe6\xdf\x47\x07\x05\x1e\x40\x41\x6b\xb1\x61\x43\xd0\x6c\xe5\x17\x29\xfb\xfa\x62\x1d\x50\x31\x8e\x22\x07\x58\xb4\x58\x43\x75\x45\xd7\xc8\x37\x6a\x70\x70\x8b\xc9\x92\x40\xad\xa7\xd9\x7c\x76\x68\x57\xd3\xfe\x1c\xd6\xbe\x6a\xee\xf4\x73\xd4\x8c\x78\xae\xcf\x1d\x20\x6b\xbf\x86\x7e\x6f\x4e\xf6\xe9\x04\xdc\x9d\xac\xda\xf0\x50\xa3\x88\xd7\xef\x0f\x67\x56\xad\x04\xbf\x31\xb0\x6a\xfa\x5e\xa5\x5a\xd6\x45\x43\xe5\x1f\xff\xa8\xec\x6b\x41\x28\xc3\xa4\xb3\x49\xeb\x61\xfa\x0e\x4c\xbf\x0d\x06\xa6\xc3\xd6\xa9\xc9\x43\x0c\x3d\x67\x64\x6a\xf2\x02\x43\xb1\xe4\xb6\x83\xb3\x0e\x33\x8f\xbc\xc8\x07\x07\x97\x67\x7b\x84\xd0\xad\x8a\xfb\x9c\xf9\xd5\xd5\xd5\x5b\x73\xf0\x1d\xac\xdd\x1b\xfa\x3b\x4e\x7c\xbf\xd3\x7e\x2a\x6c\x1b\xa5\x75\xe1\xf6\x7d\x24\xf2\x06\xd5\x0d\x17\x1f\x72\x4b\x70\x9d\xeb\xde\x82\x38\x5c\x08\x9e\x13\xab\x1c\x0f\x61\x06\xc7\x11\x7c\x09\x67\x51\x1e\xf3\x9b\x41\x5f\x4f\xe5\x19\xa6\x83\xfe\xdb\x1f\xde\x5d\xe9\xc4\x7b\x44\x32\x3a\xca\x23\x73\x7f\x68\x92\xc9\xa6\xb7\x19\xb5\xeb\x24\xaa\x4c\xaa\x3a\x1a\x36\xcd\xdb\xb4\x9b\x15\x5a\xf7\x69\xd6\x8d\x6c\xed\x1e\xad\x49\xe2\x12\x17\x02\xe5\xaa\xa2\x82\xd7\x28\xb6\x70\x0a\x12\x63\x9e\xda\x54\x24\xaa\xef\x52\x85\xe2\x9a\xb0\x81\x45\x71\x08\xa7\x16\x50\x59\x37\xc7\xe7\xa3\xe2\x55\xdd\xf3\x51\xf1\x5f\x8f\xfd\x7f\x00\x00\x00\xff\xff\x05\x22\x21\x68\x8c\x4c\x00\x00")
+var _main_with_uploadHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x1c\xeb\x76\xdb\xb6\xf9\xff\x9e\x02\x55\x9b\x4a\xee\x44\xea\xe2\x4b\x12\xd5\xf2\x39\xcd\xa5\x4b\xb7\x26\xcd\x49\xdc\x6d\x3d\x5d\xcf\x29\x24\x42\x12\x63\x8a\xe0\x48\xc8\x8a\x9d\xf8\xc7\x9e\x61\x0f\xb0\x57\xdc\x23\xec\xfb\x00\x5e\x40\x10\xa0\x64\xd7\x6d\xc7\x9c\x58\x22\x09\x7c\xf8\xee\x37\xc0\x3e\xfd\xe4\xd9\x77\x4f\xcf\x7f\x78\xfd\x9c\xac\xc4\x3a\x3a\xfb\xc3\x29\x7e\x90\x88\xc6\xcb\x69\xe7\x7a\xe5\x3d\x7d\xd5\xc1\x67\x8c\x06\x67\x7f\x20\x70\x9d\xae\x99\xa0\x64\xbe\xa2\x69\xc6\xc4\xb4\xf3\xfd\xf9\xd7\xde\xa3\x8e\xfe\x2a\xa6\x6b\x36\xed\x5c\x86\x6c\x9b\xf0\x54\x74\xc8\x9c\xc7\x82\xc5\x30\x74\x1b\x06\x62\x35\x0d\xd8\x65\x38\x67\x9e\xbc\xe9\x93\x30\x0e\x45\x48\x23\x2f\x9b\xd3\x88\x4d\x47\xfe\xb0\x00\x25\x42\x11\xb1\xb3\x37\xcf\xde\x10\x8f\xbc\x61\x41\x98\x91\x37\xcf\x9e\x90\xaf\x62\x1a\x5d\x5d\xb3\xf4\x74\xa0\xde\xab\xb1\x51\x18\x5f\x90\x94\x45\xd3\x4e\x26\xae\x22\x96\xad\x18\x83\x75\x57\x29\x5b\x4c\x3b\x83\x4c\x50\x11\xce\x07\x33\xce\x45\x26\x52\x9a\x0c\x00\x96\x18\xcc\xb3\xac\x7a\xe4\xaf\xc3\xd8\x87\x27\x1d\x1d\x5e\x7d\x7a\x12\x6d\x96\x61\x9c\x0d\x42\xa0\x26\x1b\x2c\x80\x24\x8f\x6e\x59\xc6\xd7\xac\x9c\xdc\xc4\x40\x5c\x25\xc0\x09\xc1\xde\xcb\xf5\x3a\x64\x90\xc3\x97\x63\xd4\x77\xbc\x66\x3c\xb8\x22\x1f\xca\x5b\xf9\x88\xce\x2f\x96\x29\xdf\xc4\xc1\x84\x00\x36\x8c\xa6\xde\x32\xa5\x41\x08\x7c\xec\x8d\x0e\x8f\x03\xb6\xec\x93\x4f\x4f\x4e\x1e\x32\x46\xc9\xf0\x01\x7c\x7f\x78\x72\x34\xa3\x63\x32\x1a\x0e\x1f\x1c\x7c\x59\x03\x05\xe8\x79\x2b\x16\x2e\x57\x62\x82\xaf\x2f\x57\xf5\xd7\x09\x0d\x82\x30\x5e\x4e\xc8\xe1\x30\x79\x4f\x86\xf5\x97\x92\xce\x05\x5d\x87\xd1\xd5\x84\x74\xdf\xb2\x25\x67\xe4\xfb\x6f\xba\x7d\x72\x4e\x57\x7c\x4d\xfb\xe4\x4f\x2c\x66\x97\xf0\xf9\x57\x96\x06\x34\x86\x2f\x19\x8d\x33\x2f\x63\x69\xb8\xa8\x20\xdd\x94\xdf\xfc\x35\x05\x6c\x50\x21\xe0\x93\xa5\x06\xd1\x6b\xfa\x5e\xa9\x05\x20\x7a\x34\x04\x74\x0c\x42\x68\x0a\x22\x98\x90\x21\xa1\x1b\xc1\x1d\x54\x0c\xc9\xb8\x36\x51\x5b\x1b\x55\x98\xa5\x80\xdc\x5c\x84\x3c\x6e\x61\xf8\x76\x15\x0a\x56\x87\x3f\xe3\x29\xce\x45\x11\x6c\xb2\x89\xb1\x86\x1a\xf0\xde\xcb\x56\x34\xe0\xdb\x02\x07\x72\x82\x3f\xd2\xe5\x8c\xf6\x86\x7d\xf9\xcf\x3f\x3c\x68\xe3\xfd\x91\x83\x62\x6f\xc6\x85\xe0\x6b\x35\xaa\x3e\x00\x54\x39\x89\x28\xc8\x66\x11\x31\xe3\xd5\xbb\x4d\x26\xc2\xc5\x95\x97\x5b\xdf\x84\x64\x09\x05\xb3\x9b\x31\xb1\x65\x2c\xae\x8f\xa5\x51\xb8\x8c\x3d\x20\x7a\x0d\xb4\xcd\x61\x34\x4b\xad\x1c\x8c\xf8\x92\x7b\x34\x05\x9d\xfb\xb0\x2f\x1a\xad\xa0\xf1\x5a\xd2\xc4\xe4\xa7\xb9\x22\x1a\x90\xb1\xa2\x54\xcc\x2c\xbc\x66\x13\x72\x34\x6e\xc8\xe2\xfe\x8c\xc7\xdb\xb2\xd9\x45\x28\xbc\x0a\xa4\x37\x8f\x42\xc0\x18\x71\xb2\x0f\xc5\x37\xde\x22\x8c\x22\x60\x7d\xc4\x53\x18\x9a\x82\x4d\x24\xc0\xb6\x58\x58\xac\x6b\x9b\x9b\xe6\xa3\xe1\xd0\xca\x01\xe9\xe7\x76\xb0\x60\x7c\x64\xb2\x20\x5f\xfa\xd3\xc3\xc3\xc3\x96\x35\x4f\x1c\x6b\x6e\x92\x88\xd3\xc0\x9b\x6d\x40\xef\x62\x9b\xc0\x13\x9e\x85\x68\x44\x13\xf4\x79\xe0\x1f\x2f\x99\x15\xce\x4c\xc4\x9e\x82\x65\xce\x2f\x14\x7f\x04\xc2\xb3\xe8\xb5\x46\xdb\xe8\xc4\xfa\xd2\x4a\x02\x5e\x86\xa5\x8e\x9a\x96\x7a\x7f\xda\xa1\xd6\x9a\x90\x98\xc7\xcc\xca\x7f\x8b\x27\x91\xda\x90\x33\x8f\x46\x11\x01\xaf\x90\x11\x46\x33\x13\xc0\x26\xcd\x10\x42\xc2\xc3\xa6\xcd\xfc\x62\x7b\x1b\xb9\xec\xad\x92\xd8\x64\xc5\x2f\x1b\x1e\x5a\x22\xbf\xe0\xe9\x3a\xd7\x6a\x90\x3d\xfb\xa1\xe7\x81\x10\x1b\x9c\xd1\xdd\x21\x2e\xa7\x7c\xa2\x74\x87\xa3\xe1\xb8\x0f\x92\x3f\xe9\x93\xf1\xe1\x51\x1f\x38\x70\x74\x60\x45\x06\x8c\x88\x79\x61\x9c\x6c\x4c\xd5\x2f\xe9\xaf\x33\x5e\x9b\x9a\x7b\x3d\x87\xb3\x2f\xe7\x2f\xd3\x30\x30\xd8\x03\x4f\xc0\xda\xd6\x09\x92\x86\x16\xbc\x59\xc7\xe8\xf0\x1f\x21\xf6\xa3\x85\x8d\x99\x87\x2e\x66\x66\x61\xc0\x66\xf4\xff\x33\xe2\x8c\x8f\x4d\x78\x45\x8e\xb0\x00\x1f\x96\xb3\xcf\x8c\x46\xef\xcb\x44\x02\xb2\xb5\x79\x4f\x66\x13\x90\x9e\x8d\x8f\x87\x0d\x05\x40\xe5\x59\x44\x7c\xeb\x01\x97\xeb\xe1\xda\xc2\x20\xe9\xe3\xdc\xee\x6d\xf4\xa8\xd5\x05\x3c\x34\x5d\x80\xd3\xf9\x19\xf1\xb4\xc9\xd3\x9c\x3d\xe5\x88\x51\x83\x4b\xb9\x58\x4a\x10\xc0\xf0\x8c\x47\x61\x40\x3e\x5d\x0c\xf1\x9f\x3d\xf3\x80\x8c\x93\xa7\x57\x5e\x04\x9f\x06\x99\xf8\xc8\x93\xf9\xa0\xcd\x8d\x54\x69\x8d\x23\x15\x6a\x5d\x0e\xbd\x40\xab\xe3\x6d\x92\x67\x70\xa8\xc1\x78\x43\x2b\x1b\xef\x5b\xdd\x56\xd3\xf5\x8d\x6d\xae\x4f\x37\x8c\x4f\x17\x8f\x16\x8f\x17\xf4\xb7\x72\x7f\x3a\xe7\xac\x0e\xb0\x86\x1b\x7b\xcc\xe6\x6c\x61\xa1\xd1\xf0\x90\x7f\xef\x1d\xd7\xec\xc3\xb1\xa0\x4f\xe7\x18\x49\x7f\xbd\x4a\xc0\x1d\x94\xf6\xca\x0a\x6a\x6a\x15\xb6\x98\xeb\xd1\x1e\xdc\xf5\xb0\x4a\x34\x61\x80\x38\x61\xba\xdd\x91\x4c\xc8\x2a\x0c\x02\x33\x73\x95\xc9\x56\x35\x84\x45\x90\x9c\x65\x61\x56\x1f\x24\x09\xf6\x64\xfe\x8b\x46\xb6\x85\x8a\xcf\x9d\x6e\xb8\x90\x87\x90\x20\xae\x3c\xac\x06\x4d\xb4\x25\x0e\x52\xf1\xec\x2a\x57\xda\xdc\x51\x11\x06\xed\xee\xea\xf1\xe3\xc7\x3b\x57\x6e\x61\xfb\x51\xc3\x18\x0d\x63\x6e\x5a\x3b\x07\x96\x84\x02\x0c\x69\xe8\x1f\xb7\x97\x6b\xee\x58\xfa\xfb\x86\x31\x4b\xcd\xa4\x95\xbb\x27\x43\x97\xa1\x6f\x59\x34\x87\xda\xdd\xcb\xe6\x29\x54\x43\x77\x15\xe8\x68\xd8\xac\xdb\x2c\x8b\x60\xcf\xa0\xc5\x5c\xdc\x1a\xa1\xcc\xfa\x16\x35\xa1\x65\xf1\x1d\xb1\xf5\xb0\x51\x3d\xdd\x4f\x6c\xad\x2b\x9b\x0d\xaf\xd6\x8a\xa6\x19\xf2\x2b\x9e\x9c\xdc\x8d\x21\x55\x6a\xeb\x45\x30\xc5\xe4\x49\x25\xd4\x63\x6b\x2d\xbe\x7f\x3e\xb2\xb3\x24\xf9\x35\x0b\xd6\xdf\xbd\x24\x09\x63\xa4\xc0\xfb\x15\x2b\x13\x25\xbe\xdb\xd4\x27\x87\xbb\xea\x93\xe3\xbc\x0a\xdd\xab\x3e\x29\xbf\x0e\xbe\x20\xdf\xab\xea\xf6\x25\x0f\x68\x44\xbe\x18\x54\xe8\xe6\xa8\xae\xe5\x8b\xbd\xaa\x17\xbc\xaa\xba\x7a\x11\xbe\x67\x46\x69\x22\x78\xd2\x48\x04\x23\xb6\x10\x8d\x87\x45\x0f\x0d\xb4\xc3\x9e\xe2\x37\xdf\xe8\x1a\x58\xf3\xbc\x0f\x0d\xc6\x5d\x43\x41\x16\x60\x98\x7e\x5c\x0b\x57\x78\xed\x14\x70\xa3\x25\xd5\xd2\x6a\xd2\xf9\x67\x4f\x8c\x1c\x59\xa0\x03\x46\xb1\xe8\x3d\x47\xaf\xb6\x40\x54\x75\x33\x4f\x9a\xcd\xcc\xfc\xcd\x63\x53\x10\x7a\x91\xf5\xa8\xd1\xac\xdd\xab\xaa\xca\xe9\x8d\x78\xd6\x4c\xb0\x38\x05\xb8\x29\x82\x77\x7a\xb7\x71\xbb\x77\x9b\xf1\xc8\xd0\x4b\x6b\xfe\x22\x5f\xb4\x39\x0c\xe9\x25\x0a\x4a\x9d\xfd\x3f\x8d\x16\xab\xc1\x5b\xc3\x51\x53\x05\x2c\x2d\xac\xc2\x51\x82\x6f\x20\x01\xcd\x56\x2c\xb0\xc7\x5b\xd3\x79\x37\x92\xa8\x52\x05\x4e\xec\xe9\xdd\xae\x6c\xe2\x3e\xbc\x6f\xb3\x64\x5a\x98\x6a\x25\x83\xa4\x74\x21\x4e\x66\x6b\xcc\xb2\x17\x3f\x8a\x15\x05\xcf\x55\x10\x6a\x43\x64\xb8\x18\x2f\xec\xfb\x00\xda\x52\x7e\x90\xd2\xe5\x3d\xac\xc6\x1e\xb1\x99\x49\xb6\x16\x0f\xe4\x06\x53\x6f\xe4\x0f\xc7\xf6\x4a\x2c\xc7\xa8\x3d\x57\x3b\x71\x36\x5a\xf7\x48\xd5\x9c\x7c\x97\x7d\x2e\x4b\x6f\x60\x2f\xa1\xa9\x26\x59\xb3\xd2\xdf\x59\x45\xd7\x12\x9e\x76\x9d\xdf\xb5\x31\xd1\x1c\xf0\x1b\x6f\x4c\x60\x72\x40\xd5\xd6\xa0\xc1\x06\x67\x2c\x6c\x21\xff\x1e\x53\x3d\x17\xe7\x2c\x32\x95\xd3\x7f\xbf\x44\xd0\xce\xcd\x09\x08\x92\xce\x22\x66\x36\xf0\xb5\xca\xd1\x48\xc7\x0b\x3f\x15\x73\xf4\x7a\x10\xab\xf4\x34\x46\xef\xfd\x5d\xc5\x73\x9b\x5f\xd6\x19\xd4\xdc\x18\x28\x1a\x74\x2e\xfe\x29\xf6\xcb\xb7\xa3\xaa\x35\xc7\x98\x9d\x50\x49\x64\x16\x66\xb2\xb8\xde\x64\x7b\x9b\x9f\x86\x89\xd5\x7c\x6a\x6e\xe9\x10\x9c\x60\x70\x4b\x2d\xd9\xdd\xe0\x4e\x52\xbe\x4c\x59\x66\xe2\x5c\xa6\x0e\x6d\x7a\x67\xd9\x13\x69\x43\x48\xad\x7a\x3a\xc8\x77\xad\x4f\x07\xea\x2c\xc0\x29\x6e\x5b\xe7\x1b\xda\x41\x78\x49\xe6\x11\xcd\xb2\x69\xa7\xbe\xc3\xdb\xa9\x76\xb9\x4f\x3f\xf1\x3c\xf2\x42\xee\xc1\x12\xcf\xd3\x9e\x6b\x93\xeb\x5b\xb4\xda\x64\x73\x60\xb9\x13\x69\x8c\xb1\x8e\xc3\x08\xdc\xc1\xa3\x04\xa7\x03\x78\xd5\x3e\xa1\xda\x6e\x83\x19\x96\x63\x07\x0d\x00\xb6\x47\x1a\xbc\xe6\x56\x9a\x0d\x63\xf5\xba\x98\x53\x95\x3a\x1d\xc2\xe3\x79\x14\xce\x2f\xa6\x1d\x9e\xb0\x58\x55\x1b\xb2\xd8\xe8\x1d\x58\xe0\x48\x58\x61\x01\x66\x41\xc9\x82\x16\x80\xce\x4e\x07\xa1\x7d\x42\x5e\xc2\x20\x99\x5f\x43\x2c\x69\x22\x37\x50\xd8\xb5\x92\x9d\xdf\xd6\x85\xfd\x34\x4f\xb9\x5d\xd2\x36\x1a\x4b\xa6\xb8\x11\xc4\x5b\xb5\x43\x50\x03\x61\x82\x31\xb6\x59\x76\x68\x44\x6d\xcf\x61\x4f\x1e\xe6\x8d\x4b\xc5\x44\xa5\x0c\xe0\x35\xc8\x0b\xf5\xd8\xc2\x31\xbb\x9a\x6d\xa2\x52\xcf\xb5\x0d\x81\x0e\x09\x83\xf2\xc9\xb7\xf8\xc0\x81\x54\x54\x62\xa5\x75\x04\x1d\x83\x6d\x54\x84\x31\x54\xbd\x2d\x8a\x50\x70\xea\xec\x15\x27\x98\x55\x64\x24\x0f\x02\x01\xb9\x62\xc2\x41\x94\xa2\x37\xb2\x80\x3c\x1d\x6c\x22\xab\xce\x34\xa5\xfc\x12\x7c\x85\x55\x5b\x0a\x94\x1a\xce\x45\x57\x1b\xc9\x3f\x7c\x93\x43\xd8\xa1\x00\xf5\xd6\x9f\x8b\xd9\x96\x09\x98\x1a\xde\x82\xdd\x01\x15\x74\x06\x69\x7c\x0b\xc7\xdb\x78\x6a\x41\x20\x57\xd9\xbf\xa9\x5b\x22\x38\x71\xfb\x34\x27\x0c\xe9\xd9\x9c\x44\xe4\xce\xe0\x8a\x6f\x52\xed\xd4\x95\xd2\x06\x58\xaf\xc8\xb1\xd6\x6c\x0d\xca\x4a\x36\x19\x5d\xb2\x3e\xb9\x60\x57\x18\xb1\x44\x1a\x82\xa7\x00\x89\xf4\x61\x5c\x40\x60\x04\xf3\x6f\x4d\xb6\xcb\x13\xaa\xa6\xcf\x1d\xfc\xa1\x84\x7a\x5b\x9f\xa8\xb1\xe2\x07\x64\xc5\xd7\x61\x0a\xd9\xb9\xd3\x45\x2a\xa2\x6c\x6e\xd2\x41\xae\xdd\x7b\x6a\x5f\xd5\x77\xb4\x8d\x5a\x87\xa9\xb0\x0d\x4b\x84\x91\x95\xb2\xb2\x85\x4d\xc5\x96\x8e\xdd\xf3\xda\x3a\x23\xa6\xfb\x85\xb4\xbc\x94\x83\x56\x86\x6b\x22\x90\xf7\x86\x0c\x3e\x17\xe1\x9a\x65\x5f\x42\xb6\x00\xd3\x0d\x88\xab\x31\x91\x39\x04\x5a\x6b\x95\x8d\x0c\xbf\xac\x55\xf1\x9d\x33\x23\x20\x65\x90\x70\x8c\x4d\xaf\xd1\xa4\x45\x86\x56\x8d\xfc\xaf\x76\x27\x07\x5a\xc9\xd7\x39\xfb\xef\x7f\xfe\xfd\xaf\xb6\xfc\x20\xc7\x5c\x6f\x94\x60\x96\x54\xc3\xdd\x5a\x17\x35\x2b\x06\x87\xa6\x3e\x83\x3a\x98\x7c\x0e\x1f\x3c\xa9\x88\x87\x8c\x29\xb5\xc5\xe4\x9d\x88\xea\xfd\xf2\xc6\x06\x57\xe7\x8c\xa7\x44\x4a\x11\xad\x3a\x63\x11\xb8\x52\x65\xe5\x2e\xc8\xea\x18\x86\x3a\xd0\x88\x03\x15\xab\xf1\xdb\x37\xf8\xa6\x53\x1a\x58\x79\x66\xa3\x43\xe8\x7c\xce\x12\x31\xed\xf8\x69\x30\xeb\xfb\x94\x2f\xfa\xfe\xf2\xba\xef\x5f\x67\x42\xfe\x08\xfa\x7e\x74\x7d\xd4\xf7\x67\xd7\xe3\x0e\x59\x6f\x22\x11\x26\xfa\xa9\x48\x8d\x4e\xa7\xec\xcb\xca\xb9\x42\x47\x45\x50\xeb\xc4\x86\x6f\x21\x5a\xa5\xa3\x20\xe4\x37\x4f\x04\x84\x95\xa2\xf4\x39\x7b\x2b\x68\x2a\xca\xb0\x5f\x19\xba\x3b\x23\x29\x6a\x1b\x97\x02\xee\x23\x23\xab\x32\x49\xc1\x25\x9b\x28\x22\x94\x64\x31\x4d\xb2\x15\x07\xc1\xa5\x7c\x0d\xf7\xe9\x26\x8e\xa1\x22\xc9\xfd\x36\xcd\xf0\x11\x4b\x40\xc8\x74\x1f\xa1\xca\xc0\x50\xf2\x95\xa7\x6b\xe9\x17\x52\x9e\xfb\x14\x24\xe9\xab\x20\x48\x3b\x04\x0a\x93\x39\x5b\xf1\x08\x12\x75\x48\x5b\x78\x26\x26\xf2\x98\xef\x0e\xf0\x09\xc0\xdd\x42\x95\xb1\x63\x89\xd7\xe5\xb0\xda\x32\xc5\x6c\xd2\xe3\x09\x46\x18\x1a\x1d\x74\x6c\xbe\x24\xe7\xd1\x1e\x09\x76\x53\xf4\xb8\x3c\xca\xfd\xec\x2d\x7c\x51\x3c\x95\x9c\x6c\xcd\x7f\x9d\x2a\x60\x94\x96\x9a\x76\xc1\xc3\xb7\xea\x99\x43\x39\x24\x32\x72\xc4\x4b\x28\xf0\x28\x46\x3d\x43\x5f\x46\x8f\x1f\x9e\x04\x63\xab\x67\x79\x0d\x22\xa7\x29\xa8\x81\xef\xfb\x7b\x94\x3b\x45\x19\xb9\x47\x1a\x54\x0c\xf5\x30\x1f\xd7\x6f\x3c\x8c\xfc\x09\x64\x89\xb5\x87\x34\x0e\xd7\x90\x9f\x06\x1d\x67\x80\x25\x20\x79\x56\xc1\x85\x49\x25\xa1\x79\xd7\x66\xf8\x40\xf1\xad\x18\xf2\x04\x86\x9c\xb9\xa8\xba\x73\x94\x85\x44\x30\x4c\x04\xc9\xd2\x79\x75\xdc\xfb\x5d\x36\x78\xf7\xcf\x0d\x4b\xaf\xe4\xe9\xee\x77\x19\x2e\xab\xc6\x9d\xb9\x27\x19\x47\xcc\xdf\x99\x27\xcc\x9d\x60\x2a\x1c\x07\x83\x3c\xd2\x2f\x36\xb1\xcc\x6e\xb3\xf2\x55\xf1\x84\x34\x32\x1f\x73\x63\x84\xcf\x37\x6b\x08\xe8\xfe\x92\x89\xe7\x11\xc3\xaf\x4f\xae\xbe\x09\x7a\x5d\x2d\x2f\xe8\x1e\xf8\x52\xac\xe8\x2d\x7d\x1a\xc0\x4b\xb5\xc7\xd2\xb5\xef\x74\x95\x6b\x37\x43\xfe\x2f\x5d\x3c\x85\x54\xf2\x92\xd9\xd6\xcf\xf9\xf1\x86\x65\x4c\x90\xfc\x18\x29\x7a\x8d\xda\x00\x15\xbb\x58\xa0\x82\xe5\x94\xfc\xf8\xd3\x97\xfb\xe1\x53\xc4\x0a\x40\x26\x8c\x63\x96\xbe\x38\x7f\xf9\x2d\xcc\xef\x76\xf7\x9c\x5f\x45\x0a\x80\x50\x76\xc9\xa6\x44\xa4\x1b\x76\x1b\x10\xa5\x3b\x00\x30\x52\xfb\xfd\xbc\xf9\x83\xc8\x60\xfb\xa7\x6b\x95\x08\x30\xe6\x5b\x64\x48\x5e\x37\x42\x52\x46\x12\x70\x15\x04\xb9\xd4\x14\x1b\x3e\xcd\xab\xd5\x86\xc4\x16\x4c\xcc\x57\xbd\xee\x20\x92\xcc\x68\xd8\x95\x2f\x56\x2c\xee\x81\xf5\x25\xa0\x8c\x8c\x4c\xcf\x48\xf1\x1d\xf4\x99\xc7\xbd\x03\xd7\x14\xac\x7f\x70\xf8\x07\xab\x03\xc0\x5f\xa3\x10\x44\xab\x7a\x81\x5c\x27\xa3\xb4\x61\xa6\x82\x14\x57\xb8\x20\x72\x45\x10\x26\x58\x63\x3c\x07\x65\xf8\xfc\x73\x52\x7f\xe2\x47\x2c\x5e\x8a\x15\x39\x23\x43\x93\x09\xfa\xa5\xad\xd6\xaa\x1a\xfa\x65\x2c\x04\x6a\xfa\x9c\x02\x57\x8b\x27\x6e\x3e\xd4\xf9\x01\x25\xbe\xc6\x06\x28\x50\xc1\x83\xe6\x9c\xe8\x75\xa3\xd0\x45\x7c\x71\x45\xa1\x32\xac\x57\x78\xb6\x0a\x10\xd6\x0f\x5c\xb5\x20\x9f\x4f\xd5\x69\xfd\xb9\x75\x30\x5e\x66\x4d\x25\x53\x31\xbe\xa3\xa6\x2a\x27\xeb\xc5\x45\xe3\x58\x58\x87\xc8\x4a\x77\xda\xf9\xec\x43\xc1\xc0\x9b\xce\x99\x76\x63\xab\x2e\xcc\xeb\xe7\x9d\xf4\xe6\x75\x0c\x50\x0b\x36\xb1\x53\x40\x78\x6d\xc3\x38\xe0\x5b\x3f\xe2\x73\x8a\x66\xe5\xe3\xaf\x08\x21\x9f\x07\x05\x66\x83\x2e\xf9\x23\x29\x6e\xda\x11\xb8\x69\x7f\xad\x2b\x21\x4d\xc0\xe3\x07\x4f\x57\x61\x14\xf4\xa2\xb0\x45\x07\x6e\x1c\xef\x6e\x1a\x4f\x6f\x2c\x56\x0b\x44\x81\xca\xb2\x34\x45\x66\xa0\x3e\x42\x78\xf6\xe1\x96\xa7\xbd\xee\x73\xfc\x90\x6e\x04\x33\xcc\x1c\xb9\x49\xb7\x4f\xe0\xfd\x81\xe3\x74\xc4\xa0\xa8\x5d\x0b\x37\x44\xa3\x50\x54\xdd\x32\xa5\xf1\x55\xb1\xd6\xe6\x00\xaa\x51\xba\x09\x28\x08\x65\x0d\xd2\x06\xa0\x1c\x64\x9f\xbf\xcb\x01\x55\xc1\xc2\x9c\x5d\x85\x81\xb6\xf9\x7a\xb0\xb0\x42\x28\xa3\xc0\x4e\x28\x5a\xbc\x30\x21\xd5\x92\xc6\x36\x40\xb5\x81\x4d\x38\x5a\xba\xd5\x06\x45\x1b\x86\x30\x4a\x20\x11\x84\x6b\x5b\x58\x2e\x07\x54\xd2\xc4\xd4\xe3\xf9\x25\x40\x44\xe6\x32\x70\x3f\xbd\xae\xb4\x49\x50\x2c\x9b\x4d\x96\x52\xf4\xe5\xa8\x9e\xae\x78\x3a\x06\xd5\x38\x0b\xfc\x15\x8d\x81\x68\x58\x80\x59\x56\x80\x97\x41\xc4\x24\xd6\x3d\xe6\x0b\xec\x39\x09\xb9\xc5\x9a\xb9\xd6\x6a\x25\xa6\xd8\xda\x76\x2d\xc7\xfc\x24\x65\x38\xe5\x19\x5b\x50\xa8\x80\x7b\x86\x01\x6b\xc0\x8d\x74\xad\x84\x7c\x67\xbc\x22\x46\x2f\x99\x83\xd1\xd6\x75\x8b\x4c\xed\x97\x2e\xcd\x93\x7b\x65\x47\x1b\x5a\x4d\x91\x62\xa0\x3e\x97\xa7\x02\x58\xda\x2e\xd8\x32\x79\xd2\x01\xa8\x19\x06\xda\xa6\xb2\x7f\x95\xa6\xf4\xca\xc7\x0a\x32\x1f\x8f\x0b\x09\x20\x1e\xef\x80\xea\x86\xeb\x1d\xfc\xc3\xef\xa5\xc1\xec\x23\xe5\x8b\x8f\xcb\xeb\x8f\xd7\x99\xc0\xff\xc1\xc7\xe8\xfa\xe8\xe3\xec\x7a\x7c\xf0\xd9\x20\xf4\x05\xcb\x84\x04\xe0\x63\x88\xac\xbb\x6f\x1d\x6d\xbc\x30\x19\xaa\x21\x55\x64\x3e\xd3\xe9\xd4\x9e\xfb\xd0\x88\xa5\x90\x62\xbc\x8e\xf0\xe0\x49\xd1\x10\xba\xa4\xb8\x7b\x8a\xdd\x1b\xd5\x1b\xb2\xa5\x1f\x29\x13\x9b\xd4\xd8\xb5\xbf\xa9\x63\x93\xe7\xb3\x8a\x81\x06\x88\xca\x31\xea\x39\xf4\x82\x46\x19\xb3\x06\x95\x52\x28\x75\xa0\x16\x3f\xb1\x33\x7d\xab\x33\xa8\xc8\xd8\x24\x8b\xf1\xd7\x92\x03\xf6\xde\x91\x15\x68\x61\x07\x8f\x5d\xb8\x13\x36\xa8\x31\x6d\x2c\x2b\x66\xd6\x73\xb5\xf2\x1c\x87\x25\x51\x2b\x67\xec\x4e\xd1\x4e\x9d\xad\xf5\xe2\x6d\xad\x93\xa9\x37\x0f\x8c\x36\xec\x67\x1f\x4a\x6d\xbb\x69\xe9\xd8\x9b\x60\x77\xf4\x1d\x01\x2a\x94\x70\x14\x42\x88\xc8\xcd\xc9\xc7\xf7\x07\x6d\x4b\xdc\x6a\xbf\x40\x36\x76\xb2\xb5\xfc\x08\xd0\xd5\xa7\x5a\xc7\x5a\xf9\x0a\x94\x78\x0f\xd3\x49\x90\xf1\xcd\x01\xee\xf3\xe2\x53\x77\x03\xdf\x92\x4a\x96\x1a\xa6\xe7\x66\x85\x98\x0c\x99\xdf\xec\xa8\xa9\x35\xa4\x72\xb5\x6b\x71\x2f\x7e\x86\x1d\xbd\x7c\x64\x9f\x8c\x8c\xb5\xda\x6c\xed\x0e\x5e\xc1\x6a\x9d\xcd\x0a\xf7\xa6\x95\x3e\x5d\xe0\x33\xfc\x69\x2e\x85\x88\xc9\x17\x05\x26\xca\xa9\x90\xee\x90\xc8\x59\x86\x45\x28\xfb\xc3\xbc\x7d\x34\x1c\x1f\xd9\xde\xa1\x46\xc9\xac\xa3\xab\xe6\xf7\x49\xf7\x2f\x4f\xf0\xe7\x4b\xf9\xf3\x4f\x4f\xba\x3f\xd9\xa6\x61\xf1\xf5\x92\x8a\x95\xbf\x88\x38\x24\xbd\xf2\x6b\xc4\x97\x05\xd2\x03\x52\x3e\xb9\x38\x30\x78\x9b\x63\x2c\x07\xc8\x93\x1f\x39\x41\xf9\x9c\x84\x6f\x7b\x17\xe0\x55\x0e\xc8\x17\x78\x56\x07\x61\xc1\x07\xd4\x0a\x5d\x82\x15\x83\xc4\xf7\xc7\xf0\x27\xab\x9e\x68\x42\xb8\x5d\xb6\xb4\x4b\xde\x85\xeb\xde\xc3\x21\x37\x45\x5e\xcf\x45\x9b\x9d\x8b\x19\x14\x49\x17\xa6\xc7\xd5\xd3\x4e\x1f\x1b\xce\xc5\x9e\x2f\x4c\x50\xb5\x02\x56\x17\x32\xd6\xf8\xbe\x6f\xcc\xd6\xd2\xcd\x7c\x39\xd9\x25\xc4\xb9\xc7\x0f\xba\x06\x19\xb9\x8f\x06\xcd\x7b\x26\xdb\x10\x24\x66\x5b\xf2\x75\x7e\x6b\x1a\x86\x3d\x12\xe4\xc1\xda\x62\x16\x05\xd8\xdc\xf8\x55\x79\x80\x5a\x86\x9f\x16\xe3\xb7\x60\xf6\x7e\x95\xe6\x48\xfd\xfd\xe5\xb7\x2f\x84\x48\xde\xb0\x7f\x6e\x30\xc2\x9b\xc3\x61\x60\x7e\x34\xd1\x22\xfd\x82\x25\xae\x7c\xaa\xd0\x02\x96\x4b\xfe\x29\x5f\x43\x52\x8c\x42\x75\xf5\x3f\xf2\x02\x80\xa5\x78\xd8\x0e\x87\x43\x32\x8f\xe1\x09\x41\x00\x0e\xa0\x0c\x03\xc8\xd2\x04\x17\x34\xca\x75\xd9\x5e\x71\xea\xc5\xdd\x6b\x05\xad\x30\xae\x75\x18\xf7\xcc\x05\xbe\x20\x43\xff\x61\x9f\x3c\x1c\x3a\x0a\x58\xb7\xec\xeb\x4b\x80\x41\x3d\x70\x34\x3a\xda\x94\xef\xe7\xa6\xf2\x91\xcf\x3e\x68\xc6\x6c\xe0\x7b\x70\xf3\xc0\x12\x13\x6e\xda\x05\x8f\x92\x6c\x8a\x10\xd7\x75\xd8\x6f\x21\x3d\x9c\x98\x1f\x50\x43\xcb\x1d\x0f\x9d\xdd\x2b\x91\x9a\x7f\x47\xa4\x29\x13\x60\x23\x64\xd6\x40\xf4\x9f\xdf\x7e\xf7\xca\x4f\xf0\xaf\xb8\xc8\x15\x8a\xae\xde\x39\x70\xc6\x44\xdd\xc4\x48\xc1\xf0\xb3\xcd\x7c\x0e\xfc\x6c\xeb\xa5\xe1\xb5\x87\xd9\x93\x1c\xd4\x62\x13\xf5\x09\xe2\xa4\x36\x30\x76\xf4\xac\x5a\x3c\x02\x1e\x88\x34\x7d\x42\x03\x2f\x26\xce\xc3\x35\xe3\x1b\xd1\xdb\xb7\x01\xa4\x11\x5f\xeb\x33\x9a\xcf\xf6\xec\x34\xea\xd7\x7e\xbd\x25\x73\xa1\x1f\x87\x3f\xb5\xf3\x08\xaf\x1b\xc2\xa2\xc6\xef\x02\xdc\x1a\x8f\x1d\xc2\x90\x0b\xb5\x77\xbc\xfa\xe8\x2f\x5c\x26\xbe\x37\xa2\x62\x95\xf2\xad\x74\x9d\xb2\x29\x55\xc8\x63\x9d\xb7\x3d\x3e\x7e\x2c\x95\x6a\x41\xc1\x98\x83\xb6\xa6\xa9\x1d\xe1\x1b\x22\x5b\x61\xa4\x27\x9b\x5f\x6d\x02\xdc\x47\xb5\x15\x16\x13\x19\xe8\x25\xc0\x02\x55\x37\x5a\x2d\x8a\x3d\x74\xf9\x37\xbc\xf6\xaa\xa6\xda\xe9\x6f\x15\xc1\x6d\xc8\x7d\x71\x7e\xfe\x5a\xd2\x5c\xb9\xaf\xdb\xfa\x76\x37\xb1\xb7\x22\xf4\x4e\x7e\x59\x4a\xaa\xc5\x31\xdf\x86\x15\xaf\x98\xd8\xf2\xf4\x42\x49\xdf\x42\xd1\x6d\x39\xb0\x37\xf5\x56\x5a\x85\xf2\x79\x30\xe3\x70\x08\x81\xf7\x64\xa8\xe2\xb8\x11\xc8\x71\x24\x6e\x34\xf6\xba\xaf\xbf\x7b\x7b\x8e\x59\xf3\x80\x26\xe1\x40\x85\x5b\xb8\xc5\x8c\xf0\xa0\x39\x25\xc3\x7c\xa8\xc8\x8f\x5c\x9d\x95\x3c\x49\x57\xfb\xed\xad\x7d\x4a\x35\x44\xb7\xe0\xfc\xd1\x2d\x53\xe1\xbc\xd3\x1a\x04\xad\x0d\xcd\xe2\x8c\x43\xf7\xc0\xbf\xa4\xd1\x06\xc4\x9a\x86\x6b\x5b\x15\xf5\x09\x42\x72\x24\xd0\x05\x82\xbf\x6d\xf6\x0c\x5f\x63\x3c\x0e\x09\x19\x8c\xe0\xd2\xec\x24\xb1\x90\x0f\xfd\x96\x89\x74\x23\x31\x46\x24\x40\x24\x92\x5d\x3b\x86\x16\x47\x3c\x60\x78\xab\x80\x8a\x13\x22\x85\x90\x4c\x15\x2f\xb6\x33\x51\x5b\x71\x3c\xc0\xfb\x40\xd6\x4c\xac\x38\xfa\xe0\x5c\x99\xf1\xe8\xfa\xa4\xa2\xca\xb6\x1b\x72\xd7\x6d\xcf\x22\xbf\x72\xa5\x13\x52\x7f\xf6\x4f\xa0\xf6\x88\x76\xea\xbc\x4a\x6b\xac\xb3\xc7\xb9\x7b\x4c\x39\x5a\xf7\x93\x78\xea\x66\x47\xab\x56\x6b\x94\xed\x1d\x3f\xef\x12\x4e\x2c\x36\xeb\x8a\x25\x2e\x9f\x66\xee\xc7\x6f\x41\x17\xaa\x1d\xf9\xea\x14\x47\x6d\x27\xbe\x3e\xff\x0d\x5b\x00\xe2\xab\x12\x04\xbb\x64\xf0\xf3\x18\xf2\x54\x30\x42\x0d\x04\xe4\xad\xdf\xe0\x6f\x42\x81\xfa\xf7\x34\x70\x7d\x72\x5c\x65\x57\xd5\x31\x93\xd3\x81\xfa\x3d\x8d\xd3\x81\xfa\xeb\x8e\xff\x03\xa3\x1f\x62\x67\xee\x51\x00\x00")
 
 func main_with_uploadHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -223,12 +223,12 @@ func main_with_uploadHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "main_with_upload.html", size: 19596, mode: os.FileMode(438), modTime: time.Unix(1765524000, 0)}
+	info := bindataFileInfo{name: "main_with_upload.html", size: 20974, mode: os.FileMode(438), modTime: time.Unix(1792191289, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _ops_dashboardHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\x6b\x6f\xdb\x38\xb6\xdf\x17\xd8\xff\xc0\xeb\xb6\x23\x1b\x8d\x64\x3b\x89\xb3\x8d\x63\x7b\xd1\xb4\xdd\xb6\x68\x3b\x5b\x6c\xb2\xf7\x7e\x28\x06\x09\x2d\x51\x16\xa7\x92\x28\x50\x74\x12\x4f\x90\xff\x7e\xc1\x87\x1e\x94\x48\xd9\xee\x23\x73\x2f\xb0\xb3\x40\xd7\x92\xce\x9b\xe7\x45\x1e\x66\x16\xe0\x1b\xe0\xc7\x30\xcf\xe7\x3d\x9f\xa4\x0c\xa5\xcc\xbd\xa5\x30\xcb\x10\xed\x81\x9c\x6d\x62\x34\xef\x25\x38\x75\x23\x84\x57\x11\x9b\x82\xf1\x68\x94\xdd\x9d\x81\xe2\x11\xae\x19\x39\x03\xe4\x06\xd1\x30\x26\xb7\x53\x10\xe1\x20\x40\x69\x6f\xf1\xd7\xbf\x00\x00\xc0\xec\xbf\x5c\x17\xbc\x43\x30\x66\x11\xb8\xf0\x09\x45\xe0\x15\xa4\x01\x70\xdd\xe2\xbb\xc6\x3c\x76\x93\xc0\x1d\x1f\x16\xc8\x4d\x80\x25\xb9\xeb\x01\x1c\xcc\x7b\x91\x20\x28\xe8\x9d\xf3\x77\x4a\xca\x25\xa1\x01\xa2\x2e\x23\xd9\x14\x1c\x65\x77\x20\x27\x31\x0e\xc0\x93\xd1\x08\x9e\x4c\xe0\x59\x9d\xaa\x81\xb2\x1b\x21\x18\x20\x0a\x6e\x31\x8b\x5c\x49\xa9\x89\x21\xb0\xa2\xa3\x3a\x12\xc3\x2c\x46\x26\x38\x01\x8b\x0b\xd0\x10\x82\x10\x72\x0e\x94\x2d\x11\x64\xbd\xc5\x6c\x88\x17\xe0\x62\x93\x33\x94\x68\xe6\x31\x30\x1c\x46\x47\x26\x39\x1a\xd2\x33\x42\xe2\x1c\x64\xeb\x38\x76\x29\x5f\x18\xab\x4c\xcb\x35\x63\x24\x05\x6c\x93\x71\x8b\x89\x87\x5e\x49\x88\xa5\x60\xc9\x52\xb7\x20\xd8\x03\x01\x64\xd0\xbd\xc5\xc1\x0a\x31\xb1\x40\x31\xcc\x72\xab\xbe\x26\x9d\x13\x9c\xae\x73\xa9\xaf\x45\xa0\xa1\x14\xc2\xa4\xe3\x30\xc0\x37\xcd\x55\x33\xbd\x6b\xd8\x62\x49\x82\x8d\x71\xed\x6a\x70\x94\xdc\x5a\x4d\xd4\xf6\xc9\x13\xc0\xd0\x1d\x73\x7d\x94\x32\xb3\x5b\x68\xb8\x0d\x17\x7d\x0b\xd7\x2b\x54\x3a\x69\x11\x37\x87\x13\x1e\x46\xdc\x32\x6d\x85\xba\x94\xed\x92\x72\x1f\xc9\xd6\x49\x02\xe9\xa6\x14\x2b\x83\x41\x80\xd3\xd5\x14\x1c\x4a\xb1\xec\x84\x04\xb1\xe8\x78\x51\xb8\xad\x24\x34\x1b\x46\xc7\xdb\x90\x74\x09\x5e\x23\x06\x71\x9c\xf7\x16\x1f\x09\xe4\xac\x3d\xcf\xeb\xd2\x77\xab\x3d\x2c\xdf\x76\x71\xa2\xfa\x73\xf1\xbb\x96\xbf\x5e\xa6\x24\x81\x31\x46\x39\xb8\x40\x3e\xc3\x24\xfd\xf6\xfc\x05\xb8\x7f\x06\x30\x5d\xb5\xdd\xe8\xf1\xf3\x11\xba\xf3\x63\x98\x40\xae\x91\xcb\x28\x86\xe9\x8a\xe3\x8a\xd4\xf4\x1a\x31\xe4\x33\x14\x80\xf7\x79\xbe\x46\x39\xf8\xa5\x32\xc2\xee\x29\x2a\xcf\x60\x5a\x70\x8c\xe1\x12\xc5\x40\xfc\xab\xf4\xaf\x27\x2b\xe1\x16\x50\x70\xd8\xbc\x22\xeb\x94\xf5\x16\xa3\xd9\x90\xe3\xff\xa4\xf0\x4f\xe1\x8d\xcb\xe0\x32\x77\xfd\x75\xce\x48\x62\xb5\xd8\x3a\xae\x61\x80\x02\xab\x33\xce\xe2\xd2\xca\xd0\x67\xf8\x86\x5b\x14\x82\x88\xa2\x70\xde\x7b\xc2\xe0\xd2\xf5\x29\x66\xd8\x87\x45\x72\x65\x64\xb5\xe2\x01\xc8\xe0\x72\x6b\xd4\x75\x1b\x54\x5a\xb1\x20\xdf\x30\x23\x78\xa5\xde\x77\x45\x17\x5c\xcc\x86\xb1\x2d\x51\x2b\xe5\x1a\xda\xdc\x42\x9a\xe2\x74\x95\xff\x40\x6d\x14\x49\xa9\x8e\x7a\x68\x6a\xf3\x3f\x8a\xed\x0f\xd6\x06\xa7\x21\xf9\x81\x9a\x48\x72\x5c\x0d\xfe\xab\xa9\xc3\xfb\x34\x24\xdf\x2c\xff\x6c\xb8\x8e\x77\xa8\x0c\xc2\xe1\x64\x53\xb7\xb5\x38\xd4\x50\x32\x98\x22\xa0\xdc\x57\xc8\xaf\x39\xee\xae\x79\xbe\x40\x28\x53\x47\x77\xb5\x03\x5b\x32\xbc\x4d\xd0\x4a\xc2\xd2\x19\x77\x95\x50\x21\x3c\x9a\x80\xc2\x21\x76\x15\x8e\x03\xff\x20\xc9\x7e\x62\x71\xfc\x84\x12\x42\x37\xe0\x1d\x61\x79\x46\x58\xfe\x9d\xa5\xb1\x88\xfd\x3f\xbd\x36\x86\x98\x16\xb5\xb0\xa1\xe1\x4e\xf5\xef\xbb\xeb\x14\x77\x80\x44\x30\x2e\xf8\xbe\x8a\x20\x65\xad\x36\xf2\x78\xd4\xdd\x46\x36\xfc\x31\x46\x2e\x45\x79\x46\xd2\x5c\x84\x76\xb1\xbd\x83\x74\x85\x53\xb9\x71\xea\xec\xff\x66\x82\x84\x46\x0f\x48\xaa\x3e\x49\x03\x94\xe6\x28\x50\xcf\x11\xdf\x11\x76\xa6\x1c\xc6\x17\x71\x5b\x2c\x30\xba\x05\x42\x91\x5a\x5c\x6e\x32\x34\x1b\xb2\x68\x47\xf0\xf7\x01\x4a\x19\x0e\x31\xa2\x7b\x20\x29\x47\xf8\x77\x8e\x82\x3d\xb0\x3e\xa0\x0d\x10\xa9\x7f\x0f\x9c\xcf\x88\xf2\xbd\x06\x5c\xed\xa3\xd3\xcb\x9b\x15\xb8\xc0\x7f\xec\x82\x32\x1b\x76\x1a\x96\x53\xe8\x5e\x9c\x19\xe3\xde\x2b\xbb\x79\xe5\x9f\x97\x7c\xdd\xcf\x85\x4f\xcf\x86\xe2\xb3\x35\x19\x09\x17\xf9\x39\xe9\xe8\x5f\xc8\x27\x49\x82\xd2\x40\xb4\xb5\xdf\x9b\x8e\xf2\xb5\xef\xa3\xbc\x55\x4f\x1e\x3f\x1d\xc5\x3c\xda\x97\xeb\x78\xe9\x12\x95\x95\xfe\x99\x31\x9c\xe0\x3f\x84\x9a\x4d\xad\xbf\xbf\x45\x57\x8a\xb7\x7a\x74\xaa\x31\xfa\xb9\xad\x7a\x9b\x5f\xfe\x11\xe7\xcc\x9c\xeb\xf6\x74\x13\x1e\x94\x9f\x21\x63\x88\xa6\x39\x78\x99\xc2\x78\x93\xe3\x6e\x67\x39\xd9\xe6\x2b\x19\xc5\x62\x47\xfd\xa7\xfb\xca\x57\xb4\x51\x3e\x72\x49\x32\x4d\xd3\xc7\x29\x5d\xb6\x6a\xf3\xff\xae\xa6\x28\xb3\xed\x91\x82\xf7\xcd\xf3\xb2\xa2\xec\x81\xf0\xec\x91\x93\xfb\x57\xb4\x29\x9c\xe7\xff\x46\x7e\xe7\x75\x1e\xbc\x09\x43\xec\x63\x94\xfa\x9b\xef\x0b\x59\x53\x3b\xfe\xf8\xf1\xca\xb7\x9b\x4b\x98\x97\x47\x2f\x90\xc1\xa6\x9a\xff\x09\xdc\x9f\xd9\x0c\xee\xd1\x38\x15\x28\x9f\x4f\x27\xfb\xa2\x54\xab\xf9\xc8\x31\xcc\x36\x19\xaa\x98\xff\x89\x61\x5c\x85\xf3\x4c\x6c\x3c\xd4\x67\x4f\x1d\xfc\xb9\x3e\xa4\x01\xb8\xaf\x68\xa8\x79\x4e\x8c\x42\xbe\xd1\x29\x06\x3a\x67\x15\x40\x79\x68\x3d\x9e\x64\x77\xb5\xf7\x6a\x3f\xb3\x24\x8c\x91\x64\x0a\xc6\x23\xed\xeb\x12\xfa\x5f\x57\x94\xac\xd3\x60\x0a\x9e\x84\xa7\xfc\x7f\x67\x2d\xa6\x14\x06\x78\x9d\x0b\xb6\xea\xe3\x83\x41\x5a\xaf\x38\xe2\xb0\x88\xed\xfa\x24\x26\x74\x0a\x9e\x04\xc1\xf1\xf2\xe8\xd4\x2a\x44\x18\x4e\xc2\x49\x17\x1f\xb5\x2f\xde\xc6\x26\x3c\x3a\xf5\xc7\x87\x1d\x6c\x96\xe1\xa8\x8b\x0d\x4f\x88\xdb\x78\x8c\x46\xfe\x08\x85\x56\x1e\xa3\xf0\x34\x0c\x2d\x3c\x44\x5a\xac\xd3\x0f\x49\xca\xdc\x1c\xff\x81\xa6\x60\x7c\xa2\x2d\x92\xf8\x72\xab\x36\xb9\x27\xa3\x91\x7d\x75\x27\xd6\x15\x4a\x10\x83\x36\x6e\x87\x1a\xb7\x42\xb7\x93\x93\x93\x9d\xdc\xa8\xc9\x09\x27\x19\xf4\x99\x66\xb9\x86\xe1\x8d\x5e\xdb\xf0\x4b\xdd\xf3\x8e\x4c\x2e\x2d\xb6\xe8\x66\x3c\x19\x26\xb5\xb9\xe7\x91\xff\x22\x58\xfa\x16\x89\xf3\xf5\x6a\x85\x72\xb1\x79\x78\x24\xa9\x27\x3b\x09\xad\x86\xb5\xa5\xd0\x4a\x6c\x7d\x1f\x60\xc9\x14\x53\x30\xae\x08\x05\xc1\x0f\xc8\x14\xb6\x5c\xd0\x61\xab\x87\x0e\x89\xbd\x8c\x62\x42\x31\xdb\x68\x5e\x19\x13\xc8\xa6\x40\x6c\xb2\x6a\xe4\x6f\x71\xc0\xa2\x29\x38\xd2\x25\x2a\x8e\x7d\x8e\x3a\x05\x9d\x8c\x9e\xd5\x3e\x8a\xd1\x25\x8c\xf1\x2a\x9d\x02\x39\xc1\xac\x7d\x8c\x71\x8a\x5c\x0b\x55\x2d\x06\x97\x24\x0e\xda\x11\x73\x1b\x61\x86\xf6\xd1\xdc\x1d\x83\x7b\xdd\x74\x2a\x35\xee\x88\x7e\xd8\x44\x57\x29\x6f\x47\xf4\xa3\x26\xba\xca\x66\x3b\xa2\x1f\xb7\xd1\x85\xbb\xee\x88\x3e\x69\xa2\x9f\x9e\x4a\xc5\x15\xf6\x12\x06\x2b\xe4\xa2\x30\x24\x94\xd9\x12\xd7\x58\x5b\xa2\xd2\xaf\x79\x08\xbd\x68\xe5\xa7\x3a\x41\x2f\x26\xb7\xdb\xc4\xd7\xe0\x13\x14\xe0\x75\xb2\xcd\xde\x1a\x4a\x84\x57\x51\xc7\xfa\x4a\x94\x27\xcd\xd9\x78\x5d\xd5\x8c\xe4\x98\x9b\x6e\x0a\x28\x8a\x21\xc3\x37\x68\x57\x4f\x56\xa6\x90\xc9\xe6\xb8\x9d\xab\x25\x57\x37\xe7\x6c\xdd\x1b\x18\xaf\x6d\x95\xe8\x6f\x87\x3b\x47\x81\x16\x3d\xe3\x2e\x86\xf2\x70\xc5\xb2\xa6\x2f\x76\x2f\x46\xcd\x02\xf0\xc0\xdb\xaa\xa2\x9b\xe2\x8d\x95\x4f\x71\xc6\x16\x7f\xfd\x4b\x3f\x5c\xa7\x62\x58\xdd\x1f\x14\x6c\x87\x43\xf0\x16\x31\x80\xd3\x9c\xc1\xd4\x47\x20\x85\x09\x02\x21\x25\x09\xf8\xf7\xbf\x3e\x4a\x10\x9f\xa4\x39\x03\x19\x64\xd1\x67\x48\x59\x0e\xe6\xe0\x16\xa7\x01\xb9\xf5\x62\xe2\x0b\x97\xf6\xf8\x37\x8e\xe8\xe5\x59\x8c\x59\xdf\x19\x3a\x83\xb3\x3a\x6e\x41\xfd\x57\x4e\x7c\x5e\x91\xfa\x52\xfe\xf2\x62\x94\xae\x58\x04\x5c\x30\xfe\xed\xac\x70\x8a\xe1\x10\x7c\x24\x30\x00\x24\xcb\x01\x2c\xce\x63\xf8\xce\x48\x7e\x0e\x11\xf3\xa3\xfe\xf5\x10\x66\x78\x48\xb2\x7c\x58\x80\x0c\x9f\xde\xd7\x19\x3e\x5c\x0f\x2a\x8b\x79\x2c\x42\x69\x5f\xed\x63\x10\x98\x2f\x40\xf1\xdb\xfb\x3d\xe7\x76\x69\xc1\x72\x7e\x1c\xee\x5e\xef\x6d\x29\x4a\x03\x44\xdf\x55\x5e\x2b\x00\xd5\xfa\x5e\x89\xf5\x3d\x10\xb2\x7a\x4b\x98\x63\xff\x2a\x67\x90\xe5\x83\x33\x13\x91\x72\x9e\x23\x49\xc0\xe2\xd1\x0c\xfd\x49\x1b\x00\x48\x14\x39\x14\xb8\x2a\x4e\x5d\xcd\x88\x8d\xf3\x40\x89\xd9\x38\x4b\x33\x63\x7e\xa8\x36\xfc\x12\xeb\x2b\xda\x5c\x65\xea\x8d\x19\xe5\x52\xdb\x5f\x48\x2c\xbe\xe7\xb8\x42\xe5\xcb\x3a\xe2\x43\xdd\xec\x3e\xe4\xeb\x8a\x28\x25\xd4\x60\x78\xee\x51\x24\x46\x9e\xf8\xde\x77\xde\x08\xb0\x58\x5e\x17\xd1\x3c\x65\xea\x1c\x00\x01\xa4\x33\x2a\xbd\xab\x88\x05\xc3\x52\xaa\xe5\x93\x6b\x56\x17\x40\xba\xf3\x8a\x27\xa8\xd7\xf8\x06\xcc\x41\x40\xfc\x75\x82\x52\xe6\xad\x10\x7b\x13\x23\xfe\xf3\x7c\xf3\x3e\xe8\x3b\xcd\x7c\xe6\x0c\xce\x9a\x64\x02\x79\xd9\x65\x27\x42\xea\x62\x8c\x81\xca\x92\xdc\xed\x2e\xca\x39\xb9\x73\x2a\x0b\xa8\x18\x7b\x8d\x18\xa2\x09\x4e\x91\xcc\x32\x00\xa6\x81\x50\x7d\x5d\x3b\x1b\x8c\x11\x93\x5f\x0f\xd4\xa7\x03\xd5\x5f\xbc\xe2\x2f\x6b\x42\xe1\x10\x48\xf3\x81\xc5\x1c\x9c\x8e\x06\xed\xe5\xe3\x2c\xe6\xc0\x51\x75\xc6\x39\x53\x04\xf9\xbb\x37\x77\x3e\x8a\x63\x94\x32\xe7\xac\x4e\x5e\x03\xaf\xad\x25\x40\x71\x8e\x74\x8e\x7f\x9b\x74\x72\xe4\x75\x5d\xe3\xf8\x96\x90\xc0\xc8\x4c\x42\x76\x33\x3b\xe9\x52\x4f\xd6\x44\x8d\xd9\x3f\x20\xa6\x06\x66\x05\x64\x37\xb3\xe3\x4e\x66\xe1\x8b\xc9\x78\xa9\x31\xfb\x4c\x88\x91\x99\x82\x6c\x31\xb3\xd2\x96\xb5\x5a\xa3\x5d\xdc\x1b\x31\xd0\x2f\xa0\x6b\xf4\xeb\xee\x26\xbd\xd5\x13\xc5\xc9\x93\xb8\x97\x24\x2b\xd0\x75\x97\xaa\xb0\x8a\x80\xf3\x70\x9a\x22\xfa\xee\xf2\xd3\x47\x30\x07\xd7\x8d\xc3\x86\xda\x69\x55\xbb\xac\x97\x63\x4d\x55\x48\x9f\xde\x8b\x1f\x0f\x67\xbd\xc5\xd3\x7b\x01\xf7\xb0\xed\xa8\xac\x5d\xba\x7b\x8b\xfa\x35\x4d\x2b\xbe\x61\xa0\x2a\x8a\x75\xbd\xdc\x1f\x56\x2f\xea\x1b\x5c\x60\x14\x57\xac\x43\x4b\xde\x6b\xcd\x64\x55\x72\xd9\x6a\x34\x5d\xbe\x72\xdb\x33\xb1\xcc\x7c\x67\x39\xa3\x24\x5d\x2d\x2e\x84\x18\x53\xde\x69\x88\x67\x35\x38\xb2\xd8\xb9\xad\x9b\xa6\xca\x3e\xb3\x22\x8b\xbc\xb6\x19\x75\x21\xef\x25\x61\x30\x06\x1f\xd0\xa6\x2e\xf3\xd3\xfb\x90\xd0\x04\xb2\x5f\xd7\xc9\x12\xd1\xbe\xc8\xf8\x1e\xe3\x90\x57\x5f\xd1\x26\x1f\x3c\x3c\x86\x4c\xb2\xa4\x1b\xa4\x3a\xdf\x30\x94\x6b\x42\x2d\xf9\x9b\x9f\x2d\xd5\xcb\x9b\x95\x18\x11\x5d\x70\xd7\xb4\x49\xf5\x09\xb2\xc8\x13\xfd\xbc\x12\x10\xde\xac\xb8\xcd\xae\xb8\x43\x0f\xb6\x89\x78\xdd\xdc\xcd\x37\xaa\x71\xd5\x13\x55\xed\x50\xbb\x10\x97\x27\x6d\x73\x50\x82\x79\x21\x8e\x19\xa2\x7d\xd1\xb4\x41\x2f\x46\x37\x28\x06\xf3\xf9\x1c\x38\x05\xb4\xa1\x88\x16\x97\x84\x76\x23\xa4\xa0\x0d\x74\xc4\x59\xd9\x4e\x34\x38\x64\xa3\x14\x5b\x0b\x78\xfd\x12\xa4\x33\xf0\xf8\x8e\xe7\x95\xbc\xbf\xa5\xf1\x92\x2d\xf4\xd9\x0e\x04\xb5\xfb\x80\x2d\x8a\xc5\xd7\x3d\x08\xd6\x6f\xe4\xb5\xe8\x15\xc6\xdd\x83\x5e\x79\x35\xae\x45\x8c\x7f\xa9\x08\x55\xa4\xea\x6e\xb3\xf9\x88\x73\x56\x69\x59\xfa\x92\x73\x50\xea\x56\x5f\x3a\x03\x6a\xf3\x0e\x98\x73\x50\x6a\xb1\x05\x53\xbb\xa0\xe5\x1c\x08\x79\x07\x3b\x39\xbb\x24\x80\xa4\x19\xde\x07\x07\xa0\xcb\xf3\x83\xce\x96\xaf\x24\x32\x68\x74\x66\x4d\x67\x11\xbe\xd8\x6e\x2c\x82\x46\xd5\x70\x66\x59\x91\x4b\x0c\xdb\xed\x72\x73\x2a\x4e\x2e\x9a\x97\xc6\x7f\x25\x00\xcb\xfb\xc2\x81\xba\x3f\x3c\x1b\x66\x0b\xa7\xb5\x6b\x60\x6b\x9a\xda\xba\x86\xa6\x3c\x95\x1a\x09\xcc\x64\x70\x75\x74\x03\xda\x28\xe1\xe9\xbd\x0a\xc3\x87\x6d\x23\x2f\xed\x80\x9a\x17\x2b\x94\xfb\x30\x43\xef\x58\x12\xf7\xa1\x27\xde\x0e\x8c\x4d\x83\x8d\x50\x82\x18\xb4\xce\xcd\x6c\x97\x2e\x4a\x79\x1b\x39\x0c\xfc\x1d\x38\xf2\x8a\xaf\x03\xa6\xe6\xf4\xc4\x41\xca\xdf\x53\x95\x72\x8c\x6a\x17\xff\x71\x66\x3e\x64\x68\x45\xe8\xe6\xc1\x22\xa8\xa9\x54\xd7\xf1\xe5\x29\xca\xdf\xc1\x75\x51\x4d\xfe\x9b\xbf\xd0\xca\x88\x66\x48\x01\x3f\x78\xb8\xe6\x12\x3a\x06\xa6\x5d\x16\x6e\x2e\x4a\x80\xe4\x51\x07\x26\xa9\x7d\x69\xb8\x90\xea\x6c\x9e\x4b\x69\x58\x28\xf9\xb5\xb7\x28\x34\x78\x2f\x9e\xed\x2a\x48\xf8\x82\xa3\x55\x13\xce\xb8\x76\xc4\x6e\x61\x5e\x41\x54\x02\x5c\x94\xef\xec\x42\x54\x78\x9d\x82\xb4\x0a\xf1\xc0\xfb\x9d\xe0\xb4\xef\x38\xdb\xb2\x54\xe3\xe0\xa1\x3c\x6c\x68\x27\x27\x39\x66\xec\xda\x91\x36\xaf\x87\x19\x4a\x29\x23\x59\x79\x7f\x74\x0e\x0a\x0c\x2f\x8f\xb1\x8f\xfa\xa3\x03\x70\x38\xd2\xab\xa7\x60\xaa\x65\x89\x1a\x05\x91\x27\x22\x53\x9e\x30\x8e\x54\x67\x2c\x58\x74\x44\x64\x24\x8e\x32\x64\xb0\x05\x90\xc1\x2b\xfe\x28\xc2\x4d\xdd\xf7\x11\xe1\x16\xa0\x10\xae\x63\xc6\x23\xae\x40\x29\x1a\xdd\xd9\x90\x99\xe6\xb4\x82\xad\x4f\x02\xa4\xfb\x75\xe4\xe1\xf2\x66\x22\x5f\x5b\x01\xd1\x45\x42\x79\x88\xde\xaf\x45\xc5\x29\xd1\x3a\x47\x01\x27\xa3\xa0\x3a\x08\x35\x9a\xe3\x48\x9c\xfb\xf8\xbc\x2c\x73\x7c\x2b\x9a\x25\x71\xd4\x7c\x3d\xa3\x64\x45\xc5\x55\x32\xf5\xc3\xbd\xeb\xfe\xfb\x09\x03\xae\xbb\x84\x14\x70\xc3\x66\xe5\xbd\x48\xb0\x00\x47\x23\xb9\x0c\x15\x8c\x5b\x65\xc9\x06\xec\x78\xd2\x82\xad\xa7\x4b\xed\x83\xba\xfb\xe6\x3c\xf4\xec\x52\x8a\xff\x54\x99\x54\x93\x9a\xa7\xf7\xa2\x4d\x4e\x70\xda\xaf\x33\x3f\x00\xe3\xd1\x68\xf0\xf0\xec\x3b\xfe\xd2\x2b\x4f\x60\x1c\x2f\x74\xfd\x3d\x46\xfe\x81\xef\x50\xd0\x1f\x0f\x1e\x9e\xcd\x86\x12\xc4\x94\x51\xb7\xae\x78\xe1\x32\x5a\x4f\x6f\x5a\xf3\xc6\x9d\x84\xdd\xb3\x49\xf3\x34\xb2\x79\x10\xb9\x6f\xcb\xe3\x18\x6e\x05\x6a\x59\x65\x47\x44\x73\xd7\xd9\x20\x6e\x6a\x40\x79\x7b\x65\x06\x7b\x9c\x26\xab\xc1\x1b\x40\x06\x58\x84\x73\xc0\x70\x82\xbe\xbf\xe3\x6a\x6a\xc6\xf3\x29\xdd\xd2\x77\x19\xe6\x5e\xdb\x7a\xae\x72\x16\x5a\x8e\xc7\x9e\xde\xd3\x72\x56\x26\x32\x69\xed\xd1\xda\x1a\x44\xc7\xa6\x43\x17\x79\xe4\x50\x4b\xac\xb4\xea\xe2\x8c\x7f\xa8\xf8\x2d\x7b\x68\xd0\xd5\xcc\x95\xd7\x41\xb9\x1a\x65\xa3\xd5\xd9\x52\x69\xb4\xc4\x5c\x0d\x68\x13\x41\x4e\x49\xfe\x54\xd6\x51\x0f\x40\xfe\xbf\x95\xb6\xd5\x76\x59\xd3\x46\x8d\xa6\x2a\xdb\x62\x28\x7d\x30\x38\x11\x97\x58\x1a\x17\x05\x4c\xf7\x03\xda\xb3\x2c\xbb\x75\xd5\x29\x85\xdf\xd5\x10\x51\x0f\xfa\x52\xe4\xdd\x75\xe7\xe6\x6b\x74\x86\xb6\xb3\xbb\xaa\x37\x7b\x73\x97\xa9\xbf\x8a\xec\xec\x12\xe9\x2e\x5d\xe2\xb7\x37\x67\xf5\x11\x4d\x39\x99\xf9\x86\xce\xcc\x74\xb7\xd3\xdc\x9c\x95\x37\xa5\xc5\x3c\x4f\xfc\xac\x9a\xb3\xf1\x64\x87\xe6\xac\xa0\x20\x92\x49\xb6\x5f\x73\xd6\xee\x92\x32\x4f\x89\xb1\x4b\x8b\xd4\xe8\x6c\x32\x6f\x7b\x57\xa3\x97\xc6\x4c\x9d\xc7\xc9\x9e\x6a\x0b\x62\x66\xaf\xd1\x3f\xae\xa0\x36\x26\x6e\xb5\x39\xdb\x37\xb8\x81\xe5\x7a\x60\xe3\xc0\x4a\x11\xdb\x64\x88\x7b\xc1\x3f\x97\xbf\x23\x9f\xf1\x1e\x31\xef\x9b\xa7\x7c\x06\x2f\xe0\xb8\x62\xfd\x65\x47\x6d\x9c\xf6\x31\x9e\xcb\xc0\x1c\x54\x44\xbf\x70\xe8\xdf\xce\x2c\xa0\xc5\x34\x01\x85\xa1\x57\xe1\x88\xe9\x90\xe8\x0e\x8b\x51\x12\x98\x1a\x40\x26\x12\x44\xcd\x64\x78\x90\xd6\x46\x1a\xa6\x0a\xda\x74\x5a\xbb\xe3\x82\x56\x7f\x5e\xec\x09\x3a\x3b\x71\xb3\x03\x72\xc9\x79\x77\x66\xed\xcc\xba\x51\xb3\xd3\xc9\x6e\xa8\x1d\x4d\xb9\xf1\xb8\xbf\xb0\xbf\xed\xc4\x5f\xb7\x78\xb3\x63\xed\xa8\x83\x96\x00\x6b\xdf\x89\xbd\xd6\xc6\xbd\xd6\xf0\x19\x0e\xc1\x3b\x14\x67\x88\x96\x81\x94\x37\xe2\xaa\x6e\x33\x79\xee\xde\x8e\xa4\x75\x8a\xc5\xfe\xf4\x8b\x73\xee\x1c\x00\xe7\x83\xf8\xf7\x93\xf8\xf7\xad\xf8\xf7\xf2\xdc\xa9\x3b\x6a\x8c\x18\xc0\x60\x0e\xea\x77\x17\x6f\x23\x1c\x23\x20\x59\x70\x17\x1c\x8f\x0e\x8f\xc1\x2f\xbf\x00\x0c\x66\x92\x7e\xed\x72\x44\xab\x8b\x94\x58\x43\x89\xd5\x88\x08\xfc\xfc\xb9\xde\xdf\x15\x3f\x95\xe7\x0a\xdc\xda\x12\x80\xe7\xc0\x01\x0e\x78\x2e\xb9\x7e\xc1\xbf\x59\x53\x8e\x96\x3d\xd3\x75\xa2\x89\xc5\x3b\xe1\x74\x9d\x80\x19\xdf\xe5\x8c\x06\x05\xb7\x74\x9d\x78\x8c\x5c\x30\x8a\xd3\x55\xbf\x79\x30\x59\xc1\xd7\x51\xc4\xeb\xa1\x24\xd3\x90\xf3\x83\xd3\x45\xc1\x42\xc4\x40\xe7\x93\x73\xd6\xb2\x8b\x8e\x61\x40\x3a\x77\xac\x96\xa9\x55\x24\xde\xc5\x6f\xdf\xc7\xf8\x14\x41\x86\x54\xf6\xed\x3b\x01\xbe\xd1\xb7\x2d\xf8\xa6\xb1\x17\xe1\x4f\x6d\x99\xb5\x86\xbd\x92\xee\x61\x20\x4c\x3d\x1b\x96\x77\x80\xfe\x37\x00\x00\xff\xff\x14\xa4\xcb\xb8\xdb\x47\x00\x00")
+var _ops_dashboardHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x1b\xdb\x72\xdb\x36\xf6\x3d\x5f\x81\x55\xdc\x4a\x6a\x4c\x49\xbe\xc8\x8d\x6f\xea\xd4\x69\x9a\x64\x92\xb4\x99\xc6\xdd\x7d\xc8\x64\x1c\x88\x84\x24\xb6\x14\xc9\x21\x21\xdb\xaa\xc7\xff\xbe\x07\x37\x12\x00\x41\x8a\x4e\x62\x67\x1f\xd6\x99\xba\x24\x71\x6e\x38\x38\x37\xe0\xc0\x27\x41\x78\x89\xfc\x08\xe7\xf9\x69\xc7\x4f\x62\x4a\x62\xea\x5d\x65\x38\x4d\x49\xd6\x41\x39\x5d\x47\xe4\xb4\xb3\x0c\x63\x6f\x41\xc2\xf9\x82\x1e\xa1\x9d\xd1\x28\xbd\x3e\x46\xea\x15\xaf\x68\x72\x8c\x92\x4b\x92\xcd\xa2\xe4\xea\x08\x5d\x86\x79\x38\x8d\xc8\x31\xba\x0a\x03\xba\xe0\xe0\xdf\x1d\x77\x26\x8f\x10\xfc\x9c\xfc\xcb\xf3\xd0\x4b\x82\x23\xba\x40\xef\xfd\x24\x23\xe8\x19\xce\x02\xe4\x79\x72\xd8\x90\x24\xf2\x96\x81\xb7\xb3\x5b\xc8\xa0\xd3\x43\x29\x0e\x82\x30\x9e\x7b\x11\x99\x81\x0c\xa3\xf2\x43\x26\xa4\x1a\x29\x96\x36\xdd\x69\x72\xdd\x41\x61\x70\xda\x59\x70\x31\xb8\x14\x67\xec\x9b\xe4\x32\x4d\xb2\x80\x64\x1e\x4d\xd2\x23\xb4\x97\x5e\xa3\x3c\x89\xc2\x00\x3d\x1e\x8d\xf0\xc1\x18\xeb\x44\x1d\x84\x41\x45\x18\x90\x61\xe6\x74\xe1\x09\x42\x16\x02\x47\x5a\xec\xe9\x38\x34\xa4\x11\x71\x80\x71\xd0\x50\x41\xce\x30\x9a\x61\x46\x3f\xa3\x53\x82\x69\x67\x72\x32\x0c\x27\xe8\xfd\x3a\xa7\x64\x69\x68\xb4\xca\x6e\xb8\xd8\x73\x08\x61\x49\x4e\x93\x24\xca\x51\xba\x8a\x22\xa1\xc1\x3a\x81\xa6\x2b\x4a\x93\x18\xd1\x75\xca\x74\xc5\x5f\x3a\x05\x1d\x1a\x23\xf8\xcf\x53\xf4\x3a\x28\xc0\x14\x7b\xb0\x6e\x73\x42\xf9\x8a\x46\x38\xcd\xeb\xe6\xea\x9a\x2f\x98\xdd\x2a\x17\x73\x75\x8b\x33\x14\x22\x38\xe6\x37\x84\x09\x5a\x8b\xe5\xf8\x64\x69\x61\x9a\x04\x6b\xd7\x8a\x69\x60\x59\x72\x55\xa7\x9b\xaa\xf5\x1e\x20\x4a\xae\xa9\xe7\x83\x4b\x39\x4d\xc1\x40\xb5\x8c\xf2\x05\x5e\xcd\x49\x61\x96\xca\xdb\x76\xc7\xcc\xf9\x98\x4a\x2a\x93\x69\x98\x67\x93\x88\x77\x10\x6b\xb5\x5c\xe2\x6c\x5d\xc8\x24\x5d\x0e\x84\x12\x32\xd5\xd2\xe1\xb4\x16\xfb\x13\x65\xa7\x82\x0e\x18\xe6\xfe\x06\x1c\x93\xff\x2f\x84\xe2\x30\x02\x7b\x78\x93\x60\xc6\x78\x30\x18\x34\xcc\x75\x93\x2a\xdc\x43\x9b\xed\x46\x7b\x95\x8f\x65\x68\xfb\x39\x4e\x96\x38\x0a\x49\x8e\xde\x13\x9f\x86\xe0\x28\xf7\x12\xda\x80\x10\x04\x82\x23\x34\x4d\xe8\xa2\x21\xce\x21\x66\xd2\x01\x8e\xe7\x15\xdb\x7b\xe8\xb8\x45\xae\xe1\x75\x89\x99\x46\x3c\x9a\x85\x20\x12\x43\xe5\x21\x0c\x16\x15\x54\x45\x02\xf4\x2a\xcf\x57\xa0\xb8\xef\x4b\x25\xb6\x0d\x65\x79\x8a\x63\xc5\x2f\xc2\x53\x12\x21\xfe\x5b\x4e\x5d\x0f\x6a\xdc\x98\x30\xa7\xbf\x7e\x96\xac\x62\x08\x73\xa3\x93\x21\xc3\xbf\x8f\x50\x11\xe3\x4b\x8f\xe2\x69\xee\xf9\xab\x9c\x26\xcb\x3a\x5d\xad\x22\x0d\x01\x29\xa4\x26\xb7\x8c\x0a\xf5\x62\xb0\xb2\x4b\xa6\x4a\x8c\x16\x19\x99\x9d\x76\x1e\x03\xae\xe7\x67\x21\x0d\x7d\xac\x02\x30\x4d\xe6\x73\x66\x65\x30\xb4\xc9\x49\x9b\x55\x29\xf4\xa7\xa8\x5b\x0a\x44\xcf\xe4\xf7\x06\x6f\xc4\xb0\xe6\x51\x4d\x2c\x97\x33\xb3\xa6\x72\x85\xb3\x18\x6c\x3f\xff\x7a\x53\x91\x14\xc5\x5c\xe4\x8b\x3d\x95\xff\x48\xae\x5f\x75\x2a\x61\x3c\x4b\xbe\xde\x34\x04\x35\x36\x07\xf6\x64\x4f\xe0\x15\x7c\xfb\x4c\xe1\x4f\x86\xab\x68\x73\xf6\xe0\x66\x26\x8a\xc5\x4d\x09\x44\xc3\x00\xd9\x08\x92\x36\xcb\x65\x37\xac\xb5\x65\x32\x50\xf0\x45\x9c\x68\xcc\x86\x62\x4a\xcd\xc3\x0e\x29\x4b\xf1\x0a\x0b\x6c\x29\x9e\x84\x7f\x20\xe9\xb8\x19\xb4\x94\x8c\xc1\x7e\x15\xb1\xee\x25\x77\xbe\x25\xcb\x24\x5b\xa3\x97\x09\xcd\x53\xf8\xef\xdb\x67\x4e\x15\x28\xbe\x6d\xea\x9c\x85\x99\x4a\x95\x96\x86\x5a\xa4\xc7\xaf\x93\xc7\xc0\xce\x22\xe2\x65\x04\xb8\xc6\x39\x4f\x37\x6e\xc9\x39\x9c\x81\x84\x04\x2a\x04\x8a\x80\xc4\x39\x24\x79\xf1\xbe\x60\xbb\xc5\xa6\xb0\x41\x99\x56\x37\x58\x35\xcd\x9a\x01\x24\xa1\xc9\x39\x6c\x55\x4e\x86\xf0\xd0\x0a\xfa\x15\x08\x4a\xc3\x59\x48\xb2\xf6\x38\x72\x5d\xfe\x84\x09\xb6\x47\x7a\x4d\xd6\x88\x47\xed\xf6\x28\xef\x48\xc6\x76\x12\x78\x7e\x87\xf9\xfc\x7c\x39\x47\xef\xc3\x7f\x5a\x60\x00\x44\x83\x46\x19\x7e\xe3\x9a\x9c\x50\x66\x49\xa2\x5a\x97\x06\x7a\xce\x16\xfb\x8c\xdb\x17\xa0\xb3\xe1\xba\x70\xc2\xcd\xa2\x55\x40\xe1\x9f\x55\x40\x5b\x72\xc5\x2b\x7f\x78\xb6\x80\xcd\x71\xb1\x19\x15\x63\x9e\x92\xc5\xf3\xf9\xa8\x2b\xe8\xdd\x21\x46\xfd\x41\xfc\x64\xb9\x24\x71\xc0\x4b\xd9\xff\x81\x18\x95\xaf\x7c\x9f\xe4\x76\x6e\x7a\xe8\x18\x15\x31\xd1\xa7\xab\x68\xea\x25\x32\x54\xfd\x9e\xd2\x70\x19\xfe\xc3\xd5\x64\x6b\xed\x4b\xcb\x7a\x39\xe7\x4a\x5d\x9f\x19\x6c\xee\xb1\xbc\xaf\x32\xcb\xdf\x84\xf9\x17\x1b\x17\x8b\x08\xef\x30\xa5\x24\x03\xcb\xfa\x39\x86\x2d\x4a\x1e\x36\x9a\xd8\x81\x6d\x61\x63\x66\x60\xb3\x28\xc1\x60\x47\xcc\xbe\x36\x5b\xdb\x8f\x83\xb1\xb9\x75\x77\x19\x59\x9a\x85\x7c\xdf\xff\x6d\x8d\xec\x6f\xb2\x96\xc6\x75\x9e\xa4\x86\xb2\xfe\x9f\x08\xdb\xa5\x0f\xa1\xae\xf6\xb9\xe3\x8e\xe9\x49\xa4\xc1\xf6\xf0\xdf\x3d\x64\x4e\x02\xeb\x51\xe6\x72\x1f\x69\xe9\x0e\x6e\xce\xea\x11\xf4\x7c\x36\x0b\xfd\x90\xc4\xfe\xfa\xab\x3a\xb8\x70\xe7\xbb\x9c\x85\x73\x07\x77\x6c\x22\x1e\xda\xbb\xd9\xb6\x78\x8a\xf3\xe2\x54\x08\x5e\x6d\x4d\xfd\xdf\xcd\xef\xa1\xde\x85\x38\x9a\xdf\x47\x35\xa9\x30\xde\x92\x20\x84\x34\x7e\x37\xa4\x77\x87\xe3\x3b\x62\x94\x56\xf2\x90\x41\x85\x75\x41\x4a\xce\xdf\x24\xae\xa8\xf0\x72\xc2\x03\x85\x18\x1a\xc8\x13\x4e\xcf\x67\xcd\xb5\x9b\x02\x5b\xb6\xb6\x44\xa8\xd8\x57\xbd\xad\xe3\x62\xbc\x38\xcf\xdf\x61\x51\xa4\xf8\x0c\xb9\x7f\x1e\xb2\xae\x0e\xa5\xc9\x92\x95\xb1\xfa\xe0\x14\xfb\x7f\xcf\x33\x48\x14\xc1\x11\x7a\x3c\x3b\x64\xff\x8e\x6d\x86\x19\x0e\xc2\x55\xce\x59\x8a\xb1\xdb\xaa\x98\x03\x75\xaa\xe3\x96\x17\x5c\x2a\x4a\xa0\x26\x7e\x1c\x04\xfb\xd3\xbd\xc3\x3a\xfe\xb3\xd9\x78\x36\xae\xe7\x21\x77\xf4\x1b\x58\xcc\xf6\x0e\xfd\x9d\xdd\x7a\x16\xd3\xd9\xa8\x9e\x05\x0b\xa5\x1b\xe8\x8f\x46\xfe\x88\xcc\xea\xe8\x8f\x40\x85\x33\x27\x7d\x1e\x4e\x35\xda\xb3\x24\xa6\x5e\x0e\x6e\x02\x6b\x72\xa0\xaf\x09\x1f\xb8\x92\x0d\xa3\x83\xd1\xa8\x76\x29\xc7\x35\x0b\xb2\x24\x10\x7d\xdd\x8c\x76\x75\x46\x6a\x46\x07\x07\x07\x2d\xcc\xc5\x62\x12\x2e\x53\xec\x53\x5d\x57\x96\x9e\x5d\x86\x69\xda\x9e\x69\x5e\x7b\x0e\xa3\xe5\x6d\x5c\x27\x96\xf0\x02\xad\xc3\xbb\xe7\x3f\x0d\xa6\xbe\x53\xd4\x7c\x35\x9f\x93\x9c\xef\x65\x1e\x40\xdc\x71\x1b\x69\x65\x3f\x5a\x4a\x2b\xc4\x35\xf7\x24\x6e\xef\x07\xa1\x4a\x22\x41\xf0\xa5\xde\x5f\xe3\xe0\xf5\xfa\xb9\xad\x17\x75\x00\xfb\x8c\x04\xa2\xc0\x5a\x37\x3d\x51\xeb\xf0\x42\xa6\x24\x2d\xab\xa1\x3d\x43\x16\xd5\x21\xdd\x6b\x92\x90\x15\x50\xc5\x18\xef\xcd\x62\xd8\xbc\xc6\x47\x48\xb4\x68\xcb\xb1\x28\x8c\x89\xe7\x26\x69\xb8\xd7\x34\x89\x82\x8a\x43\x5c\x2d\x42\x4a\xda\xcf\xd7\xdb\x41\x37\xa6\xba\x64\x94\x6b\x87\xbd\x6b\x63\xcb\x00\xd6\x0e\x7b\xcf\xc6\x96\xe1\xa9\x1d\xf6\x7e\x15\x9b\xdb\x65\x3b\xec\xb1\x8d\x7d\x78\xc8\x27\x2d\x70\xa7\x38\x98\x13\x8f\xcc\x66\x49\x46\x6b\xa2\xd1\x8e\xbe\x2e\x85\x01\x33\x37\x79\x6a\x45\x1d\x9d\xd8\x20\x4a\xae\x36\xc8\x6d\x80\x2f\xa1\x86\x59\x2d\x37\x68\xd9\xc0\x58\x80\x71\xd4\xaf\x29\x47\x78\x6c\xb7\xfa\xb5\x29\xa6\x49\x1e\x32\x7d\x81\xe1\x93\x08\xb3\x6e\x49\x3b\xa3\x55\x75\x3f\x0f\x23\xfb\x76\xe0\x15\x0c\xbd\x9c\x71\xf4\x2e\x71\xb4\xaa\x49\x26\x3f\xee\xb6\xb4\x76\xc3\x47\x76\xea\x79\x89\xc3\x1b\xf7\x0a\x3e\x6d\x9b\x4f\xac\x48\x2e\xb5\x38\xfc\x01\xbd\xc1\xeb\x64\x45\xd1\x2c\xbc\x26\x39\xfa\x61\x28\xf8\x3b\x4f\xff\x34\x11\x94\xd4\xfb\xa3\x51\x5d\x18\xde\x35\x46\xf4\x33\x3c\x23\xe8\x5a\x37\xa6\xd0\x04\x41\x41\xf6\x81\x6f\x0a\x7e\x10\x1b\xb9\xce\x47\x8d\xb1\x15\x4e\x77\xed\x09\xd9\xf4\x8e\x8e\xf0\x0c\xd6\x58\xa3\x20\x01\x8e\x50\xa7\x53\x8a\x17\x84\x79\x1a\xe1\xf5\x91\xd8\x70\x68\x1a\xd5\x8e\x12\x25\x9b\x93\xa1\x2c\x15\xa1\x66\x84\xa2\x2b\xa5\x93\x47\xbd\xd9\x2a\xe6\xd7\x15\x7a\x7d\xc9\x68\x38\x44\x2f\x08\x45\x61\x9c\x53\x1c\xfb\x04\xc5\x78\x49\xd0\x2c\x4b\x96\xe8\xcf\x3f\xde\x3c\x92\x62\xe4\x14\x2c\x8e\x2e\xde\x81\x6a\x73\x74\x0a\x2a\x8a\x83\xe4\x0a\x9c\xcb\xe7\xce\x3e\x60\x63\x0c\x6f\x00\xa2\x85\xb4\xd7\x1d\x76\xfb\xc7\x1a\xaa\xa2\xfd\x1b\x23\x7d\x5a\x52\xfa\x50\x3c\x0d\x22\x12\xcf\xe9\x02\x79\x68\xe7\xe3\xf1\x23\x25\x17\xbb\xfc\x81\x92\x34\x47\x58\x1d\x91\xb1\xbd\x23\x1f\x9d\x11\xea\x2f\x7a\x9f\x86\x38\x0d\x87\x00\x31\x54\x10\xc3\xad\x1b\x9d\xdb\xed\xd6\x8d\x2d\x6b\x0e\x6a\xf2\x17\xb7\x9f\xfa\x85\xe6\x06\xb0\x0d\x88\x7b\x72\x13\x08\x02\x4e\x90\x7a\x1e\xfc\x95\x33\x4d\xd9\xa0\x4c\x0a\x06\x56\xae\x14\xfb\xc9\x20\xf6\x91\xec\x65\xe9\xeb\x1c\x4e\xfa\xc7\x05\xf7\x8f\x6d\x3e\x01\x08\x20\x79\xe8\x5f\x80\x94\x34\xef\x1f\x3b\x68\x14\x9d\x3b\x41\x01\xab\x57\x27\xf0\x5b\xe3\x64\x5c\x60\x08\x9f\xb8\x50\x3e\xe1\xc4\xb3\x8e\x6a\x05\xa2\x75\xd6\xe9\x44\x7c\x5d\x9e\xaf\x08\xa4\xbf\xc9\xfa\x22\x95\x5f\x9c\x18\xe7\xc6\xe6\x49\x20\xb1\x0d\xd5\x05\x29\x3e\x6a\x78\xb7\x9a\xba\x61\xcd\x60\x99\x49\x96\x25\x59\x55\xe1\xcc\xb8\x92\x88\x0c\xf8\x70\xaf\xfb\x9c\x43\x45\xe2\xc2\x90\x61\x36\x47\xdd\x6d\xc4\x81\x0c\x2e\xd2\xce\x94\x43\x38\xd6\x4f\xae\x99\x58\x28\xd3\x2f\xc1\xaa\xe7\x2c\x94\xff\x12\x5e\x82\x45\x07\x89\xbf\x02\xad\xd1\xc1\x9c\xd0\xe7\x11\x61\x8f\x67\xeb\x57\x41\xaf\x6b\x47\xfe\x6e\xff\xd8\xa2\x12\x88\x9b\x4e\xad\xe8\xc8\x5b\x51\x55\x22\xd3\xe4\xba\xbd\x20\x67\xc9\x75\x57\x4d\x5e\x3a\x1a\xbb\x99\x93\x2d\x21\xc6\x8b\xb8\x0c\x8a\x0b\xf8\xac\x57\xe5\x81\x6b\x04\x31\x82\x0f\x6e\xcb\x91\x6d\x59\x70\x3d\x63\x1f\x4b\x81\xc2\x19\x12\x7a\x43\x93\x53\x74\x38\xea\x57\xd6\x8c\xd1\x3f\x45\x5d\x99\x86\xbb\xc7\x92\x1c\xfb\xf6\xfc\xda\x27\x11\x44\x02\x0a\x5f\x35\xe2\x06\x78\xb9\x80\x88\x44\xe0\xad\x06\xbf\x1f\xc7\x4d\xfc\x58\xb1\x63\xf0\x7b\x91\x24\x81\x93\x95\x80\x6c\x64\x75\xd0\x30\x35\x51\x2f\x18\xac\x7e\xc5\x61\xe6\x60\xa5\x20\x1b\x59\xed\x37\xb1\x9a\x3d\x1d\xef\x4c\x0d\x56\xef\x92\xc4\xc9\x4a\x42\xda\xac\xea\x28\x8b\x22\xc6\xa0\xac\xae\xfd\x38\xa8\x2b\xe8\x92\x7a\x69\x60\xc2\x3a\x07\x3c\x17\x0d\x04\xe2\x79\x92\x2a\x5c\xc3\x8a\x0a\x1c\xe5\x5c\xb0\xcb\x8e\xc1\x29\xcf\xdf\xbe\x01\xd0\x4f\x86\xa8\xfa\x39\x5e\xb5\xe0\x29\xce\x53\x65\xa5\xb1\x75\xc3\x1f\x6e\x8f\x3b\x93\xad\x1b\x0e\x77\xbb\xe1\x08\xb1\x5a\xd8\x74\x26\xfa\xf5\xdb\x3a\x74\x75\x8f\xda\x2e\x66\xf4\x62\x68\xb7\xfc\xa0\xef\xde\x91\x53\x58\xae\x7f\x5b\xda\x4f\x9a\xb2\xca\x18\xb2\x49\x5d\xa6\x6c\xc5\x4e\xcf\xea\x0c\x15\x28\x39\xcd\x92\x78\x3e\x79\xcf\x25\x38\x62\xe5\x04\x7f\x97\x0d\xbb\x1a\x05\x57\xa7\x65\xcc\xa2\x7d\x8f\xae\x46\xd8\x9a\x1b\xa8\x4a\xd8\xf3\x84\xe2\x88\x35\x8d\x74\x81\xb7\x6e\xa0\x58\x5f\x62\xfa\xdb\x6a\x39\x25\x59\x8f\x47\xf4\x01\x65\x90\x17\x90\xbd\xf2\xfe\xed\xbd\x0b\x24\xd2\xb4\x43\xa4\xb3\x35\x85\x44\xaf\x4b\x34\x65\x5f\xee\x55\x24\x76\xb8\xcb\xda\x6a\xec\xe4\xb5\x56\xa4\xb7\x50\x9c\x0d\xf8\x96\x46\x4a\x87\x2f\xe7\x4c\x5b\x17\xcc\x84\xfb\xcd\xf2\x7d\x32\x2a\x5d\x2b\xc5\x96\xe5\x4d\x59\xd9\x54\xb2\x6b\x71\x48\x78\x8a\x0a\xa8\xc1\x2c\x8c\x20\x51\xf5\x78\xf1\x85\xa1\x66\xbc\x84\xcd\xc6\xe9\x29\xc4\x1f\x05\x5d\x4d\x8d\xea\x4e\x57\x3b\x3a\x12\xba\x4a\x86\x1f\xf6\xb5\x22\xc1\x20\x8d\xfc\x5a\x9b\x93\xf5\xdb\xa9\xdd\xfe\x80\x6d\xf7\x9e\x89\x8a\xdf\xe0\x24\x4a\xe3\xe3\xcd\xf4\x8c\xdb\x9a\x15\x82\x6a\xb4\x3d\x3d\xfd\xc6\x64\x85\x9c\xd2\x6b\x7b\x72\xc5\xe5\xc5\x0a\x2d\x36\x52\xd0\x29\x08\xe9\xc6\xb2\x66\x5d\xf7\x72\x86\x85\x05\x41\x61\xa7\xbe\x69\x4b\xe6\xc0\xb4\xaf\xea\x01\xa2\x9a\x41\x33\xa2\x71\x93\x0e\xb0\xd8\x7b\x7f\xb3\x75\x0b\x6c\x22\xe6\xff\x2a\xd8\x46\x0d\xa6\x1e\x34\x96\x6e\x05\x8d\xbe\x59\x64\xd9\x06\xc2\xad\xaf\x52\x29\x04\x56\x4e\xe8\x9e\xa4\x2a\x66\x38\x8e\x17\x8a\x6d\x39\x3f\x9d\xb1\x2f\xfd\xff\x96\xa0\x50\xdc\xdc\x0e\xe4\x4d\xee\x93\x61\x3a\xe9\xda\x15\x3f\x5d\x65\xb1\xb3\x0e\xb0\x65\x29\x67\xb0\xc4\xa9\xf0\xa4\xfa\x0c\x6f\x34\x3a\xb6\x6e\xa4\xcb\xdd\x6e\xe8\xef\x19\x07\xea\x2c\x0d\x91\xdc\xc7\x29\x79\x49\x97\x51\x0f\xf6\x20\xec\x6b\xdf\x55\x07\xd4\xd1\x61\xc7\xe5\x75\x3d\xc2\xba\x4b\x2c\x85\xb0\x56\xa8\x42\x3f\xa1\xae\xb8\x6c\xdd\x45\x47\xee\x30\xc4\x40\x8a\xe7\x23\x19\x5b\x5c\x73\x56\x3f\x8c\x17\xec\x99\xc8\x1c\x72\xcd\xad\x5b\x4c\x47\x02\xd6\xb1\xc5\x69\xd1\x4f\xe8\x93\xca\x15\xff\x66\x1f\x8c\x24\x61\xe8\x90\xc3\xf7\x6f\x3f\x31\xf1\xba\x55\x96\x0d\xba\xb5\x57\x23\x20\xe2\x90\x02\x5c\xa9\x76\x4d\x98\x84\xb2\x9b\xc0\x44\x74\xac\x90\x18\xed\x4c\x94\xf8\xaf\xf8\x7b\xbd\xfc\x02\x5e\x31\xac\x9b\x06\xe3\xab\xb5\x06\x6a\x78\x97\x10\x25\xff\xf7\xc5\xb7\x7a\x19\x4a\xbc\x26\x39\xec\x14\xdb\x1f\xfc\x95\x84\x71\xaf\xdb\x6d\x0c\x47\xd6\xf1\x40\x71\x24\x50\x89\x42\xa2\xd7\xd9\xb4\x85\xb4\xef\xfa\x55\xb3\x24\x14\xbb\xc5\xa5\xde\x53\xa4\x10\x06\x79\x14\xfa\xa4\x37\xda\x86\x40\xa2\x27\x46\xce\xd1\x88\x08\x1a\x3e\x8f\x09\x0b\x47\x4c\x70\x75\x74\x4f\x68\x30\x69\xf0\xbf\x05\x3f\x6d\x10\xae\xc5\x0e\x1f\x2e\xd8\x2b\x77\x2e\x79\xdf\x89\x3b\x57\x40\x66\x78\x15\x51\xe6\x5f\x0a\x45\x95\xab\x27\x43\xea\x68\x13\x73\xae\x7e\x12\x10\xd3\x92\x17\x83\xb0\xb8\x59\xca\xd6\x93\x43\x34\x50\x90\x46\x61\x16\x5f\x0b\x75\x86\xb3\xca\x49\xc0\xa8\x48\xa8\x7a\x3a\x56\x89\xbb\xe0\xc7\x32\x3e\xcb\xb7\x0c\xbd\x0e\xcb\x1d\x23\x34\xdb\x4e\xb3\x64\x9e\xf1\x3b\x78\xf2\xc1\xbb\x6e\xfc\x83\x15\x07\xaa\x37\xc5\x19\x62\x2a\x4d\x8b\x5b\xad\x68\x82\xf6\x46\x62\x01\x4a\x18\xaf\x8c\x86\x16\xec\xce\xb8\x02\xab\x87\x45\x63\x40\xde\x19\x84\x65\xac\x15\x92\xff\x98\xd7\x6d\xb6\x6e\x78\xb9\xbb\x04\x87\xd2\x79\x6f\xb3\x63\xe0\xfe\xed\x77\x9f\xfd\x57\x78\x39\x04\x86\x68\x62\x4e\x1e\xca\xfc\x5f\xc3\x6b\x12\xf4\x76\x80\x32\xac\x2c\x07\x71\x44\xce\x4d\x2b\xad\x2c\xc5\xa8\xcb\x1d\x6b\x6d\xde\x82\x68\x19\x37\xec\xe3\x41\xfb\x64\xf0\x8e\x55\x4c\xd7\x71\x8b\x52\x8f\x1f\x2d\xf1\xdc\xf5\xa3\x45\xbb\x5a\x4a\xb2\x7a\xc9\x0d\xf4\x20\x55\x93\xc5\x1a\x61\x88\x94\x8b\x30\x47\x34\x5c\x92\x2f\x2b\xa1\xec\x49\xb1\xa0\x99\x35\x17\x52\x8e\x3e\xdd\x86\x22\xaa\x68\xd6\x16\xdd\xbc\xad\x9b\xac\x68\xed\xf1\x70\xa9\xbd\xd6\x65\xfc\xc5\xbe\xeb\x64\x44\x1c\x0e\x68\xd1\x33\x2b\xab\x32\xd7\xdf\x8c\x7e\xc6\xa6\x97\xa3\xd5\x25\x87\xe2\xc2\x2b\x9b\x43\x51\x39\x35\x15\x49\x06\x29\xde\x0a\x44\x46\xfb\x92\x11\x12\x8f\x52\x33\xf2\x05\x89\xff\xd7\x91\xae\x53\x5b\x6a\xab\xc7\x2a\x93\xd2\x66\x1d\x99\x7d\xcc\x31\xbf\x34\x63\xdd\x5a\x70\xdd\x56\xa8\xb6\xe2\x6a\x15\x2b\x4f\x14\xfc\xa6\x12\x27\x1b\x60\x5f\x08\xdc\x76\xde\x4c\x71\x56\xa1\x57\x77\xae\x56\xd6\x5a\xcf\xaf\x53\xf9\x07\xa6\x8d\x45\x5f\xd6\xa2\xe8\xfb\xbc\x62\x4b\x6f\x8d\x14\x1d\x91\xbb\x57\x5a\xae\x1b\xac\xce\x62\xab\xb8\x43\xce\x9b\x6a\xfc\xb1\x2c\xb6\x76\xc6\x1b\x8b\x2d\x85\xcf\xe3\x46\x7a\x97\x62\xab\x5a\xf6\xa4\x03\x29\x42\x8b\x9a\xc7\xaa\x55\xd2\xc1\xc6\x3a\xc5\xcc\x79\xa9\x3c\x29\x13\x35\x52\x33\x5e\x5a\x9f\x79\xbf\x4a\xa2\xb4\x9a\x5b\x5a\x4b\xeb\xee\x0b\x5f\x73\xcb\xd0\x38\x4e\x92\xa4\x00\x92\x2d\xfb\xef\xd3\xbf\xc0\xe4\x59\xad\x97\xf7\x9c\xdd\x34\xc7\xba\x33\x54\xbe\xe2\xa2\x2a\x76\xb5\xd5\x28\x8b\x56\x00\x5b\x92\xfc\xc0\x80\x3f\x1e\xbb\x21\xd5\x59\x3e\x3c\x0e\x4a\x14\xde\x91\xe1\x55\x9e\xea\xde\x80\xa3\x55\x41\xc6\x02\x44\xb6\x42\x98\x2f\x96\xdd\x04\x47\x5e\xb4\x4c\x54\x2c\x74\xcd\x2d\x4f\xb3\xbe\x56\x15\x7d\x53\x25\x5d\xda\x8d\x61\xa1\x4c\x6a\x71\x50\xdc\x0e\x4d\x98\x29\xc3\x62\xc5\x59\x5d\x61\xd6\x8c\xb9\xe4\xf7\x69\x3f\x13\x39\x3d\x1c\xb7\xc2\xac\x2f\xe4\x9d\x47\xfc\x6a\xad\xeb\x4e\xf9\xcd\xd5\xb5\x0b\xdd\xfa\x9c\xea\xf6\xdf\xca\xed\xdd\x4f\x7a\xff\xd6\xed\x9d\xc3\x21\x7a\x49\x22\x76\x3d\x43\xf9\x69\x6e\x7a\xad\xae\x29\x71\xd2\x5e\xf1\xd3\x55\x1c\xf2\x0d\xec\x87\xee\x59\x77\x1b\x75\x5f\xf3\xdf\x6f\xf9\xef\x17\xfc\xf7\xf9\x59\x57\x73\x05\xd6\x1a\x0d\x01\x5c\xbb\x7d\x79\xb5\x08\x23\x82\x04\x7d\x66\xe3\x3b\xa3\xdd\x7d\xf4\xfd\xf7\x00\x76\x22\xa8\x6b\x17\x1e\xec\xe2\x53\x20\x0d\x05\x92\xe9\x70\xe1\x93\x27\x7a\x59\xa8\x9e\xa4\x63\x70\x44\x4d\xe7\xe8\x09\xea\xc2\xbf\x27\x82\xe3\x87\xf0\xa3\x3b\x8c\x19\x86\x1e\xaf\x96\xba\x3c\xac\x70\x86\x4f\x20\x35\xec\x83\xa0\x4c\x96\x9c\xe0\x13\xf0\x79\x4f\x33\xa8\x22\x7a\xd6\xb9\x64\x09\xae\x63\xf0\xcf\x43\x41\xc5\x12\xf1\x75\xb7\x81\x40\x0d\x0d\x07\x99\xb7\x1a\x19\x27\x82\x03\xe7\xac\xeb\x56\x89\x96\xd6\x58\xbd\xbf\x71\xb3\xe3\x67\x04\x4a\x47\x19\xca\x7b\x5d\x18\x35\xf6\x36\x50\xb4\x9b\x1b\x16\xf6\x56\x91\xd6\xa8\xed\x95\x5c\xb7\x7d\xa6\x5f\xf0\x1d\x79\x7f\xe7\xbf\x10\x4b\x31\x55\xc2\x49\x00\x00")
 
 func ops_dashboardHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -243,12 +243,12 @@ func ops_dashboardHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "ops_dashboard.html", size: 18395, mode: os.FileMode(438), modTime: time.Unix(1765548089, 0)}
+	info := bindataFileInfo{name: "ops_dashboard.html", size: 18882, mode: os.FileMode(438), modTime: time.Unix(1792193695, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _ops_enhanced_revelHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x5d\x6f\xdb\xb8\x12\x7d\x0f\x90\xff\x30\x57\x37\x80\x1d\xdc\x4a\x4a\x3f\x70\x1f\x6c\x59\x40\x9b\xf6\xa1\xb8\xb7\xdd\x7d\xc8\xdb\x62\xd1\x52\xe2\xd8\xe2\x96\x22\x05\x72\x6c\xc7\x2b\xe8\xbf\x2f\x48\xc9\x8e\xec\xca\x69\x9c\xb4\xc5\x6e\x1e\x1c\x89\x1a\x1e\x9d\x39\x3c\x43\x71\x12\x2e\x56\x90\x4b\x66\xed\x2c\xc8\xb5\x22\x54\x14\xae\x0d\xab\x2a\x34\x01\x58\xda\x48\x9c\x05\xa5\x50\x61\x81\x62\x51\xd0\x04\x9e\x5f\x5d\x55\xb7\x53\xd8\xde\xb2\x25\xe9\x29\xe8\x15\x9a\xb9\xd4\xeb\x09\x14\x82\x73\x54\x41\x7a\x7e\x06\x00\x90\xfc\x2b\x0c\xe1\x86\x65\xf0\x91\xad\xc4\x82\x91\xd0\x0a\xc2\x70\xfb\x70\xef\xcd\x32\x2c\x79\xf8\xfc\xc5\x76\xe6\x61\x80\x62\xab\x90\x58\x66\xc3\x7c\x69\x49\x97\xfd\x30\x1f\xba\x94\xbd\x48\xd8\x46\x1f\x86\xf9\x50\x29\xb6\xa1\x2c\x27\xb1\xc2\x20\x4d\x18\x14\x06\xe7\xb3\xe0\xdf\xc4\xb2\xd0\x25\xb3\x12\xb8\x0e\x80\x33\x62\x21\xe9\xc5\xc2\x89\x40\x2c\x1b\x82\xf3\x90\x3b\xc4\x39\x83\x39\x0b\x39\xb3\x45\xa6\x99\xe1\x41\x9a\xc4\x22\x85\x5f\x3a\xc4\x01\x32\x31\x4b\x93\x58\x8a\x61\x9e\x87\xc4\x2a\xfb\x78\x4e\x05\x32\x43\x19\x32\xda\x72\xaa\x2c\xbc\x56\x4c\x6e\xac\xb0\x47\x10\x6c\xc5\xd4\x16\x44\xb2\x0c\x25\xf8\xdf\x70\xcd\x8c\x12\x6a\x11\x80\xe0\xb3\x40\x57\xf6\xbd\xb5\x4b\xb4\xd7\x7a\xa9\x68\x67\x19\x2e\x6c\x25\xd9\x66\xa2\xb4\xc2\x69\x90\x5e\x25\xb1\x43\x1b\x4a\xf3\x14\x05\x38\x12\x13\xf2\x09\x2a\x08\x35\xd7\x61\x2e\x4c\x2e\xb1\xd3\xe1\x6d\x0b\xf9\x60\x62\x49\xbc\x94\x87\x43\x3d\x9f\x3a\x96\x5d\x19\x0d\x9a\xcf\x15\xc4\xd6\x0e\xbe\x32\x76\xe5\x70\x1f\x62\xc5\x14\x42\xe7\x56\xaf\xfa\x9e\x4f\x8f\xe4\x5e\xd7\x84\x65\x25\x19\x21\x04\xa8\x0a\xa6\x72\xe4\x9f\x0c\xae\x50\x46\x05\x95\x32\x80\xa8\x69\x86\xd2\xe6\x62\x95\x9e\x9f\x1d\xe3\xde\xb3\xcd\x69\xfc\x7b\xc4\xab\xc1\xc2\x3c\xe4\xac\x2b\xfb\x69\x57\x49\x4f\xa0\xdc\xad\xf0\x63\xd9\x6e\x3d\x77\xcc\x61\x83\xbb\xd8\x6e\xe7\x64\x66\x21\x54\x48\xba\x9a\xc0\x0b\xb7\x71\x1e\x83\x39\x84\xca\xf4\xed\x7d\xa1\x03\xe1\xae\xc2\x39\x1a\x58\x0b\x2a\xc2\x4c\x1b\x8e\xe6\x5b\x08\x1e\xa5\x78\xd9\x07\x21\x41\xae\x34\x5e\xf3\x95\xf7\x0b\x7c\x40\x32\x22\xb7\x49\x5c\xbc\xfc\x16\x9d\x6e\x15\x4e\xa1\x9c\x69\xbe\x79\x10\xc9\xde\x3c\xa3\x8f\x3a\xfe\xbe\x69\xdd\xda\xfc\xf7\xa1\x73\xfd\xfc\xe2\x55\xfa\x01\x4b\x6d\x36\xf0\x56\x58\x32\x22\x5b\xba\x4f\x58\x12\x17\xaf\x4e\x41\x21\x96\x49\xec\x19\x4c\x22\xf8\x5f\xb7\x51\x70\x54\x16\xf9\x29\xa4\x5a\x48\x73\xe2\x8c\x76\x16\x4f\x6f\x34\x31\x09\x6d\x52\x93\x24\x26\xfe\x48\x9c\xc4\x92\xd1\x6a\x91\xd6\x75\xb1\x2c\x99\x12\x7f\xe2\x9b\x0d\xa1\x85\xe8\x46\x93\x6c\xaf\x9b\x26\x89\xbb\xa8\x47\xbc\x28\x89\x4f\xce\xf1\xa9\xaa\xfc\x0f\x37\xf6\xfb\x6a\x72\xad\xcb\x92\x75\x9a\x7c\x5c\x96\xff\x20\x45\xde\x32\x62\x70\xb3\xa9\xf0\x3b\x29\x22\x51\x41\xe4\xf0\x7e\xb6\x0c\x49\xec\x6b\xed\xa1\x3b\xc6\x03\x36\xb1\x5d\xec\x77\xd8\x5d\x9c\x22\xf0\xc6\x20\xfb\xc2\xf5\xfa\x6f\xb0\xb1\xd4\xb5\x61\x6a\x81\x70\x41\x9b\x0a\x9f\xc1\x45\xee\x0e\x75\x30\x99\xf5\xd6\xee\x67\x39\xb0\xae\x3d\x89\xa6\xf9\x11\x25\xd9\x26\xd6\x34\xf0\x05\x37\xf6\x29\x6e\xbc\x63\xbb\xbf\x0f\x8e\x85\xe2\x78\x0b\x17\x5e\xb7\x76\xc8\xa7\x73\xe9\xcc\xff\x33\x4a\xbf\xae\x51\xf1\x53\x56\xeb\xc7\x14\xca\x83\x4e\x05\xdf\x88\xb9\xef\xf9\xb1\x67\x43\xe3\x87\x63\xfd\xfb\xed\xf5\xdd\x59\x32\xb1\xb9\x11\x15\xa5\xe7\x67\x71\x0c\xff\xd7\x8c\x83\xae\x2c\x08\xdf\xeb\x40\x5b\x17\x73\x6d\x20\x63\x7c\x81\xe7\x67\xe3\xf9\x52\xe5\xee\x70\x30\xbe\x84\xba\x85\xcc\xb5\xb2\x04\x15\xa3\xe2\x57\x66\xc8\xc2\x0c\xd6\x42\x71\xbd\x8e\xa4\xce\x7d\x2b\x1c\xb9\x67\x8a\x95\x18\xd9\x4a\x0a\x1a\x8f\xe2\xd1\xe5\xb4\x3f\x57\x28\x4b\xee\x18\xf6\x91\x95\x08\xb3\x3b\xa8\xdf\x76\x57\x91\x44\xb5\xa0\x02\x42\x78\xfe\xfb\x74\x7b\x02\x9e\x23\xe5\xc5\xf8\x73\xcc\x2a\x11\xeb\xca\xc6\x05\x32\x49\x45\x7c\x51\xf7\xf1\x9a\xcf\x97\x77\x4a\x44\x54\xa0\x1a\x1b\xb4\x95\x56\x16\x61\x96\xc2\xf6\x3a\xfa\xc3\xba\x9c\xbe\x8a\x75\x0d\x98\x8b\xab\xf7\x25\x6e\x79\x93\xfb\x9c\xb6\x5d\x21\xcc\x7c\xaf\x16\xe5\x46\x90\xc8\x99\xfc\xd4\x09\xf8\x9f\x76\xb8\x6b\x25\xed\x74\x1f\x46\xcc\x61\xdc\x07\x49\xe1\xea\xf2\xf0\x55\x77\xaf\xf3\x4b\xe0\x5e\xa4\xf3\x65\x89\x8a\xa2\x05\xd2\x3b\x89\xee\xf2\xcd\xe6\x3d\x1f\x8f\xf6\x7b\xd4\x9d\xc8\xfd\x3f\x8f\x11\x11\xde\xd2\x75\xdb\xbd\xc1\xac\x9f\xc6\xd1\x19\xfe\x9c\x1f\x75\xdd\x2e\xcc\x60\x24\x94\x14\x0a\x47\xd3\xa1\x76\x24\x8e\xe1\xba\xf0\xfb\x6b\x4b\x39\xd7\xd2\x3b\xc8\x22\x07\xad\xc0\xe2\x0a\x8d\xa0\xcd\xd7\x13\x9d\x1e\x83\x32\x1e\x13\xe6\x8e\xa0\xff\x3a\x74\x06\x1a\xf5\xbb\x78\xee\x88\x98\xd1\x40\x66\x0d\xa0\xb4\xf8\x48\xd8\x6e\x45\x07\x71\xf7\x87\x7a\xb7\x4d\xdf\x5f\x39\x73\xf6\x45\x63\xb4\x39\xe2\x30\x2d\x31\xf2\xcf\xc7\xa3\x77\x3e\x4c\x6a\xc6\x85\x5a\xf8\x0a\x6d\xdd\x3e\x19\x3d\x03\x1f\xd2\x5f\xec\xc6\xdd\x34\x97\x63\xf7\x2f\x89\x77\xf5\xfd\x57\x00\x00\x00\xff\xff\x00\xa0\xb1\xd6\x03\x13\x00\x00")
+var _ops_enhanced_revelHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5c\x5f\x73\xdb\x36\x12\x7f\xf7\xa7\xd8\xea\x7c\x23\x39\x8d\xa8\xa4\xed\xdd\x83\x2c\xeb\x26\xfe\x93\xb9\x4c\xdd\x24\x13\xab\x77\x0f\x9d\x4e\x0a\x91\x90\x88\x0b\x45\xb2\x00\x28\x5b\xd5\xe8\xbb\xdf\x2e\x40\x4a\x94\x44\x29\x96\x28\xc9\x75\x6d\xcf\xb4\xa1\x48\x60\xb1\xbb\xd8\xfd\xed\x02\x04\xb7\xe5\x89\x21\xb8\x01\x53\xea\xac\xe2\x46\xa1\xe6\xa1\xae\xdf\x4a\x16\xc7\x5c\x56\x40\xe9\x51\xc0\xcf\x2a\x03\x11\xd6\x7d\x2e\xfa\xbe\x6e\xc2\xeb\x57\xaf\xe2\xbb\x53\xc8\x7e\xb2\x44\x47\xa7\x10\x0d\xb9\xec\x05\xd1\x6d\x13\x86\x42\x89\x6e\xc0\x4f\xe1\x56\x78\xda\x37\xcd\xff\x7e\x5a\x69\x1f\x01\xfe\x8d\xc7\xa2\x07\xce\x25\xd3\xac\xcb\x14\x57\x93\x89\xb9\xdb\xfa\xa6\x5e\x87\xec\x26\xdc\xf0\x80\xbb\x3a\x92\x50\xaf\xdb\x4e\xad\x39\xfe\x82\xfa\xc0\xab\xbf\xfe\x6e\xca\x59\x7e\x14\x88\x99\xe7\x89\xb0\x5f\x0f\x78\x0f\x39\x7b\x35\xbb\x21\x2d\xaf\x78\x67\xc0\x64\x1f\x85\xe9\x46\x5a\x47\x03\xea\x87\xb2\xa4\xdc\x99\xc1\x7a\x91\x1c\x64\xa3\xd1\x75\x5d\x84\x81\x08\x79\x05\x06\x5c\xfb\x91\x77\x56\xe9\x73\x9d\x6b\x6f\xfa\x04\xac\xcb\x03\xc0\xd6\x67\x15\xaf\x6b\xf9\xaf\xb4\x5b\x62\x4a\x86\x41\x8f\xd5\xbd\x54\x40\x7c\xd2\x10\xed\xa9\xbc\xad\x86\xe9\xbd\x40\x51\x19\x22\x73\x7c\xd0\xd4\xc8\x28\x00\x11\xc6\x89\xae\xab\x41\x05\x84\x97\x1b\x0f\x42\x36\xe0\xf4\xbb\x02\x51\xe8\xfa\x2c\xec\xe3\x2f\xed\x0b\xe5\x50\x6f\x47\x25\xdd\x81\xd0\xb5\x93\x05\xd6\xcd\x60\x51\xac\x45\x14\xc2\x90\x05\x09\xf6\xa9\xbf\xae\xd8\x79\x0a\x34\x38\x17\x89\x94\x68\x0f\x97\xe7\xf0\x6a\x32\xb1\x4c\x71\x6f\x3c\xe6\xa1\x37\x99\xb4\xdf\x04\x01\x64\x52\xa9\x56\xc3\x92\x59\xa6\x3f\x1e\x4b\xe2\x06\x8e\xbd\x2e\x34\xcf\x96\xa7\x7f\x0d\x2f\xe3\x31\x76\x72\x2e\xcf\x27\x93\x94\x27\xfe\x3b\xd8\x3b\x70\x3c\xe3\x6d\x99\x33\xaf\x3b\xeb\x09\xb5\xf1\xd8\x4f\x06\x2c\x14\x7f\xf0\x8b\x68\x30\x60\x86\xc2\xfb\x64\x80\x8f\xbe\xf0\x91\x7a\x09\xb3\xe7\xe7\x23\xcd\x95\x79\x6e\xae\x26\x93\x93\x75\x62\x99\xb1\xe6\xe7\xad\x61\x39\xc9\xd9\x53\x83\xd4\x3f\xfb\x9d\x7a\xc0\xf9\x4d\x82\x9c\xc8\xd1\x87\x30\x18\xe5\x68\xb4\xe2\x6c\xca\x35\xbf\xd3\xf5\x41\x82\x32\x2d\x5b\x92\x08\x7b\x51\xdd\x15\xd2\x0d\x32\x63\xea\xe0\x3c\x4f\xa7\x02\xf0\x5a\x59\xf2\xf5\x08\xe9\x37\x41\x8f\x62\x8e\x72\x06\x3c\xec\x6b\x1f\xff\x19\xf2\x00\x7f\x76\x3a\xd7\x0a\x58\xe8\x81\xf6\x39\x04\xe8\x16\x5c\x69\xa3\x11\x60\x92\x83\x1b\x25\x08\x05\x1e\x20\x0a\x4c\x29\x3b\x73\xc2\x66\xa2\x7c\xe2\xa6\x2d\x43\xa7\x5f\x54\x47\x37\x41\x2f\x0b\x33\xee\xbb\x3a\x04\xfc\xaf\xee\xf1\x1e\x4b\xd0\xba\xe8\xfa\x4e\x59\x3b\x96\x96\xc8\xb9\x0e\x2b\xed\x0b\xba\x02\x9a\xc3\xfc\x1c\xa3\xe1\x43\x2f\x09\x82\x56\xc3\x52\x6d\x2f\x30\x83\x32\x2d\x8e\xff\x29\x09\xa1\xe5\x46\x1e\x6f\x4b\x0f\xa5\x48\x06\x31\x82\x0a\x5a\xe1\x3c\xe1\x56\xc3\x34\x21\xff\x05\x54\x8d\x1c\x81\xe4\x71\x24\x35\x44\x3d\xd0\x79\xc5\x2e\x8a\x3f\x3f\xff\xad\x46\xbc\x3c\xcd\xc5\xba\x69\x29\x57\x8a\x58\xcf\x4b\xe0\x45\x6e\x32\x40\x96\x1c\x04\x98\xab\x80\xd3\xe5\xf9\xe8\x9d\x57\xab\xce\x54\x53\x3d\x71\x10\xcf\xae\x86\xf8\xe8\x5a\x28\x04\x6a\x2e\x6b\x55\x37\x10\xee\x97\xea\x4b\x60\x6a\x14\xba\xa8\xa1\xd0\x35\x1e\x54\x3b\x81\xf1\x92\xcd\x1a\x3c\xf0\x84\x22\x7e\x3c\x38\x03\x2d\x13\x7e\xba\xd4\x0a\x91\x06\x2d\x81\xf0\x04\x9b\xcc\x74\xf5\x0e\xef\xb2\xd0\x45\x41\x56\x75\x31\xd8\x79\x06\x21\xbf\x85\xb7\x78\x49\x8e\x5e\x3b\x59\x6e\x6c\x00\x89\xe2\x4b\x88\xd2\x79\xdd\xea\xcb\x85\x09\x29\xe8\xa2\x71\x56\x96\xc5\x99\x8d\x2c\xb9\x8a\xf1\x82\x18\x66\xb7\x4c\x20\x2b\x5c\xbb\x7e\xad\xda\x60\xb1\x68\xa4\x0a\x6c\x54\xe1\x5b\xe0\x21\x4d\xf6\xcf\x9f\xde\x21\x14\x60\x0f\x1c\xb1\x46\x82\x9e\x20\x0f\x29\xc2\x37\xa1\xfa\xf1\xc3\x4d\x07\xb9\xea\x46\x1e\x3a\x8f\x11\xaa\x88\xa7\xb9\xd1\xc9\x9e\xb3\xb1\x33\x66\x9c\xff\xa9\x28\x2c\x52\x00\xfd\xa1\x7d\xd4\xbe\xb1\x1d\x11\x9c\x5d\x97\x2b\x55\x34\x63\xd9\x1f\x0b\xb8\xd4\xb5\xb4\xfd\x00\x1b\xb3\x3e\x5f\x41\xd9\xe8\x6b\x61\xa2\x7b\x0c\xbd\x63\x75\x73\xc9\x75\x22\xc3\xe2\xe7\xcb\x20\x4d\x7f\xb7\x22\xf4\xa2\x5b\x27\x88\x5c\x46\xe6\xe6\xf8\x92\xf7\x70\x9c\x6a\x43\x73\x89\xc9\x02\x0b\xd6\x6a\x1b\x1f\x55\xff\xe5\x75\xcf\xa8\xcd\xfc\xdc\x2f\xf3\x30\x01\x1c\xc2\xf5\xa1\xc6\xa5\x8c\xe4\x2a\x1d\x59\xfd\x98\x26\x5f\x51\xcf\x3d\x55\x33\x2f\x76\x7e\xfe\x11\xe4\x17\xdc\x77\x11\x0a\xf2\xbf\x5b\x0d\x4c\x5f\xb2\xec\xc7\xde\x9e\x25\x3d\x1d\xd6\x85\xf7\x6c\x28\xfa\x46\x89\xfb\xc9\x78\xf2\xc9\x4d\x8e\x6e\xc8\x86\x75\xc4\x25\x55\x77\x13\x85\x99\xd0\x62\x4a\x93\x04\xb9\x86\x90\x35\x2e\xca\x1e\x82\x69\x74\x62\x88\x3c\x43\x0a\x49\x0c\xc8\x1c\xce\x2a\x7f\xc3\x3e\x75\xca\x0c\x87\x82\xdf\x56\x0c\x8e\xd6\x75\xd4\xef\x93\x14\xf8\xa8\x80\x9a\xa1\xb8\x94\x38\x29\xbf\x1b\x31\xe9\xa5\xc1\xee\x43\x4a\x70\x99\x95\x06\xc3\x16\x81\x28\x64\x72\x91\xab\x58\x6d\xcd\x90\xcf\x99\xd4\x5d\xce\x74\xc6\x50\xac\xe0\x0d\x9a\xfc\x48\x09\x55\x4c\x40\xc5\x6c\x1a\x05\x6d\xb2\x68\xfe\x5f\xbf\x65\x32\xc4\xc9\xb2\x21\x10\x59\x7a\xa7\x54\xc2\x95\x89\x7f\xd3\xe9\x46\x53\x8d\x03\x36\x6a\x86\xe8\x3f\x38\x99\xaf\xd0\xfc\x90\x5a\x81\x8c\x1b\x48\xef\x71\xcd\x44\xb0\xbd\x06\x96\x33\x90\x4b\x4b\xf1\x9e\x5c\xb5\x1a\xc9\x62\xc6\x9b\x33\x4d\xe2\x30\x5d\x88\x14\x19\x1c\x39\x4e\x66\x03\xc6\x83\x32\xb7\x59\x47\x0f\x55\xc6\x21\x35\x50\xa3\xed\x39\xd3\x2c\x96\x7a\x3c\xd6\x7c\x80\xba\xd7\x1c\x2a\x3c\xf4\x29\xf6\x79\x9f\x25\x65\x4f\x8e\xaf\x07\x41\x05\x9c\xa2\x0c\xd6\xfa\xfb\x0a\xb6\x73\x96\xb2\x11\xeb\x39\x9e\xe3\x22\x2f\x5c\x64\x17\x5b\x7d\x9e\xfa\xcd\xb6\xdc\xa6\x73\xba\x25\xa3\x99\x8d\xad\xb0\xa8\xb5\x10\x97\x2e\xd2\x74\x14\x37\xe1\x3b\xb3\xda\xdc\x1e\xf4\xd6\x8d\xdc\x8d\xee\xd6\xb4\x2c\x68\x4d\xbe\xef\x61\x46\x7c\x2b\xb4\x8f\x6b\x48\x89\xd7\x5f\x21\x60\x88\xf8\xdf\xe7\x69\x68\xa1\xc9\x6f\xde\x78\x43\x63\x52\xf0\x13\xd7\x52\xb8\xb8\x82\xf2\xbf\xff\x0a\x33\xb3\x58\x72\x5f\x7e\x29\x87\xb9\x0f\x87\xb9\x6e\x32\x5a\xe5\x10\xeb\x7a\xa5\x93\xf8\xcf\x7b\x76\x35\xdd\xfd\x1f\xda\x3f\xf1\x41\x84\xb9\xdd\x25\xa6\xb3\x52\x60\x62\x8f\x61\x10\xf5\xf0\xc3\x06\x44\x4c\x72\x9d\x33\x43\xfc\x61\xfe\x4f\x10\xe2\x71\x4c\xc3\xbc\x0d\x58\xb2\x14\xe5\x66\x1d\x6c\x27\xaf\xdd\x89\x34\x0b\xc0\x4a\xd4\x6c\x35\xf0\xce\x56\x64\x5a\xa8\x8a\x28\xec\xb7\x17\x97\xa5\x0e\xd2\x0f\x78\xba\x30\xc5\x28\x60\x5b\x6d\x3e\x0e\xf6\xd8\x50\xc0\x92\x1a\xf9\x11\xd7\x94\x3b\xd5\x87\x5d\xc6\x5b\x7d\x98\x85\xfc\xe3\xd0\x06\xad\x87\xa0\x43\x6b\xf1\x9d\x68\x03\x97\xf3\xa8\x03\x24\x77\x58\x15\x60\x6b\x72\xaf\x7b\x22\xc4\xd7\x11\x6b\xda\xb4\x3c\x98\x90\x32\xe0\x5c\x72\xf6\x05\xd7\x26\x0f\x8e\x23\xd3\x7d\x2f\xda\x7e\x79\x09\xc7\x66\x11\x6a\x76\xc0\xa6\x93\x76\x18\xbb\x1b\x8f\x0d\x0b\x93\xc9\x1e\x9c\xd0\x4a\x95\x6e\xa5\x95\xb0\xc1\x19\xaf\xf3\xa0\x57\xc3\x75\x26\xbf\x83\x63\xa3\xb3\x74\x7b\x8e\x84\x39\x21\x8b\xdf\xbf\xaf\x17\x6d\xf2\x7d\x65\x84\x9d\x3b\xc7\x3d\x9b\x15\xef\xad\xaf\x25\xbc\xab\xa0\x8f\x99\xdb\x66\x8e\x7a\x39\xdb\x35\x7e\x94\xb1\xde\x6f\x5f\x9e\xe3\x54\xfb\xdb\xf5\xfd\xd1\xb8\xca\xb6\xbd\x6d\x7e\xb1\x7d\xff\x1b\xf4\x2d\xf8\xb7\x08\xf5\xf6\x24\xae\xee\x62\x21\xd1\x13\x4b\x90\xda\xc6\x15\xef\xff\x1a\x61\xbd\x00\x5b\xc2\xe8\x74\x09\x4d\x3b\x57\xb9\x37\x13\x73\x6f\x1b\xec\x7a\x77\xe7\x28\x9b\xbd\xb1\xd8\x03\xc2\xe6\xde\x76\x94\x21\x6a\x15\x40\x16\x51\x9e\x4e\x6a\x5f\xdb\x12\xfb\x8b\xc3\xfc\xfd\x58\x7d\x38\x70\x47\x78\x03\x33\x83\x23\xa8\x75\x3a\xd7\x27\x8f\x16\xe3\x29\xe5\xd8\x1e\x24\x51\xf4\xc7\x16\x22\x4a\x80\x72\x9a\xe3\xf2\x10\x97\xf1\x08\x2a\x26\xcb\xed\x5c\x5f\xd8\xe4\x70\x3b\x92\x44\x6b\x44\x94\x32\xaa\x07\xcf\x97\xcb\x00\xf9\x8a\x1d\x5f\xda\x39\xad\xd0\x08\x46\x3a\x07\xe7\xd9\x80\x3a\xed\xe8\xee\x21\x6c\xd8\x41\xf6\x16\x39\x2c\xf9\xad\x83\xc7\xfe\x71\x7a\x9b\x3e\x0f\x88\xed\x0f\xb6\x0b\x77\x45\xef\xe9\x44\xd8\x7f\xbc\xf9\x78\x39\xac\xce\xe4\x7f\xda\x80\x9d\x69\xe1\x19\xb5\x9f\x51\x7b\x0d\xcd\x27\x83\xda\xa6\x69\x79\x70\x7d\xcf\x99\x84\xcc\xb7\xe0\x5a\x0c\x84\x7e\xc6\xd9\x6d\x29\x18\xf5\x3d\x36\x98\xce\xfa\x5f\xdb\x43\x76\x07\xc5\xf9\x74\xcb\x99\x8c\xb0\xe3\xe3\xa2\xda\x8f\x02\xef\x90\x98\x1c\x6a\xb3\x75\xbb\x5f\x58\xc6\x41\x32\xd3\xda\x05\x30\x9b\xf3\x80\x96\xac\xb1\xb7\xc9\x04\xec\xaf\xff\xd0\xb1\xd4\xd9\x89\x41\xb3\xe5\x4a\xf7\xdf\x74\xa3\x21\xde\x67\xf4\x4f\x76\x12\x11\x19\x8c\x6e\x53\x18\xdb\x43\x90\xc0\x51\xf7\x16\x21\x90\xf6\x0e\x76\x84\xa6\x06\x48\xf1\x92\x68\xad\x9a\xc6\xf4\x3c\x28\xcd\xe4\x5c\x68\x85\xad\xb5\xf7\x97\xde\x01\x7a\xb0\x55\x42\x0a\x5f\x90\x9e\x4e\x7d\xbc\x41\x0c\x8d\xac\xc4\xbe\x4e\xa9\x08\xf8\x56\xf0\xc0\x2b\x11\x41\xcc\x11\xee\x52\xf1\x0f\x1e\x60\xb1\xc1\xd1\x62\x4c\x18\x4a\x4d\x28\xb3\xa0\x03\xc6\x21\x62\x21\x43\x97\x12\xbb\xd3\x86\x4c\xe9\x80\x96\x05\x18\x43\xcd\x98\xc4\x34\xa8\xec\xfe\xdc\x88\x1d\x05\x2d\x67\x4f\xcb\x89\x54\xb1\x7f\xe2\x05\xc5\x9f\x78\x71\x70\xf3\x85\xdf\x72\x0f\x2e\xa2\x80\xbe\x1b\x11\x51\xa8\xa0\xa5\x06\x2c\x08\x96\x8f\xbb\xd8\xa6\xeb\x3e\x5c\x49\x9b\xcc\x8e\x08\x19\x42\xcf\x30\xbd\x79\xef\x59\x88\x7b\xe0\xb5\x42\x16\x6c\x0f\x0b\xd7\x06\xab\xad\x31\xd9\xdc\xed\x70\x30\xbd\x0b\x8c\x2e\x0d\xd0\xcb\x5b\x32\x94\x6c\x7f\xe8\xd1\x64\xec\x36\x9b\xcf\x40\xd4\x29\x7f\xae\x6f\x99\xf9\x29\x71\x04\xff\x0f\xbd\x5c\xec\x7d\x3a\x30\xbd\xd1\xe1\x99\x0b\x7b\xe8\xfc\x82\xb0\xef\xb0\x27\x68\x36\x0d\x1b\x66\x21\x0a\x29\xbf\x8f\x16\xe0\xcb\x61\xb4\x99\xa6\xed\xbb\x1b\x15\x96\xe8\x5f\x36\x15\xa7\x4f\xa2\x24\x57\x8a\x3e\xfc\xb9\x61\xc3\x32\xac\x7c\xe2\xf4\x91\x1a\xd3\xdb\xd2\x29\x11\x2a\x5c\x1b\x2b\x36\xf6\x9c\x79\x11\xb6\x8f\x17\x6e\x79\xb0\xff\xfa\xf6\x12\x0e\x62\x24\xdb\xc1\xde\xd2\xf2\xf9\x49\x9e\xed\xe3\xec\x0c\xef\x5d\x9e\x65\xfb\x3b\x8f\x53\xa4\x89\xd4\x6e\xd1\xd6\xb2\xed\xb6\x9d\x47\x2c\x1c\x26\xb3\xe9\xd9\x30\x4f\x23\x60\x99\xa6\xe5\x03\xc4\x14\x5d\x08\xde\x3f\x4a\xde\x13\x77\x8f\xf8\xb4\xa5\x15\xe0\xa1\x16\x03\x25\x03\x0d\x65\xd0\xf0\x2e\x54\xb1\x29\x51\xf0\xd4\x03\x46\x9c\x8f\x17\x99\x61\x1e\x30\x60\xc4\x3b\x58\x60\xc4\x07\x88\x39\xf1\xfe\x42\x4e\x9c\x6d\xc8\xef\x3e\x3e\xc4\x07\x09\x0f\xf1\x93\x8d\x0e\x3b\x3e\x24\x6a\x97\x3d\x68\x0d\x9d\xf4\xcb\xd1\x3f\xf7\x67\x03\xb4\x7b\x3d\xe5\xf4\xd1\x06\xb3\x4c\x82\xc7\xfa\x16\xfb\xbf\x42\xfb\x50\xea\x74\xeb\xd5\x1d\x43\x15\x1c\x38\xf0\xd8\xf7\xe0\x1b\xda\xfa\x3c\xe7\xdb\x7e\x38\x90\x6d\xf7\x6b\x27\x1b\x7b\x2f\xdb\xfd\x29\xbc\xef\xf1\xc5\xf0\x4e\xde\x0b\x2f\xb1\x8b\xb6\x54\x7a\x09\x94\x4d\xb2\x93\xd9\xd6\x3d\xdf\x37\x3f\xbf\x6c\x5e\xff\x77\xf0\xcf\x0d\x36\x5d\xe8\x50\x4c\x78\xe7\x21\x9a\x77\xc4\x80\x43\xed\xfa\xd3\xcf\x4f\xf5\x83\x03\xd2\xc2\x63\x8b\x29\xbb\x3d\xc0\x4a\x1a\x78\x3e\xbc\xfa\x7c\x78\x75\x0d\xcd\xe7\xc3\xab\x9b\xc1\xeb\x1b\x53\x99\x0c\xde\x4a\xfe\x7b\xc2\x43\x77\x84\x08\xfb\xf6\xc9\x22\xac\xc1\x16\x2e\x9f\x36\xc8\x92\x29\x3c\x83\xec\x33\xc8\xae\xa1\xf9\x64\x40\xf6\x3e\x05\x99\xd6\x37\x59\xf3\x78\xc5\xa3\x82\xdb\x0b\xb7\x72\x3f\xd3\xcb\xac\xcc\xd7\xb4\xf0\x68\xa3\x01\xd7\x11\xf3\x20\x8a\x15\x08\x53\x74\xce\x16\x7c\x35\x45\x50\xbb\xcc\xeb\xf3\xa3\x5a\x56\x4a\x74\x5a\x49\xd4\x16\xbb\x8c\x99\xf6\x3f\x32\xa9\x15\x9c\x2d\x55\x81\xa4\x67\x54\xe0\xd1\x51\x71\x20\x74\xad\xda\xa8\xa6\x75\x13\x6d\x57\x91\x56\x10\x7d\x6f\x4b\x8b\x4e\x29\xfd\x32\xbd\x72\xd2\x1a\xb5\x75\x78\xfd\xeb\xa9\x2d\x4b\x66\x2b\x79\xfe\x66\x2a\x79\x22\xbb\x0d\x9f\xb3\x40\xfb\x8d\xe3\x71\x9e\xda\xe4\x78\xbc\xc8\x8b\xe2\x4c\xba\xfe\xe4\xb7\x93\xa9\x5e\x1c\xed\xf3\xb0\x36\x2b\x15\xda\x5e\xac\xd4\xb9\xd8\x94\xaa\xe3\x51\xb3\xf9\x92\x93\x56\x16\x4d\xa5\x8d\x6c\xbd\x3e\x14\x85\x5a\x3a\xa8\x5b\x2d\x5c\x16\x7c\x4e\x35\xfa\xad\xbd\x9d\x16\xf9\x53\xf3\x35\x26\xa9\xfe\x67\x9e\x46\x1b\x5e\x15\xd5\xb6\xb4\x83\x99\x19\xa1\x61\x56\xd5\x88\x9d\xaf\x1d\x58\x2d\x28\x7d\x69\x48\x38\x54\x56\x38\xdd\x7b\xa7\xf2\xaf\xb3\xf1\x57\x75\x30\x05\xd9\x9c\xb4\x06\x21\x15\xf8\xb4\xe5\xb0\xab\xa7\xcb\x35\xe3\xd0\xa6\x2e\x4c\xdd\xe9\x94\x5d\xcc\x38\x8c\x31\x61\x78\x87\x28\x04\x45\xb5\x75\x85\x1e\x2d\xf5\x23\x4d\x14\xea\x6f\x85\x4a\x66\xcc\x19\x1c\x4e\x8d\xa9\x3a\xb7\xc2\x27\x36\x64\xb5\xa8\x98\x28\x1d\xce\xdf\x8e\x68\x3a\x8f\x45\x54\x8f\x8a\x7f\x4d\x72\x06\x65\x8a\x98\xda\x02\xa5\xc5\x26\x15\xa1\x9e\xcd\xe3\x5a\xf5\xca\xb4\x0a\xd0\x41\xe9\x8b\x21\xf2\x51\x6b\xf4\xcd\xea\x4b\xb0\x55\x50\x4f\x73\x63\x9c\x1e\x4d\x4e\xa8\xca\xec\xac\x2e\x29\xfa\x39\x4d\x1b\xba\xf9\x0b\xb8\x66\xa3\x28\x41\xbf\xa6\xd7\x2c\xc6\xbb\x11\x05\x21\xad\xae\x08\x2f\x1a\x47\x4e\xae\xda\x62\xca\x55\xbe\xd6\x9e\xb9\xb1\x5c\xdc\xfd\x68\x72\x64\x7b\x9a\xba\x8a\x05\xdd\xf2\xcf\x9b\x4d\xd6\xc3\xb4\x69\x86\x22\x34\x56\x13\x2a\x15\x4b\x3d\x2b\x70\x69\x73\xc2\x14\x2f\x02\xf4\xde\x26\x74\x23\xed\x5b\x5a\x0b\xe5\x4a\x57\x71\x4a\x25\xef\x94\xf8\x03\xd5\x46\x9d\xa9\x3c\x5f\x1d\x6f\x15\x93\x28\x90\x3c\xad\x23\x88\x14\xff\x11\xdb\x5e\xa8\xc1\xab\x50\x25\x92\x03\x0b\x02\x32\xe9\x64\x10\xda\x02\xd9\xb1\x8c\x62\x2e\x83\x91\x11\x88\xa1\x4f\x78\x46\x9d\x0b\x15\xf4\xd1\x88\x11\x7a\x7f\x31\x46\xf5\xc2\x66\xe1\x95\x5f\x5f\xe6\x74\x57\xf4\x3c\xe5\x66\xa1\x54\xbd\x29\x84\x88\x3c\x51\xc4\x37\xb3\xfb\x7f\x24\xa0\x9c\xeb\xb9\x5f\x00\x00")
 
 func ops_enhanced_revelHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -263,7 +263,7 @@ func ops_enhanced_revelHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "ops_enhanced_revel.html", size: 4867, mode: os.FileMode(438), modTime: time.Unix(1765548110, 0)}
+	info := bindataFileInfo{name: "ops_enhanced_revel.html", size: 24505, mode: os.FileMode(438), modTime: time.Unix(1792196255, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -288,7 +288,7 @@ func revelHtml() (*asset, error) {
 	return a, nil
 }
 
-var _terminalHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5b\x6f\x6f\xdb\xc8\xd1\x7f\x7f\xc0\x7d\x87\x39\x3d\x4f\x20\xe5\x39\x93\x92\x9d\x3f\xcf\x9d\x62\x19\x77\x71\x9c\x36\x45\x9a\x18\x76\xae\x68\x5f\xae\xc8\xa1\xb4\xe7\xd5\x2e\xb1\xbb\xb2\xac\x3b\xb8\x9f\xe1\x0e\xed\xa1\x40\xd1\xf6\x4d\x5f\xf4\x55\xdf\xf7\xf3\x04\x45\xd1\x8f\x51\xec\x92\x94\xc8\xe5\x2e\xa5\x38\x29\x8a\x9e\x62\xc0\x26\x39\x33\x3b\x33\x3b\x33\xbf\xd9\xa1\x72\xfc\xc9\xb3\xd7\xa7\x6f\x7e\x75\x7e\x06\x73\xbd\x60\x27\x1f\x7f\x74\x6c\x7e\x03\x23\x7c\x36\xe9\x7d\x33\x8f\x4e\x5f\xf5\xec\x4d\x24\xe9\xc9\xc7\x1f\x01\x00\x1c\x2f\x50\x13\x48\xe6\x44\x2a\xd4\x93\xde\x57\x6f\x9e\x47\x9f\xf5\x1a\xcf\x38\x59\xe0\xa4\x77\x4d\x71\x95\x0b\xa9\x7b\x90\x08\xae\x91\xeb\x49\x6f\x45\x53\x3d\x9f\xa4\x78\x4d\x13\x8c\xec\xc5\x01\x50\x4e\x35\x25\x2c\x52\x09\x61\x38\x39\x8c\x47\x1b\x59\x9a\x6a\x86\x27\xff\xfc\xcb\x9f\xff\xf1\xa7\xef\xfe\xfe\xb7\xbf\x42\x04\x17\xcf\x2e\xe0\x0d\xca\x05\xe5\x84\x1d\x0f\x8b\xe7\x25\xb1\xd2\xeb\xcd\x85\xf9\xfc\x1f\x7c\xbb\xbd\x30\x9f\x05\x91\x33\xca\xc7\x30\x7a\xd2\xbc\x9f\x93\x34\xa5\x7c\xd6\x7e\x30\x15\x37\x91\xa2\xdf\xd8\x67\x53\x21\x53\x94\xd1\x54\xdc\xd4\x88\x6e\x3f\xfe\x68\x7b\x31\x15\xe9\xda\x5d\x72\x4a\x92\xab\x99\x14\x4b\x9e\x8e\xe1\x7f\x46\xc4\xfc\x73\xd6\x48\x04\x13\xd2\x3c\x1c\x65\xd9\xc8\x55\x20\x13\x5c\x47\x19\x59\x50\xb6\x1e\x43\xff\x54\x2c\x25\x45\x09\xaf\x70\xd5\x3f\x30\x97\x5c\x09\x46\x54\xff\x00\x16\x82\x0b\x95\x93\x04\x1d\xfe\x39\xd2\xd9\x5c\x8f\xe1\x70\x34\xba\x9e\x3b\xcf\xc4\x35\xca\x8c\x89\xd5\x18\xe6\x34\x4d\x91\x87\xcc\x8a\x75\xe9\xee\xc8\x6c\x22\xa1\x1c\xa5\x6b\x65\xd7\x32\x29\x55\x39\x23\xeb\x31\x64\x0c\x6f\x5c\xf3\x18\xde\x44\x29\x95\x98\x68\x2a\xf8\xd8\xf8\x62\xb9\xe0\xee\x26\x34\x5c\xd8\x70\x51\x40\x4f\x13\xa9\x6d\x25\x1b\x72\x0e\x89\xf9\x17\x8a\x83\xc3\x51\x7e\x03\x47\xa3\xdc\xd5\x77\x13\x03\x5a\x8b\xc5\x18\x8e\xf2\x1b\x50\x82\xd1\x34\xb0\x7b\x5d\xa6\x7f\xbd\x54\x9a\x66\xeb\xa8\x4c\x8c\x31\xd8\xed\x8b\xa6\xa8\x57\x88\xae\x0b\x08\xa3\x33\x1e\x51\x8d\x0b\x35\x86\x04\xb9\x46\xb9\xdb\x0b\x36\x37\x5c\x27\xd8\x80\x52\xf4\x1b\x1c\xc3\xe1\xe3\x96\x7d\xbb\x63\x71\x55\xee\xf4\x54\xb0\x74\xb7\x0a\xd3\xa5\xd6\x82\x2b\x57\x89\x2e\xbf\xcc\x48\x3e\x86\xcf\xf2\x60\x92\xb9\xc2\x5d\xd9\xb6\xa4\x8c\xe1\xf0\xa8\x65\xdc\x26\x48\xdb\x8f\xca\x7d\x95\x24\xa5\x4b\x35\x86\x47\xa3\x7b\xae\x63\x96\x52\x19\xcf\xe4\x82\x76\x3a\x7f\xaa\x79\x24\x31\x85\x6f\x9b\xd1\x96\x65\x8f\xb2\x47\x8f\x9f\x18\xe2\x06\xe9\x1a\x19\x13\xab\x36\xf5\x34\x3d\xc2\x36\xf5\x4c\x22\x72\x97\xf8\xe8\xff\x93\xcf\x1f\x64\x4f\x82\x4e\xf2\xd4\x24\xe3\xf5\x31\x1c\x86\x82\xdf\x13\xf7\x55\xa9\x88\xd6\x63\x20\x4b\x2d\x7c\x91\x51\x06\xd5\xc3\x16\x33\xa3\x1c\xa3\x8d\xf3\xe3\xc7\xbb\x77\xd6\x70\xf8\x6b\xf7\x26\xf5\xda\xcb\x10\x4e\x17\xa4\x28\x23\x19\x49\xf1\x05\x87\x51\xfc\x40\x01\x12\x85\x11\x0d\x16\xb7\x2f\xae\x70\x9d\x49\xb2\x40\x55\x71\xb9\xce\x92\x62\x01\xdf\x82\xc8\x49\x42\xf5\xda\x40\x04\x68\x49\xb8\xca\x84\x5c\x8c\x8b\x3f\x19\xd1\xf8\xcb\x41\x64\x8a\xc6\xfd\xc6\xae\x99\x8f\x16\x75\xee\xc3\x10\xf7\xa8\xc9\xd9\x74\x4c\x2e\xc5\x22\xd7\xae\x66\x1f\x2a\x59\xe9\x02\x95\x26\x8b\x3c\x24\xff\xf1\xe3\xf0\x96\x51\x9e\x89\xb0\x5e\xd3\x2c\xcb\x82\xac\x6a\x99\x24\xa8\x5a\xb5\x21\x68\x55\x93\x7b\x45\x24\xa7\x7c\x16\xe2\xce\x32\x42\x3a\xb8\x51\x4a\xd1\xc2\x87\x2d\xef\xc3\x87\x0f\x1f\x06\x79\x73\x29\x66\x12\x95\x8a\xa6\x44\x86\x11\xb1\x6a\x35\x4c\x32\x85\xfb\x8d\xc3\x10\xc4\x8c\xe1\x70\x8b\x2d\x0f\x1e\x3c\xe8\x84\x44\xb7\xab\x08\xe8\xcb\xc8\x14\xd9\xde\x9b\x05\xed\x9c\xeb\xaa\xca\x75\xaf\x84\x4a\xf2\xa8\x55\x56\xab\xaa\xe0\x83\xda\x9d\x68\xed\xf1\x94\x37\x15\x72\xa1\x68\x51\x17\x24\x32\xa2\xe9\xb5\xdb\x24\xed\xdd\x08\x6d\x8c\xcc\x28\x6b\x79\xb2\xd6\x03\xb9\x66\xd6\x6d\x31\xb5\x8d\xc8\x68\x66\xb0\x06\xb9\x1e\x7c\x3e\x4a\x71\x76\x50\xa9\x6e\xff\x30\xa1\x7b\xdf\x11\x61\x4b\x45\x69\x86\xf5\x27\x8c\xe2\x47\x45\x75\x7b\x87\xa6\xa3\xbb\x8f\x00\x5f\x5b\xe2\xa5\xaa\x17\xfc\x36\x9a\x6e\x63\xea\xee\x55\x49\x69\xa2\x97\x2a\xa2\x3c\xa5\x09\xd1\xed\x64\xdd\x58\x49\xb9\xc5\x97\x29\x13\xc9\xd5\x93\x40\xdc\x85\x5b\x81\x60\x8b\x17\x6e\x05\xca\xa4\x90\x85\x84\xcf\xba\x70\x28\x5f\x32\x85\x70\xa4\x80\xf2\xcc\x9c\x71\x70\x97\xb9\x39\x91\xca\x53\xd5\x9c\xee\xa0\xb3\xb4\x95\x92\x12\xb1\xc8\x19\x6a\xd3\x8a\x74\x76\xd3\x9d\x45\xb6\x94\xe5\xad\x96\x8e\x4e\x5d\x25\xb3\x86\xb1\x85\x47\x1c\x51\xa3\x7b\x07\x36\x6b\x1c\xa0\x74\x80\xf4\x51\x93\x60\x14\x3f\xe8\x40\xcc\xa2\x5f\x7b\x8f\xa0\x69\xef\xeb\x26\x66\xda\x6d\x73\xb7\x4f\xa1\x19\x14\x53\x46\xf9\x15\x1c\x7a\x83\x02\xb6\xf1\xc5\x30\xd3\xf6\x84\xb1\x87\x57\x0b\x91\x1e\xaf\x3e\xfc\x7c\xb7\x53\xdb\xbe\x1f\x75\x39\x56\x2c\x16\x84\xa7\xde\x16\xad\xb3\x21\xa9\x00\xf1\xd0\x05\xc4\xa6\x7c\xa2\x12\x4a\x23\x22\xef\xd0\xed\x94\xf5\xa8\x9d\xd2\x4e\x03\x7a\x14\xd0\xac\x05\xd5\x4d\xcd\x24\x16\x07\xd5\xc0\xb9\x63\x77\x0c\x84\xcb\x62\x85\x64\x5c\x70\x37\x1a\x76\x1e\x49\xbb\x26\x04\xa1\xa9\x40\x77\xbf\x1e\x3e\xeb\x6c\xbd\x15\x69\x91\x7b\x61\x7b\xff\x0a\xef\xf8\x73\x3c\x37\x30\xbc\xc3\xab\x5d\x95\x6f\x3c\x8e\x56\x38\xbd\xa2\x3a\x52\x89\x14\x8c\x75\x35\x22\xe1\xac\xf2\x48\x89\xb4\x24\x49\x2b\xbd\xde\xa1\x05\xf3\xca\x9c\x2f\x17\xd3\xf7\xa8\xcd\x41\x99\x77\xf4\xa3\xf9\x75\x3c\xac\x26\x68\xc7\xc3\x72\xd8\x77\x6c\xce\x8f\xd5\x7c\x2d\xa5\xd7\x90\x30\xa2\xd4\xa4\xd7\x1e\x09\xf5\x6a\x83\x37\x2f\x65\x31\x94\xa9\x93\x05\x49\xed\xe4\xc2\xa5\xb4\xd4\x2a\x27\xbc\x22\x6f\xb5\x08\x4d\x10\xed\x01\x4d\x2b\xa2\x17\x15\x4d\xef\xe4\x78\x68\x64\x78\x64\xd7\x07\x8b\x10\xc1\x79\x09\xc5\x17\xcf\x9e\xc2\x73\xca\xd0\x51\x7b\x98\xd2\xeb\x7d\x4c\x29\x27\x20\x5e\x63\xc2\xf4\x50\xce\x11\x8c\xba\xed\x85\xf6\x61\x2e\x26\x0b\x77\xe7\xb7\xb3\x06\x3f\xbb\x7b\xaf\xba\xde\xb1\xff\x26\x92\x8a\x3d\xa9\x6e\x3d\x35\x77\x3a\x9c\xb8\xc1\x02\x43\xf4\xf6\x87\xdf\xbe\xfd\xe1\xbb\xff\xe6\x9f\xdf\x19\x23\xbe\x77\x37\xa2\x0a\xbd\x08\x2e\x30\xa5\x0a\x9e\x11\x4d\xe0\x02\xaf\x91\x30\x1f\xe9\xdb\x1f\xbe\x0f\x8a\xb1\x71\x0a\x5f\x72\xc2\xd6\x8a\x2a\xb8\x5c\x2b\x8d\x0b\x2f\x61\x25\xe6\xf7\xff\x69\x9f\xbc\xe7\xcf\x1f\xfd\x91\x19\x0e\xa9\x7a\xfb\xb2\xb3\xc0\x14\x63\x97\xde\x89\x14\x42\x7f\x21\x53\x39\xfe\xf5\xff\x96\xd5\x03\x64\x2a\xc1\xd4\x19\x2c\x39\x4c\x58\x67\x94\x21\x27\x0b\x0c\xd4\x98\xdd\xca\xf9\x27\x0b\xbb\x4a\x47\xf3\x7c\xef\xa3\xde\x1a\x66\xd4\xac\xe8\x5f\x16\xe4\x2f\x8a\x77\x2f\xf6\x25\x47\x1c\xc7\xc1\xf2\xb8\x4f\x21\xa9\x1b\x10\x54\xc4\x47\x6f\xce\xd3\xbd\x86\x76\xcf\xed\x1d\x8b\x47\xe5\x3b\xa3\x31\x8c\xee\x85\x84\xfa\x2d\x7c\x83\x37\xba\x77\x32\xba\x17\xb4\xa9\xd3\xae\x8e\xca\xe7\xd9\x42\xb3\x2e\x13\x33\xb5\x2d\x99\x01\xa2\xf2\x60\x46\x05\xbf\x2c\xde\x7a\x6c\xac\xdc\x9c\x4e\x6c\x1b\xb8\x37\x62\xd8\x4e\xbc\x1c\xa6\x85\xfc\xf3\xa3\x28\x9e\x7e\xc3\xbc\x95\xf0\xed\x1f\x7e\x03\xe7\x5f\x5e\x5c\xbe\x78\xf5\x13\x38\x7d\xfd\xf3\xf3\x97\x67\x6f\xce\x9e\xf9\x08\xa1\xaa\x85\xfe\x07\x3f\xb2\xfa\x08\x7b\xe6\x71\x23\xb6\x82\x89\x5c\x76\x0b\x25\x8f\xd3\xce\x17\xd9\x7c\x4d\x71\x75\x81\x6a\xc9\xb4\x7a\xaa\x79\x57\xf6\xfe\x82\xe2\x6a\x0b\x5d\x25\x0f\x9c\x04\x93\xb6\x58\xe5\xfd\xf3\x76\x5f\x8b\xbd\xc0\x50\x15\xfa\xc6\xc3\xe2\xf8\xb4\x03\x04\x7c\xd7\x4d\x2d\x8f\x55\x22\x69\xae\x6b\xb4\x89\xe0\x4a\x43\x85\x32\x30\x81\x15\xe5\xa9\x58\xc5\x4c\x24\x76\xb4\x10\xe7\x44\xcf\xcd\xa3\x58\xe5\x8c\xea\x41\x7f\xd8\xbf\x1f\xe7\x22\x1f\xd4\x67\x89\xa9\x48\x96\x0b\xe4\x3a\x9e\xa1\x3e\x63\x68\xfe\x7c\xba\x7e\x91\x0e\xfa\x95\xdc\xfe\xfd\x58\xe3\x8d\x3e\x2d\xe6\x7f\x30\xd9\x2c\xf8\xa4\xee\xbf\x42\x97\x7a\x23\x07\x93\xb0\xec\x3a\x5d\xbf\xae\x4d\x21\xc6\xd4\xcd\xd3\xcd\x20\xbd\x43\x8e\x21\xf4\xf0\xd7\x31\xa3\x8b\xbd\x4e\xd7\x21\xc6\xc0\xc6\x3e\x62\x0c\x5d\x87\x18\x8b\xaf\xfb\xc8\xb1\x84\x1e\x41\xce\xd1\xa5\x4b\x94\x43\xea\x11\xd6\x82\x9d\x2e\x71\x2d\x62\x8f\xc0\x66\x6a\x77\x49\x6b\x52\x5a\x51\x5b\x61\x0c\xb5\x7d\xa3\x78\x69\x4f\xb1\x30\x01\x2d\x97\xcd\x50\x1b\x0e\xe1\xcb\x34\x35\x41\x62\x07\x39\xdb\x07\xd9\x92\x17\x96\x90\x34\x7d\x29\x66\x83\x05\x2a\x45\x66\x78\x00\x7a\x9d\x9b\xf4\xe8\x53\x9e\x89\xfe\xfd\xf6\x20\xc9\xc6\x9c\x41\xcd\x9a\xd2\x89\x44\xa2\xb1\xd4\x7b\xd0\x4f\xe9\x75\xdf\x1d\xc1\x1b\x96\xd8\x26\xf9\xab\x22\xff\xfa\x4d\x0c\xee\xc3\xa7\x76\x69\x1f\x5b\x33\xa5\x4a\x4d\x5d\xc2\x7a\x1a\xc4\x24\xcf\x91\xa7\xa7\x73\xca\xd2\x81\x11\xd1\x74\x9b\xf9\xd0\x0c\x06\x5b\xd7\xb5\xec\x34\x9f\x7a\xe2\xc5\xc5\xa0\xe0\x8d\xc8\x8d\x97\xdb\x0f\x7e\x6a\x87\x36\x8e\x4e\xa1\x39\xe0\x70\x08\x5f\xe5\x29\xd1\xb8\x09\x77\xcf\xbe\x2c\x2d\xc5\x79\x49\x30\xa8\x28\x0f\x40\x69\xcc\x5b\xfa\xd6\xb3\x33\xb6\x2d\x51\x5c\xbc\xe8\x98\x6c\x1e\xc1\xa7\xd0\xbf\xd7\x77\x27\x64\xb5\x74\x74\xfc\xdc\xc9\x67\xdc\xe7\x55\xa4\x2e\xd4\xe6\xa6\x23\xd5\x30\xed\xef\xa6\xd3\x39\x26\x57\x66\xb1\x15\x82\x9a\x8b\x25\x4b\x61\xa9\x10\x2e\x2f\xcf\x40\x48\xc8\x05\x63\x94\xcf\xdc\xe4\x5a\x2a\x34\x04\x65\x36\x18\x31\x97\x28\xaf\x51\x46\x97\x46\x85\xb3\x6b\xe4\x5a\x41\x26\x24\x48\xb4\xe3\x92\x05\xda\xe8\xa9\x2f\x6d\xcc\x2b\xc4\xb4\x0c\x34\x9b\x67\x54\xd8\x25\xb2\xd8\x3f\xe5\x4b\x1f\x34\xf4\x97\x62\x29\x13\x93\x08\x1c\x57\x85\x84\xe2\xce\xa0\x3f\x24\x39\x1d\x2a\x2d\x91\x2c\x86\x26\x2d\x2a\x0c\x69\x07\x71\x4d\x50\x2c\x78\x99\x18\x06\x75\xca\x20\x1a\x58\x02\x7f\x70\xcb\xd6\x37\x1b\x9a\x4a\xa6\xe6\x28\x3d\x81\x9f\x5d\xbe\x7e\x15\xdb\xc3\x5a\x21\x2c\x36\xf7\xdb\x9a\xd4\x1d\x67\x28\xe2\xa2\x8c\x4c\x26\x60\x70\xa7\x5d\x47\xea\x9f\xb2\x02\x59\xb6\x4d\x19\x2a\xeb\xcf\x13\x3f\xdb\x2d\x20\x53\xe8\x5b\xad\x8a\xbd\xee\x25\x9d\xe4\xb2\x32\xb6\x19\x66\x2f\x0b\x54\x08\x5b\x0a\x8d\x44\x2e\xa8\x61\x33\x4e\x0b\xf3\x38\x68\xd3\xac\x89\xee\x54\xce\x4d\x3b\xaf\xab\xcb\xb5\xad\xf9\x9b\x37\x57\xdd\xf6\x07\x15\x79\x49\x95\x8e\x49\xba\x41\xc5\xa8\x26\xb0\x43\x17\xb0\x61\xe3\xe0\x5e\x59\x87\xca\x93\x99\x31\xcf\xbe\x32\xea\xb2\x09\x9c\xa0\x4e\x98\x50\x38\xe8\x5a\xd9\x8d\x84\xba\x33\xec\xab\xb7\x0f\xe5\x88\x52\xd8\x0e\xe5\xcb\x58\xee\x9f\x5d\x5c\xbc\xbe\x18\x6f\xe6\xa0\x19\xa1\x0c\xd3\xfe\xc1\x46\xa7\x7f\x87\x0f\x3e\x88\x99\xe5\xfc\xb7\x53\xc3\xdb\x40\x52\xb6\x6f\xdf\x42\x42\x74\x32\x87\x01\x06\x77\x21\xb1\xdf\xc3\xc4\xe2\x5b\x25\x83\xfe\xb9\x9d\x0b\xd9\x8b\x71\xff\x00\xd0\xa7\x87\xb3\xd0\xed\x8e\xba\x58\xbc\x81\xad\x55\x45\xaf\x2e\x95\x1e\xcc\x6c\x9f\xc1\x8f\x44\x70\x5e\x76\x7b\x4c\x28\x7d\x00\x6a\x45\x75\x32\x37\xdb\xa9\x45\x85\x3d\x71\x1c\x7b\x5d\xb5\xdf\x06\x2a\x4d\xa4\x3e\x2f\x24\xb5\x08\x6e\xeb\xef\x18\xbc\x3b\x1c\x64\x77\x61\xf4\x39\x61\x6c\x4a\x92\xab\x31\x9c\xbb\x90\xb9\xe9\x37\x9a\xc2\xfc\x7d\x9f\x31\xfa\x05\xd7\x28\xaf\x89\xe9\x36\x15\xea\xea\x6a\x40\xd4\x9a\x27\x30\xb8\x0f\x93\x93\x3b\xc1\x8d\x44\x95\x0b\xae\x4c\x15\x24\x2b\x42\x35\x64\xa8\x93\x79\x09\x86\x55\x69\x6e\xc1\xa1\x4f\x9e\x29\x05\x9f\x54\xe2\x62\x71\xd5\x99\xff\x09\x43\x22\x37\x46\xd4\xed\xeb\x4a\x80\xe1\xd0\xce\x9b\xcd\x89\xdd\xc4\x82\xb4\xfd\x39\xe4\x64\x86\x61\x1e\xf7\xbc\x39\x97\x98\x99\x9a\x38\xa4\x5c\x69\xc2\x13\x6c\x18\xd7\xb1\xb8\x44\xbd\x94\xee\x77\x5c\xab\xcf\x6d\x08\xae\x1a\xa0\x5e\x78\x78\xe3\xa3\xaf\x95\x49\x8a\x20\xd2\xed\x01\x96\xc9\x52\x4a\x13\xf0\xa6\x23\xdc\xdd\x1b\xdc\x01\xb0\xee\xb6\x4f\x1f\x02\x92\xde\x09\xaf\xa1\x85\x9a\x7b\x36\x30\xef\x08\x5b\x77\x73\x87\x03\x4e\x26\xde\xec\xe2\x76\xc1\xbd\xb0\xe9\x4e\xce\x28\xc4\x86\x03\xb6\xed\x9b\x0a\x34\x0c\xe3\xde\xc0\x51\x94\xae\x1a\x74\x58\xee\x3d\xe0\xc3\x7e\x87\x63\xd4\x55\x3f\xed\x7c\x4d\x96\x63\xb5\x62\x78\xb6\x7d\xde\x3c\xa1\x1b\x30\xb5\xdd\xbc\x41\x56\xe4\x28\x07\xfd\x84\xd1\xe4\xaa\x7f\xe0\x2f\x8e\x77\x2e\x0b\xb7\xf7\xdd\x83\x7e\xf9\x32\xa2\x3c\xcc\x54\x0f\xaa\x4d\x9f\x4c\x4e\xa0\xfe\xba\xc2\xbe\x9b\xb5\x8d\xbd\x34\x20\xe6\xeb\xb8\xeb\xac\x2f\x05\x49\x6d\x27\x43\x19\x16\xa1\x53\xe9\xb4\x8b\xf3\xd2\x00\x8b\x61\x4d\x31\x11\xa9\x3d\xf3\x26\xa8\x94\x6f\xd1\xe3\xe1\x66\x6e\x77\x3c\x2c\x5f\x9e\x1f\x0f\xcb\xff\x57\xf3\xaf\x00\x00\x00\xff\xff\xba\xb0\x68\x4b\x69\x33\x00\x00")
+var _terminalHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdd\x5b\xdd\x72\xdc\xb6\x15\xbe\xf7\x53\x20\x6a\x95\x5d\xd5\x22\x77\x25\xdb\xaa\xb3\xfa\x49\x6c\x49\x4e\xd5\x3a\xb6\x46\x52\x3a\xed\x64\x72\x81\x25\xc1\x5d\x44\x5c\x92\x43\x62\x25\x2b\xb6\xfa\x0c\xe9\xb4\x99\xce\x74\xda\xde\xf4\xa2\x57\xbd\xef\xf3\x64\x3a\x9d\x3e\x46\xcf\x01\x40\x2e\x09\x02\xdc\xb5\xa2\x4e\xa7\xa1\x9d\xcd\x2e\x79\xce\xc1\xc1\x87\xf3\x0b\xd0\x7b\x1f\x1c\xbd\x3e\xbc\xf8\xf5\xe9\x31\x99\x8a\x59\x7c\xf0\x60\x0f\xff\x47\x62\x9a\x4c\xf6\xd7\xbe\x9e\x7a\x87\xaf\xd6\xf0\x1e\xa3\xe1\xc1\x03\x02\xd7\xde\x8c\x09\x4a\x82\x29\xcd\x0b\x26\xf6\xd7\x3e\xbf\x78\xe1\x3d\x5d\xab\x3f\x4a\xe8\x8c\xed\xaf\x5d\x71\x76\x9d\xa5\xb9\x58\x23\x41\x9a\x08\x96\x00\xe9\x35\x0f\xc5\x74\x3f\x64\x57\x3c\x60\x9e\xfc\xb1\x49\x78\xc2\x05\xa7\xb1\x57\x04\x34\x66\xfb\x5b\xfe\xb0\x14\x25\xb8\x88\xd9\xc1\xbf\xff\xf6\xd7\x7f\xfd\xe5\x9b\x7f\xfe\xe3\xef\xc4\x23\x67\x47\x67\xe4\x82\xe5\x33\x9e\xd0\x78\x6f\xa0\x9e\x2b\xda\x42\xdc\x94\xdf\xf1\xfa\x09\x79\x5b\x7d\xc7\x6b\x46\xf3\x09\x4f\x46\x64\xb8\xdb\xb8\x9d\xd1\x30\xe4\xc9\xa4\x75\x7f\x9c\xbe\xf1\x0a\xfe\xb5\x7c\x34\x4e\xf3\x90\xe5\x1e\xdc\x5a\xd0\xdc\x3e\x78\xb0\x20\x0d\x6f\x8c\xc1\xc6\x34\xb8\x9c\xe4\xe9\x3c\x09\x47\xe4\x47\x43\x8a\x7f\x9a\xe2\x83\x34\x4e\x73\x7c\x36\x8c\xa2\xa1\x31\x74\x04\x50\x79\x11\x9d\xf1\xf8\x66\x44\x7a\x87\xe9\x3c\xe7\x2c\x27\xaf\xd8\x75\x6f\x13\x7f\x26\x45\x1a\xd3\x02\xbe\xcf\xd2\x24\x2d\x32\x1a\xb0\x26\xfb\x94\xf1\xc9\x54\x8c\xc8\xd6\x70\x78\x35\x6d\x3e\x4a\xaf\x58\x1e\xc5\xe9\xf5\x88\x4c\x79\x18\xb2\xc4\x3a\x1d\x5f\x68\x78\x3d\x5c\x33\xca\x13\x18\xfc\xed\xaa\x23\x84\xbc\xc8\x62\x0a\x7a\x47\x31\x7b\x63\x4c\x0b\xee\x78\x21\xcf\x59\x20\x78\x0a\x0b\x01\x10\xcc\x67\xc9\x6e\x07\x6e\x75\x60\xac\x0a\xa2\x3d\xb6\xb4\x6b\xc8\xd8\xa2\xf8\xc7\xb1\xe4\x5b\xc3\xec\x0d\xd9\x86\x0f\x73\xe9\xf5\x72\x0b\x91\xce\x46\x64\x1b\x88\x00\x72\x1e\xda\x57\xab\x63\xc6\x5f\xcd\x0b\xc1\xa3\x1b\x4f\xdb\xfe\x88\xc8\xd5\xf2\xc6\x4c\x5c\x33\x66\xcc\x9c\xc6\x7c\x92\x78\x5c\xb0\x59\x01\xd0\x00\x35\xcb\x97\x4c\x5e\xda\xbe\x31\x77\x69\x3a\x60\xb6\x0c\x26\xb7\x63\xce\x6b\xa9\xcd\x5d\xeb\x75\x1d\xa7\x71\xb8\x64\xf0\xf1\x1c\xc0\x49\x0a\x63\xf8\x0e\x2c\x26\x34\x1b\x91\xa7\x99\xdd\x83\x4c\xb9\x86\x58\x19\x25\x60\x46\xdb\xe6\x8c\x2a\x43\x6c\x3d\xd1\x6b\x98\xd3\x90\xcf\x01\xd0\x27\xc3\x75\x03\x8b\x79\x5e\x20\x18\x59\xca\xdd\x50\x8f\x45\xe2\xe5\x2c\x24\x6f\x9b\x36\x15\x45\x4f\xa2\x27\x3b\xbb\x40\xda\xa0\xbc\x61\x31\xb8\x56\x9b\x78\x1c\x6e\xb3\x16\xf1\x24\x07\x0b\x30\x69\xb7\x7f\x1a\x7c\xf4\x28\xda\x75\x20\xd3\x8e\x32\x88\x32\x4c\xde\x61\xdd\x6d\xc3\x2e\xdd\xdf\x83\x15\xa2\x73\x91\x5a\x4c\x40\xdb\xce\x63\x93\x35\x86\x30\xe0\x55\x70\xfb\x3b\x4b\x96\x11\xc9\xad\xf1\xb7\xf2\xaa\xd6\x08\x34\xe1\x33\xaa\x22\x43\x04\x5e\x7d\x92\x90\xa1\xff\xa8\x20\x8c\x16\xcc\xe3\xf6\x48\xf5\xc9\x25\xbb\x89\x72\x48\x34\x45\xc9\x62\xe0\x93\xa7\x33\xc0\x38\x05\xaf\xe3\xe2\x06\x43\x3c\x11\x39\x4d\x8a\x28\xcd\x41\x03\xf9\x35\xa6\x82\xfd\xaa\xef\x61\x24\xd8\xa8\x2f\x12\x5e\x22\xad\x33\x6f\xb9\x98\x87\x0d\xc6\x3a\x1c\x19\x8c\x9f\x09\x43\xa9\xfb\xf0\x42\x0e\x53\x16\x74\x96\x39\x44\xef\xec\x38\xd6\x87\x27\x51\xea\x54\x67\x1c\x45\x91\x9d\xad\x98\x07\x01\x2b\x4c\x67\x77\x4d\xa4\xce\x79\x4d\xf3\x04\x8c\xd1\xc1\x19\x45\x94\xba\x38\x59\x9e\xa7\x66\x64\x5f\xf0\x3d\x86\xcb\xce\x07\x98\x83\x6f\x15\x85\x37\xa6\xb9\x33\x81\x95\xb5\x00\xba\x88\xb3\x20\xd8\x72\x24\x06\x78\xb2\xc8\x08\x8f\x1e\x3d\xea\xca\x60\x46\xe6\xb7\x2a\x1a\xd3\x31\x8b\x57\x5c\x95\x85\xf2\x95\x23\x39\x63\x6a\x1d\x09\x47\x40\x1d\x9a\x61\xb1\xf4\x70\x4b\x52\x5c\x96\x55\x2d\xe0\xd8\x4c\x3c\x4b\x0b\xae\xbc\x3c\x67\xe0\x3f\xfc\xca\xa8\x5e\x56\x2b\x51\xaa\xb9\x45\x3c\x36\xb1\xab\x55\x27\xeb\xee\x39\x60\x84\x02\x0b\x99\x60\x86\x80\x6c\xdb\xff\x68\x18\xb2\xc9\x66\xa9\xb3\xfc\x82\xc6\xb9\xd1\x94\x20\xdd\x5e\xeb\x2f\x41\x84\x20\xf5\x44\x05\xa9\x95\xab\x82\xce\x4c\x8f\x57\xab\x6c\xb0\x11\xd5\xa3\x75\x2b\xfb\x2d\xcc\xe7\x6e\x01\x06\x82\x8b\x98\x17\x10\x76\x43\x1e\x50\xd1\xf2\xc3\x6a\x72\x3c\x91\x79\x61\x1c\xa7\xc1\xe5\xae\xdd\xc2\x9c\x29\xdb\x55\x76\x39\x53\xb6\xb6\xfb\x5c\xf1\x3f\xed\xc8\x1f\xd9\x3c\x2e\x18\xd9\x2e\x40\xbf\x08\x5b\x0b\xd6\x39\xcb\x0c\x7a\x98\x76\x8c\x32\x92\xb8\x3b\x50\x69\x29\x01\x84\xfa\x98\x09\xac\x16\xba\x2a\x5a\x77\xa8\xd4\x72\x6c\x71\xcf\xd0\xc5\x19\xfc\x6a\xf9\x50\x41\xd0\x14\x33\x5c\xdf\x94\x4e\x61\x64\xb5\x66\xd2\x7b\xd2\x7c\x0e\x39\xd8\x95\xdd\x54\x09\x75\x67\xd3\x68\x2d\x60\x65\x19\xad\xc2\xb5\x13\x43\xbc\x6a\x6b\x3f\x86\x61\x2f\xc9\x96\x6d\xed\xf1\xd2\x46\x14\xb3\x48\xc8\xca\x7e\x19\x8e\x4a\x5c\x1b\xc7\xc7\x1f\x2d\x85\xb1\x0d\xf6\xd0\x09\x65\x3a\x9b\xd1\x24\xb4\x95\x4d\x5d\xe5\x42\x99\xc6\xb6\x8c\x34\x56\x17\x4d\x8b\x80\x73\x8f\xe6\xef\x5d\x86\xe8\xd8\xd2\x72\x53\xa3\x12\xdc\xb6\xab\x64\x66\xd6\xba\x4a\x50\x52\xcb\x2e\xd0\x5e\xec\x2f\x5d\x6c\x67\x70\x2b\x33\x50\x92\x26\xc6\xaa\x2f\xeb\xf8\xba\x3a\x6e\x47\x97\xdd\x59\x2d\x3b\xbb\x8b\x05\x44\x9e\x48\x33\x5b\x9e\x5d\x31\x42\x1b\x18\x8e\xa6\x98\x37\xbb\x91\x74\x86\xb0\xd1\x08\x06\x1c\x5f\x72\x98\x4f\x90\xa7\x71\xdc\x51\x2f\x38\x1c\xc6\x22\xc1\x83\x54\x19\x98\x9e\xb3\x6a\x71\x64\x95\x37\x9d\xcf\xc6\x77\x0c\xae\x4e\x79\x77\xc1\x0d\x3f\xf7\x06\x7a\xb7\x69\x6f\xa0\xf6\xc4\xf6\xb0\x3d\xd3\x1b\x51\x21\xbf\x22\x41\x4c\x8b\x62\x7f\xad\xbd\x95\xb2\xb6\xd8\xa1\xb2\x12\xaa\x2d\x8d\x1a\x95\x93\x52\xf6\xff\x06\xa1\x24\x06\x6b\x4d\x4a\xea\x56\x26\x6f\x26\xbd\x35\xc2\xc3\x92\xe8\xa4\xa4\x59\x3b\x80\xe9\x81\x8c\xb6\xe8\xfa\xe6\x1b\xf1\xc8\xa9\xce\x9c\x67\x47\xcf\xc9\x0b\x1e\xb3\xa6\xce\x03\x50\x7a\x85\x69\xe8\x9d\x04\xdb\x44\xdc\xe4\x44\xb7\xe6\xa8\x6a\x6b\x98\x55\x78\x55\xb3\x7e\x67\x76\xd9\xbe\x5b\xb9\x8d\x5b\xfa\x67\xf7\x9a\xa3\xed\xa8\x95\x28\x6f\x3d\xc7\x3b\x6e\xf0\xaa\xa0\x0e\x34\xdf\x7d\xfb\xfb\xef\xbe\xfd\xe6\xff\xf9\xef\x1f\x60\x0e\xbf\x35\x57\xa0\x34\x37\x8f\x9c\x41\xa8\x2b\xc8\x11\x15\x14\xbe\x5e\x31\xb0\x3c\xcb\x05\x12\x5c\x52\xa4\x69\x92\x67\x80\xea\x4d\x01\x82\xce\x6f\x0a\x28\xbd\xad\x84\x5a\xca\x1f\xff\xd7\x80\x7c\xcf\xbf\x7f\xb6\x19\xa4\xd3\x96\xea\xb5\xc7\xb2\x68\xa2\x36\x34\xd6\x0e\xf2\x34\x15\x9f\xe4\x61\x3e\xfa\xcd\x8f\x75\xa8\x20\xf0\x8b\x60\x50\x61\x9a\x03\xad\x19\x9a\x33\x86\xe7\x00\xf6\x80\xb2\x4c\x31\x7b\x27\xbf\x24\x4e\x34\xbb\x6a\x0b\xf1\x62\x4e\xa8\x61\x49\xfe\x52\x51\x9f\xa8\x73\x08\xb9\xed\xef\xfb\xbe\x2b\x0c\xae\x10\x34\xea\xca\xbb\xb4\xb0\x91\x63\x3f\xbb\xd6\x50\xed\x85\xbc\x23\xd3\x8d\x3e\x3c\x81\x42\x72\xdd\x21\xd3\x3e\xbb\x0b\xf6\x06\x16\x6d\xb8\xee\x9a\x4f\xd7\x9c\xde\x63\xaa\x0a\xf2\xc6\xc8\xe7\x90\x59\x8a\x4a\xfb\xb2\x38\x7c\x8a\xb5\x21\xfe\xd9\x75\x07\x60\x1d\x6c\xf5\x18\x46\xb9\xa3\x06\x09\x68\x12\xb0\xf8\xb9\x48\x8c\x01\x54\x69\x25\x8b\x95\xb5\x83\x43\x49\xb4\x37\x50\x8c\x2b\x5a\x20\x4a\x8f\xd3\x49\x51\xa9\x67\x27\xd1\x3d\x1f\xf4\x1d\xe7\xea\x50\xa3\x52\xa4\x6a\x83\x64\x2d\xba\x6a\x6e\x93\xe5\xbf\xde\x6c\x73\x2c\xf0\x0f\x21\xda\xdb\xe7\x65\x8d\xdd\xdf\xfd\xe9\x77\xe4\xf4\xd9\xd9\xf9\xc9\xab\x4f\xc9\xe1\xeb\xcf\x4e\x5f\x1e\x5f\x1c\x1f\xd9\x08\xb5\x0c\x87\xec\x1f\x56\x44\x97\xf6\xf3\x1e\x75\x8b\x23\xb2\x4b\xfa\x15\xdc\x0c\xcf\x70\xcf\x58\x31\x8f\x45\x81\xbe\xe6\x0e\x3c\xbf\x04\xc2\x45\x9e\xd5\x2c\xe4\xc0\x15\x6f\x6c\x1e\xe9\x98\xda\xb2\x54\xb1\x6c\xaa\xd6\x24\x56\x26\xa5\xc6\x43\xd5\xb9\x75\x26\x2c\xcb\xcf\xba\x7e\x7b\xd0\x62\xf0\x4c\x2c\xe8\x20\x75\x15\x82\x94\xb9\x90\xec\x43\x37\x95\x84\xe9\xb5\x1f\xa7\x81\xdc\xb3\xf0\x33\x2a\xa6\xf8\xc8\x87\x98\xc1\x45\xbf\x37\xe8\x6d\xf8\x59\x9a\xf5\x6b\x7b\x8f\x61\x1a\xcc\x67\x2c\x11\xfe\x84\x89\xe3\x98\xe1\xd7\xe7\x37\x27\x61\xbf\x57\x8a\x05\x16\x01\x51\xfe\x50\x6d\x19\xc2\x20\xe5\x83\xdd\x07\x86\x22\xf5\x12\x13\xe8\x9c\x92\xeb\x74\xbd\x9a\x2a\x4a\x0a\x46\xc7\xc3\x6a\x77\xbd\x43\x0c\x12\xb6\xd9\xeb\xc9\xad\x8b\xbb\x4e\xe7\x96\x82\xf9\x6d\x15\x29\x48\xe7\x96\x22\x6b\x80\x55\xc4\x48\xc2\xb6\x1c\xa3\x8b\xea\x92\x64\x90\xb6\x65\xb5\x32\x4b\x97\xb4\x16\x71\x5b\x5e\xd3\x87\xbb\x84\x35\x29\x51\x52\x25\x0a\xc6\x90\xc7\x86\xe7\xb2\x87\x06\x21\x22\x9f\xd7\xed\x6b\x30\x20\xcf\xc2\x10\x4d\x43\x6e\x12\x55\xf7\xa3\x79\xa2\xe6\x40\xc3\xf0\x65\x3a\xe9\xcf\x00\x43\x3a\x61\x9b\x44\xdc\x64\xe8\x0f\x3d\x3c\x96\xea\x6d\xb4\x36\xa8\xa4\x9d\x61\x4a\xac\xa9\x1b\xe4\x8c\x0a\xa6\x35\xee\xf7\xc0\xed\x7a\x1b\xed\xfd\x29\x5f\x7a\xf3\x2b\xe5\x6e\xbd\x66\x7e\xed\x91\x87\x72\x60\x0b\x57\xd3\x83\xb4\x9a\x06\x5d\xdd\xee\x7d\x9a\x65\x2c\x09\x0f\xa7\x3c\x0e\xfb\x28\xa1\x8e\x16\x5e\x3c\x22\xfd\x05\x62\xe6\x0c\xf1\xaa\x7b\x99\xaf\x36\x27\x2e\xd2\x0c\xb1\x6d\x3f\xf8\x99\xdc\x15\x6a\xea\x63\xdd\x4f\x84\x85\xf8\x3c\x0b\x01\xa7\xca\xb6\xdb\x6b\x31\x97\x04\xa7\xfa\x79\xbf\x24\xdc\x04\x33\x66\x99\xa9\x69\xdd\x0d\x7d\x59\xe2\xf8\xea\xf4\x63\xbf\x7a\x04\xa8\xf6\xd6\x7b\xbb\x56\x36\xf4\x3b\x03\xdc\x2e\x36\x44\xcd\xa6\x45\x5d\xa4\xf4\x41\x43\x26\xf2\xac\x80\x4e\x05\x01\x9e\xe4\x52\x30\x7b\xc1\x8a\xfe\x18\x3f\xed\x26\x38\x87\xa6\xa0\x00\xf1\x5f\xf4\x9e\xe3\x0b\x38\xbf\x90\x9f\x9f\xc9\xcf\x4f\xe5\xe7\xc5\xf3\xde\x97\x86\x95\x80\xa7\x70\x60\x31\x36\x28\xaf\xa7\xd8\x89\xaa\xb1\xc8\xc1\x3e\x94\xa8\xdb\x8f\xc9\x87\x1f\x02\xe9\x9e\x1a\xc5\x87\x98\x3d\x01\x58\x3d\xb2\x65\x9b\xbc\x62\x1c\x28\xc6\xdd\xd6\x63\xfe\xf0\xa1\x6b\xfa\x78\xe5\x4c\xcc\xf3\x44\x09\xf1\x45\xfa\x82\xbf\x61\x61\x1f\xb4\xdc\x07\x3d\xc9\xc7\xf0\xdf\x08\x47\x85\xf5\x90\x2e\x22\xf5\xf9\x82\x7f\x69\xdd\x4f\x33\x20\x3c\x9a\xe7\x32\x93\xf5\x0b\x06\x90\x85\x2d\x1c\xf5\x6d\x00\xe4\x33\x48\x75\xbe\xdc\x5a\xab\x68\xcd\xcd\x5d\x84\x7c\x5a\x92\x46\x71\x9a\xe6\x25\x29\x19\x90\x47\x3b\xad\x23\x39\xc5\x31\xb3\x73\xac\x4b\x0e\x60\xdc\xb1\xb3\xa1\x4e\x0b\xda\x1d\x63\xbd\x34\x62\xfd\x29\x39\x90\x10\x4d\x11\x9d\x69\x0f\x80\xea\xf5\x10\x29\xfd\xe0\xdd\x3b\x18\x5e\x51\xcc\x90\x62\xb6\xa0\x90\xf6\x5d\xf4\xac\x20\x2e\xbc\x54\xad\x2b\x98\x36\x16\xfe\x2c\x84\xc0\x38\x05\x8c\x26\xd3\x6c\x0e\x01\x37\x09\xc9\xf1\xc5\x33\x97\x03\xcb\x06\xab\x0f\xdf\xa8\x09\x3a\x7a\x11\xde\xd7\x07\x4b\xe4\x03\x58\xe7\x1e\x86\x2b\xe8\x6d\x7b\x68\x75\xed\x87\x6a\x3b\xaf\x15\x89\xf1\x72\x27\x9f\xb2\x09\x83\x0a\x44\x45\x06\xdd\xfb\x60\xe4\xc5\xee\xa7\xd7\x65\x93\x95\x92\x12\x82\x33\x46\x43\x69\x8f\x60\x1f\x2c\x82\x78\x1a\x6e\xe8\x35\x68\xbb\x97\x50\x39\xbf\xee\xc3\x4d\x41\x1b\xed\xa0\x22\x09\x44\x2a\x20\xaa\x2a\x17\x24\x43\x7b\x4c\x06\xd1\x0f\x41\x7d\xb0\x1b\x74\x85\xd6\x18\x0b\x11\x1b\x5d\x93\x5b\xc8\x79\x27\xe5\x48\xde\x4b\x76\x23\x8d\x82\xc8\x2f\xea\x41\xcd\x2b\x2a\x9a\x53\x96\x43\x36\xdf\xa8\x48\x07\x9a\xb8\xa5\xad\x1d\x81\x1a\xfb\xa0\xb0\xc4\x57\x49\x89\xef\x69\x42\x18\x5a\x02\xc2\x3b\xb4\xbf\x1a\x10\x95\xb7\x97\x32\x3a\x41\xa8\x06\x03\xeb\xc2\xbd\x95\x65\x63\x41\xb7\x77\x71\xf2\xec\xe5\xc8\x8e\x7c\x71\xc9\x21\xe5\x86\x0a\x7b\x89\x8d\xbe\x63\x43\x06\x2f\xbd\x60\x39\x78\x0d\xc5\xc3\xd9\x8f\x09\x44\xec\xea\xa7\x76\xd4\x2e\xfd\x97\x56\x83\xd2\x03\x5b\xe5\x37\xfe\x72\x79\xfd\xe1\x94\x05\x97\x08\xcc\x35\x74\xfb\xd3\x74\x1e\x87\x64\x5e\x30\x72\x7e\x7e\x4c\xa0\x6c\xcc\x20\xd3\x83\x17\x1a\x05\x1c\x10\xe0\x73\x5d\x73\xa1\x94\x73\x96\x5f\xb1\xdc\x3b\xc7\xf1\x8e\xaf\xe0\xb3\x40\xb8\xc0\x5f\xe4\xd1\x00\x54\x3e\x58\xaa\x2c\xc6\xc5\x75\x50\x42\xcc\x15\xc0\x38\x84\xc3\x2f\x93\xa7\x02\x4e\x61\x89\xa2\x0c\xc9\xcf\xd3\x79\x1e\x60\xbd\x95\x40\x17\x78\xbc\xb8\x03\xfd\x0c\xcd\xf8\xa0\x10\x20\x69\x36\x90\xab\xaa\x5b\x13\xb3\x5a\xaa\x89\xf1\xd3\x44\x97\x5f\xe8\xe3\x3a\xe4\xf5\x25\x81\xd5\x80\x72\xf3\xd5\xb8\xa6\x82\x68\x04\x20\xe8\xe7\xe7\xaf\x5f\xf9\x72\x93\x52\x89\xf2\x65\xdc\x34\xb4\xa8\x03\xa6\x8c\x47\x56\xa9\x18\x20\x01\x51\x6b\x70\x2c\x2f\x5d\xdf\x4a\xae\xaa\xc8\xd5\xd5\x6d\x3b\x5d\xe3\x75\x4b\x18\x9e\xd3\x5b\xc6\x2a\xcd\xab\x73\x40\xa3\x88\x53\x7e\x56\x55\x72\xb5\x00\xef\x18\x7e\x21\xa3\x96\x47\x1c\x78\xe0\xb5\x48\x59\x3a\x6f\x54\xe7\x4a\x4e\x16\xa3\xd5\x69\x56\xe6\xe6\xe9\x54\xcf\xad\xa6\x99\xce\x24\x48\xd5\x4b\x17\x9d\x28\x39\xd5\x78\xc9\x0b\xe1\xc3\xa2\x95\x0d\x99\x57\x93\xe7\xd6\x04\xaf\x56\xcb\xd5\xce\x7d\xf2\xcd\x87\x8e\x09\xe1\x55\xb7\xf8\x20\x4e\xc1\x2e\x3b\xc6\x35\x6d\xa5\x0e\x84\x7c\x6b\xe4\x9e\x40\xd0\xb2\xba\x35\xd7\xa6\xde\x3b\x3e\x3b\x7b\x7d\x36\xaa\x4e\x02\x23\x0a\xbe\x1d\x62\x51\xbc\x92\x94\x7b\x9b\xbf\xaa\x42\xe2\x7b\x33\x84\xf7\xc2\xa0\x9c\xfc\x42\x09\x98\xbf\x7e\xf3\xf1\xbf\x81\xc0\x7d\x4c\xb0\xaa\xf5\x3a\x46\xb3\x47\xac\xd6\xdd\x5b\x98\xb8\x08\xa6\xa4\xcf\x5c\xd8\x07\xf2\xdf\x2c\x30\xf5\x4e\xa7\xc2\x8b\x11\xf9\x63\x04\x50\x31\x8b\x0e\xcd\x51\x6e\x3b\x13\x85\x7a\x63\xaa\x96\x26\x6c\x6a\x94\x2a\xc4\xb8\x60\x98\x49\xe1\x46\xa2\xb7\x56\x00\x76\x01\x7d\xef\x35\x87\x59\xe0\x3a\x8a\xb4\x4c\xc2\xbe\xef\xdb\x10\x5a\x69\xd1\x00\xe8\x5c\x9c\x2a\x39\xe6\xf3\xdb\x5a\x69\x60\x5b\x53\x17\x6f\xb3\x8e\x78\x41\xf1\x4d\x86\xe0\x12\xdc\xcf\xa8\x19\xaa\x26\xa1\x29\xc8\xda\xde\xe2\x54\x4f\xf0\x2d\x95\x2b\x1a\xcb\x6e\x48\x94\xbf\xfa\xb4\xb8\x49\x02\x02\x7c\xfb\x07\x77\x48\xba\x90\x84\x32\xf8\x82\x81\x9e\x5e\x53\x2e\x48\xc4\x00\x60\x5d\x0e\x94\x59\xca\x2c\x08\x6c\xe2\xd0\xe3\x3f\x28\xa5\xf9\xe9\x65\x97\x8b\x07\x31\xa3\x79\x35\x81\xfa\xdc\x3a\x2c\x1d\xb0\x3c\xd3\x3b\xdf\xb8\xf8\xb9\xdc\xfe\x22\x19\xa4\x6f\x27\x8b\xb9\x87\x3b\xcd\x59\x84\x51\x7f\xc0\x61\xea\x18\x07\x1a\x13\x73\x0f\x6d\x6b\x6f\xca\xeb\xd6\x9e\x88\x1b\x25\x8d\x42\xb6\x02\xe7\xab\x02\xed\xdf\x91\xc1\x57\xa8\x16\x82\x79\x9e\xa3\x6d\xe3\xee\x8b\x5d\xa9\x55\xcb\x85\xef\x91\xaf\xef\xb4\x86\xf7\x90\x91\xdf\xab\x54\x21\xad\x92\x61\xb5\x0a\xef\xfd\xb2\xf6\x9d\xa0\x30\x72\x73\xd5\x7a\xca\xe1\x56\x49\xcd\x77\x02\x42\x49\x7d\x7f\x10\x56\x4b\xdd\xdf\x0b\x88\xbb\x26\xe8\xfb\xc7\xa1\x23\x79\x22\xdb\xaa\x09\x54\x05\xf4\x5a\x0a\x95\xcc\x4b\xd3\xa8\x7c\x23\x75\xe8\xcc\x27\xea\xa8\x9c\xa8\x73\x39\xa3\xff\xac\xb6\x79\x3a\x0f\x22\x16\x7b\x41\xb5\x03\x88\xf2\x26\xd6\x1f\xb2\x2f\xc4\x62\x84\x41\xda\x06\x86\x98\x83\x47\x6e\x12\x77\xa2\x59\x70\x83\x23\xd3\x31\xac\x5d\x75\xee\x50\x27\xb3\xe7\xa2\x15\xf2\xd0\x57\xe9\xb8\x99\x83\x36\xc9\x5b\x32\x63\x62\x9a\x86\xe0\x39\x47\xc7\x78\xe0\xdc\x23\xb7\x16\x6c\x2b\xe1\x98\x2b\x9c\x81\xd8\xe4\x2a\x73\x19\x30\x95\xff\xac\xc6\xb5\xe8\xa5\xf5\xea\x65\x51\xa5\xb5\x72\x67\x2d\x60\xd1\x63\x3a\x5d\xda\xb0\x80\xa5\xd6\xd6\x31\xa8\xe4\x59\x36\x66\x6d\xc7\x7d\xa3\x79\x34\x24\x4f\x87\x73\x7d\x28\x6c\xd8\x58\xf3\xd0\xa9\xc3\x50\x6c\x26\x72\xd7\x54\x6c\x2a\xa8\x5f\xfe\x51\xfb\x26\x26\x1e\xfb\x30\x6c\xfd\xed\x20\xf9\xce\xa3\xdc\x4a\xc8\xb1\x48\xb4\xb4\xf9\x75\xce\x97\x29\x0d\x65\x7b\x04\x4a\xe8\x9d\xad\xca\xdc\x3a\x19\xcf\xb1\x7e\x43\xce\x90\x05\x69\xc8\xca\x3d\x62\xcb\x90\x7b\x83\xf2\xdc\x79\x6f\xa0\xde\x45\xdd\x1b\xa8\x7f\xc9\xfd\x1f\x4a\xaa\x3f\x95\xda\x3d\x00\x00")
 
 func terminalHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -303,12 +303,12 @@ func terminalHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "terminal.html", size: 13161, mode: os.FileMode(438), modTime: time.Unix(1765379491, 0)}
+	info := bindataFileInfo{name: "terminal.html", size: 15834, mode: os.FileMode(438), modTime: time.Unix(1792192271, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _uploadHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x3b\x6d\x8f\x1b\xc7\x79\xdf\x0d\xf8\x3f\x3c\xa5\xad\x90\x67\x93\xcb\xe5\xdd\xe9\x4e\xa1\x8f\x04\x7c\x92\x1c\xbb\xb5\x6c\x57\x3a\xb7\x0d\x0c\x03\x1e\xee\x3e\xe4\x8e\x6e\x39\xb3\x99\x19\x1e\xef\xec\x1c\xe0\x16\x4d\xa4\x44\x4a\xaa\x36\x8d\x0a\x17\xaa\xdc\xc6\x32\x94\x06\x0d\xe4\xa2\xad\x9d\x42\x32\xf2\x67\x44\xea\xf4\x29\xfd\x09\xc5\xcc\xee\x92\xcb\x7d\x23\x4f\xd5\x87\xae\x00\x1e\x77\xe7\x99\xe7\xfd\x75\x96\xda\xf9\xa3\x0b\xef\x9e\xdf\xfb\xfe\x7b\x17\xc1\x53\x43\xbf\xfb\xe2\x0b\x3b\xfa\x2f\xf8\x84\x0d\x3a\x95\x8f\xbd\xc6\xf9\x77\x2a\xe6\x21\x12\xb7\xfb\xe2\x0b\x00\x00\x3b\x43\x54\x04\x1c\x8f\x08\x89\xaa\x53\x79\x7f\xef\x8d\xc6\xb9\xca\xc2\x1a\x23\x43\xec\x54\x0e\x28\x8e\x03\x2e\x54\x05\x1c\xce\x14\x32\xd5\xa9\x8c\xa9\xab\xbc\x8e\x8b\x07\xd4\xc1\x86\xb9\xa9\x03\x65\x54\x51\xe2\x37\xa4\x43\x7c\xec\xb4\x2c\x7b\x86\x4b\x51\xe5\x63\xf7\xf2\x85\x5d\x78\x83\xfa\x08\xaf\x33\xe2\x1f\x7d\x8c\x62\xa7\x19\x2e\x44\x50\x3e\x65\xfb\x20\xd0\xef\x54\xa4\x3a\xf2\x51\x7a\x88\xaa\x02\x9e\xc0\x7e\xa7\xd2\x94\x8a\x28\xea\x34\x7b\x9c\x2b\xa9\x04\x09\x9a\x2e\x95\xaa\xe9\x48\x39\x7f\x64\x0d\x29\xb3\x1c\x29\x67\x64\x0d\x9a\xe8\x46\x5f\x3d\xee\x1e\xc1\x27\xf3\x7b\xf3\x8c\x38\xfb\x03\xc1\x47\xcc\x6d\x83\x4f\x19\x12\xd1\x18\x08\xe2\x52\x64\xaa\xd6\xda\x38\xeb\xe2\xa0\x0e\x2f\x6d\x6d\x6d\x23\x12\xb0\xcf\xd4\xe1\xa5\xed\xad\xcd\x1e\x59\x87\x96\x6d\x9f\x59\x7b\x6d\x11\xd7\x90\xb2\x86\x87\x74\xe0\xa9\xb6\x5e\x3f\xf0\x52\xeb\x2e\x95\x81\x4f\x8e\xda\xd0\xf7\xf1\x30\xb5\x46\x7c\x3a\x60\x0d\xaa\x70\x28\xdb\xe0\x20\x53\x28\x52\x10\x57\x47\x52\xd1\xfe\x51\x23\xb2\x41\x01\x54\x9f\x33\xd5\xe8\x93\x21\xf5\x8f\xda\x50\xbd\x82\x03\x8e\xf0\xfe\x5b\xd5\x3a\xec\x11\x8f\x0f\x49\x1d\xbe\x87\x0c\x0f\x48\x1d\xfe\x0c\x85\x4b\x18\xa9\x83\x24\x4c\x36\x24\x0a\xda\x4f\xa0\x3a\x9e\x7f\xb5\x46\x81\xcf\x89\x6b\xe8\x12\xca\x50\x94\xa9\x70\xec\x51\x85\x29\x96\x7a\x5c\xb8\x28\x1a\x5a\xab\x23\xd9\x86\x75\x3b\x48\x0b\xdf\xe3\x87\x0d\xe9\x11\x97\x8f\xdb\x60\x1b\x00\xd8\xd2\x1f\x62\xd0\x23\x35\xbb\x6e\xfe\x59\x1b\x69\x75\x07\xc4\x75\x29\x1b\xb4\x61\x33\x8b\x71\x48\x0e\x43\xb7\x6c\xc3\x96\x9d\x5d\x8e\x96\xb4\x11\xcb\x85\x26\x02\x49\x46\x5e\x23\x4f\x1b\x36\x82\x43\x70\x89\xf4\xd0\x8d\x3d\xa4\x5c\xf0\xd6\xd9\x0c\x1f\x33\x11\x8c\xb8\x39\x9a\x51\x78\xa8\x1a\xc6\x37\x0a\xec\xad\x04\x61\x92\x2a\xca\x59\x1b\x88\xef\x83\x6d\x6d\x48\x40\x22\xd3\x46\x70\x46\x42\x72\xd1\x86\x80\xd3\x1c\x2c\x49\x13\xbe\xd4\x3f\xd7\xff\x6e\x7f\x89\x33\x68\xbd\xb4\x3d\x7e\x90\xe3\x0d\xa1\xd0\x0e\xf7\x35\xb9\x28\x5c\x4a\xc9\xd9\xfd\xf5\x05\x72\x33\xb1\xfa\x5c\x0c\xdb\xe1\x57\x9f\x28\xfc\x7e\xad\x71\x36\x38\x5c\x5b\xce\x98\xe5\x0a\x32\x78\x2e\xbc\xe1\x39\xec\x95\xf1\x66\x52\x5d\xad\x65\xd9\xeb\x4b\xd8\xa2\x0e\x67\x69\x6e\x4c\xa8\x4a\xfa\x31\xb6\x61\x6b\x33\x63\xf9\x98\xc9\x5c\xcf\x1a\x12\x31\xa0\xac\xd1\xe3\x4a\xf1\x61\x26\xa4\x72\xe8\x6b\x3f\x2a\xa1\x9f\xe3\x79\x31\xfd\x8d\x8d\x8d\x72\xe2\xad\xec\x5e\x83\x79\x1c\x65\xc2\x2d\xdb\x2e\xe7\xcd\xa3\x2c\xc3\xdb\x5c\xfa\xad\x3c\xdc\x21\xd7\xad\xcd\x42\xb1\xfb\xd4\xc7\x06\x65\xc1\x28\x83\x79\x96\x84\x19\x67\x58\xb6\xdb\xa7\x32\xb3\x39\x12\x5d\xf1\xa0\x0d\x1b\xc5\x4a\x0f\xa9\x2b\x1c\x96\xa5\xca\x30\xce\xd2\x96\x9d\x65\x84\x9c\x6c\x91\xce\x27\x79\x69\x6f\x99\x69\xca\x6a\x50\xa6\xc2\xc8\x80\x38\xd8\xe8\xa1\x1a\x23\xb2\x53\x15\xac\x1c\x5b\xf4\x79\xc6\x01\x7d\x3c\x6c\x43\xab\x6c\x9b\x6e\x40\x72\xfd\x36\xdf\xbb\xe0\x34\x8e\x7b\xb6\xdc\x7e\xda\xc7\x9e\xb7\x5b\x06\x82\x0f\x04\x4a\x99\xc6\x1b\x77\x0d\xe7\x8a\x2c\x6a\x3c\x2e\xc7\x9c\xe5\x2e\x91\x24\xdd\x53\xac\x41\xc2\xc6\x2b\x4d\x3d\xbf\x1a\xc2\x12\x6f\x4c\x4a\x9c\xe5\x7b\x89\x91\x56\x76\x65\x23\x78\x5e\xcb\xf0\x1c\xfb\xb6\xb8\xa0\xa7\x32\x02\xcc\x0d\x9e\xd7\xd6\xac\x52\x79\x0b\x0c\x90\x5f\x39\x0b\x6a\xde\xfa\x62\xcd\x83\x4c\xbf\xd4\x8a\x7b\x87\xb0\x5f\x6a\xd9\xeb\x75\x68\xad\x6f\xd5\x61\x7d\x63\xb3\x0e\xb6\xb5\x59\x54\x9b\x92\x1c\xb9\x54\x92\x9e\x8f\x6e\x9a\x29\x1e\x10\x87\xaa\xa3\x36\xd8\x56\xda\xe5\xe3\x96\x82\x71\xdd\xa2\xf8\x7c\x8c\x6e\x71\xa5\x2c\xc9\xb6\x86\x05\x49\x65\x43\xf7\xf8\xa3\x4c\x6c\x2c\x71\x84\xd2\x84\xb9\x50\xcb\x37\xfa\xeb\xfd\x34\x87\xcb\xfd\x70\x95\x7a\x11\x32\xde\x18\xa2\x94\x64\x50\x98\x34\x5a\xdf\xdd\xde\x72\xd3\xed\xc6\x6a\xa5\xd2\x6b\x65\x9c\x65\x59\x63\xb8\x72\x1a\xdc\x28\xa8\xdf\x61\x68\x6f\xac\x97\x87\xf6\x76\x61\x75\xf7\xf9\x20\x93\xf1\x97\x72\xbd\x7a\x63\xa3\xd1\x2f\x6b\x6b\x36\xb3\x79\xe9\x79\xe6\x8d\xc6\x18\x7b\xfb\x54\x35\xe6\x38\x1b\x8e\x4f\x83\xb6\x11\xb3\x00\xd6\x68\xa0\x4f\x7d\x3f\xee\x42\x4d\x8c\x04\x44\x20\x4b\x6f\x59\xd0\xf3\xb9\x3c\x3d\xef\x34\xe3\x11\x77\xa7\x19\xcd\xf5\x3b\x7a\xc8\x8d\x07\x60\x97\x1e\x80\xe3\x13\x29\x3b\x95\xf4\x10\x57\x49\xcc\xc5\x49\x38\xad\xd6\xe4\x5a\xde\xba\x11\xa2\xd2\xbd\x7c\xe1\xf2\x4e\xd3\xa5\x07\x49\x4c\xe9\x7b\xaf\xd5\xbd\x8c\x2e\x95\xa0\x87\xff\xf9\xdc\xef\xb5\xba\x2f\xbe\x90\xcf\x40\xa2\x8f\xaf\x00\x75\xe3\x07\xaf\xeb\xfb\x12\xbe\x12\x7d\x76\xa5\xfb\x3f\x9f\xff\xe2\x2f\xd3\xac\x14\x6c\x08\x45\x99\xde\xb8\x3d\xbd\xf1\xed\xe5\x0b\xbb\xd3\xdb\xd7\x1e\x3f\xfc\x7a\x72\xfd\xab\x93\xdf\x7f\xf6\xf4\xda\xcd\x15\x91\xe8\x0e\xb6\xd2\x9d\x5e\xbf\xfd\xe4\xaf\xfe\x7b\x72\xed\xe1\xd3\x4f\x7f\x32\xbd\xf1\xaf\x21\xaa\xc7\xbf\xfb\xe9\xe3\x47\xff\x9c\x8b\x27\x6c\x4f\xd5\x51\x80\x9d\x8a\xee\x38\x42\x71\xf5\xb7\xb7\xf4\x4a\x25\x26\x32\xef\x65\x2b\x40\x1c\x07\x03\xd5\xa9\x58\xc2\xed\x55\x60\x38\xf2\x15\x0d\x16\xce\x38\x62\x4a\xf9\xca\x9d\x35\xb6\x73\x5a\x6f\xeb\xbb\x6e\xce\xb6\xde\x48\x29\xce\xe2\x9d\x3d\xc5\x20\x51\x2f\xc2\xfd\xd1\xcd\xae\x62\x15\x88\x0b\x48\x77\xf2\xe8\xd3\xc9\xfd\x1b\x93\xeb\x3f\x9e\xde\xbd\xb5\xd3\x0c\xb1\x14\x32\x94\xca\xfd\x09\xb4\x92\xca\x2b\xe1\xb3\x12\xfd\x2f\x26\xde\x70\x77\xf8\xec\x52\xf4\xa8\x3b\xb9\xf6\xe3\xc9\xbd\x6b\x8f\x7f\xf7\x5b\xcb\xb2\x96\x99\x33\x6e\xcf\xd2\x24\x8b\xe0\x1a\x3d\x22\x20\x79\xd3\x90\x4a\xd0\x00\xdd\xc5\x87\x84\xd1\x21\x51\xe8\x56\xb2\x58\xcd\x25\xb8\x8f\x73\xa4\x3d\x22\x8a\x00\x4d\xb4\x47\x87\x6f\x6d\xb0\xcf\x14\xc1\x69\x35\xc4\xe8\x76\x89\x98\x9b\x77\x41\xa0\x92\xd8\x5d\x74\x87\x1d\xe9\x08\x1a\x28\x90\xc2\x99\x9f\xc4\x5d\x95\xcd\xab\x3f\x18\xa1\x38\x32\xc7\x6e\x57\xa5\x26\x12\xc2\x75\x4b\x76\xa5\xce\xef\xae\xa6\x8f\xef\x8a\xf1\x24\x38\x75\x38\x93\x0a\xe6\x79\x01\x3a\xe0\x72\x67\x34\x44\xa6\xac\x01\xaa\x8b\x3e\xea\xaf\xbb\x47\x6f\xb9\xb5\xea\x1c\xaa\x9a\x4c\xdf\x21\x8a\x59\xac\x95\x61\x98\x01\x15\x20\xd0\x01\xb4\x6c\xbf\x86\xc9\xd9\x3e\x8f\x9f\x32\x04\x73\xa8\x22\x14\xb3\x58\x59\x8a\x66\x06\x99\x83\x6a\x21\x70\xca\x30\x2d\x00\xe6\x20\x4a\xb8\x5e\x19\x9a\x04\x98\x41\x32\x47\xe3\xa3\x02\x89\x3e\x3a\x0a\xdd\x37\xa8\x8f\x5a\xae\x0f\x3e\x5c\x00\x69\x36\xe1\xbc\x4f\x9d\x7d\x50\x3c\x02\x35\xc6\x98\x03\xcc\x0d\x6f\x11\xd7\xbd\x78\x80\x4c\x69\x2b\x20\x43\x51\xab\x3a\x7a\x6b\xb5\x0e\xb5\x35\xe8\x74\x33\x0d\x44\x6c\x70\xcb\x80\xd5\x16\xda\xe7\xb5\x34\x17\xe6\x44\x3b\x4c\xe5\x8e\x47\xd8\x20\xc1\xc2\x1c\x51\x0e\x07\x06\x56\xb3\x80\x79\x3c\x78\x84\xb9\x3e\x1a\xd9\x6b\x68\x29\x22\x06\xa8\xcc\x58\x2a\xcb\xb9\xb9\x20\xc8\x00\x08\x73\xc1\x15\x3c\x88\xb0\x08\xb9\xa2\x56\xe2\x63\xb3\x42\xae\xd0\x0a\x04\xea\x3d\x17\xb0\x4f\x46\xbe\xaa\xa5\x3b\xa2\x04\x7a\x93\x2a\x35\x6e\x4d\x28\x81\xbb\x84\xff\xa5\xcc\xf9\x48\x0e\xb0\xc8\x6c\xb9\xb4\x05\x0e\xf9\x01\x3e\x17\xf2\x3c\x78\xce\x7a\x29\xe5\x2d\xeb\x04\x2e\x51\x64\xcf\x0c\x54\x28\x96\xba\x42\x7f\xc4\x1c\x3d\x9a\x2e\xe0\x08\x37\xa5\x99\x4f\x07\xda\xeb\x42\x90\x23\xab\x2f\xf8\x30\xda\xa0\x89\x29\x14\xe6\x0e\x3a\x39\xa5\x51\x2f\x58\x8c\x0c\xd1\x42\xe6\xca\x3f\xa7\xca\xab\x55\x75\x83\x52\x5d\x5b\x84\x5d\x64\x51\x5f\xb4\x0f\xb5\x05\xfa\x96\x8f\x6c\xa0\x3c\xe8\x74\x3a\x60\x67\x58\x05\x73\xee\x84\x42\xd5\xaa\x27\x0f\xbe\x89\xda\xac\x3b\x3f\x99\xfe\xf2\xfa\x93\x7f\xfc\x6b\x4d\x31\xec\xb9\x32\x8a\xd4\x97\x40\x35\x12\xe9\x43\xac\xe3\x34\x43\xd1\xb4\x17\xea\x2b\x8d\x66\x9e\x88\xad\xd9\xb8\xdc\x81\x3e\xf1\xd3\x33\x7f\x8e\x1d\x16\x11\xe7\xa5\x1c\xe3\x13\x94\x31\x14\x6f\xee\x5d\x7a\x1b\x3a\x50\xad\xbe\x56\x62\x29\xab\xcf\xc5\x45\xe2\x78\x35\x63\x97\x3a\x50\xe6\xe2\x61\x9e\x73\xc2\x62\xb9\x53\x38\x4c\x26\x65\x47\x20\x51\x18\xe5\xe5\x5a\xd5\xa5\x07\xb9\xda\x8b\xb7\x86\xee\xfb\x0e\x19\xea\x02\x51\x9d\x9d\x73\xa6\x39\x5d\xd8\x92\x94\xe9\xa3\xfc\x8e\x25\xd3\xa3\x52\xd6\xcf\x8c\x21\xa5\x1b\xb4\xfb\x55\xba\x2f\x7f\x32\xf3\xc5\xe3\xbc\x9e\xa7\x14\x85\x9e\x19\x0d\x0a\x2e\x86\x44\xed\x1e\xa9\x28\x64\x2c\xbd\xb0\x56\x8a\xaf\x74\x2d\xbf\x91\x96\x43\xf3\xc7\xd5\x65\x40\x54\x80\x33\x53\x6c\x3a\x95\x30\x2b\x68\x13\xd7\x5e\xfe\xc4\x18\xf5\x78\xad\xd2\x7d\x72\xff\xe1\xd3\xcf\xee\x25\xda\xe9\x34\x95\x8f\x0a\x2c\x10\x66\xe0\x20\x40\xe6\x9e\xf7\xa8\xef\xd6\x62\xb3\xa4\xad\x7c\xbc\xb6\xd4\x87\x13\xac\x45\xde\x56\x96\x4a\x2c\x19\xf8\xd4\x89\x40\xeb\xd0\x4a\x13\x2c\x0d\xb5\x67\x49\x0c\xb9\xd1\xa9\xc4\x28\x7d\x70\x77\xbc\x4c\xce\xa4\x03\xf4\xf4\x67\x86\x9e\x66\xcf\xac\xc4\xfc\x84\xd9\x05\xaa\x36\x98\x6d\xe9\x78\x08\xe3\x6f\x1f\x3a\xd0\xb2\xd7\x37\x73\x17\xb5\x93\x99\x3e\xa7\x1a\x62\xa8\x43\xf5\x4f\x76\xf5\xe7\x25\xf3\xf9\xbd\xdd\xea\x87\xb9\xfb\x28\x74\xe0\x12\x51\x9e\xd5\xf7\x39\x17\x35\xf3\xd5\xe7\x83\x98\xf1\x26\xcc\x9e\xec\xaf\xa5\xb5\x1c\x71\x6d\x20\xcc\xf9\x45\x24\x54\xb4\x29\xe0\xe3\xda\x7e\x1d\xe8\x1a\xbc\x02\x2d\xdb\xd6\xc8\x5a\xb6\x0d\xaf\x42\x15\xaa\xf0\x6a\xc8\xf1\x07\xf4\xc3\x22\xbf\x49\x18\xe4\x94\x1d\xd8\x32\xeb\xcf\x72\xf9\x2a\xf9\x39\xc7\x03\x16\x5b\x61\xcb\x8c\x55\x56\xe4\x8f\x3a\xaf\xf5\x7c\xee\xec\x67\xb2\x6f\xb2\xeb\xb5\x14\x1e\xaa\xf3\xe1\x7b\x12\xbd\x63\xfa\xdb\x2f\x26\x77\x7e\x1d\x0e\xfb\x61\x11\xb2\x2c\x2b\x8d\x21\xd1\xf0\x46\x34\xcd\x24\xa7\xf7\x9f\x3d\x53\xcd\x88\x13\x25\x6d\x2e\x86\x17\x88\xd2\x43\x0e\xc3\x31\xbc\x11\xdd\x66\x22\x26\xbf\x38\x44\x35\x3b\x2f\x5e\x62\xc4\x51\x76\x08\x07\x15\xed\x77\xfa\x6f\x6e\x76\x58\x7c\xd4\x6c\xc2\xfb\x12\xe1\x2f\x2e\xbd\xfd\xa6\x52\xc1\x65\xfc\xc1\x08\x43\x76\xa1\x87\x4a\xa1\x30\x3f\x16\x11\xdc\x37\xed\xe8\xec\xc5\x87\x12\xc4\xd9\xa7\x6c\x90\x27\xea\xa1\x27\x22\x29\x17\x91\xd6\xf2\x89\x9b\x9e\x6a\x86\x79\x71\xfd\xd0\x13\xd1\xeb\xc5\x1c\xdf\x8b\xb7\x14\xf6\x73\xb1\x0f\x62\xe4\x77\xe7\xf9\x30\x18\x29\xed\x50\xb9\x99\x67\x2e\x41\x80\xc2\x41\xa6\x34\xbc\x8f\x4a\xd7\x48\x8d\x83\x13\x17\x5d\x68\x02\x5a\x8a\x2b\xe2\x47\xe1\x94\x93\xb0\x21\x35\xdc\xbe\x17\xa2\x8b\x23\x7c\x48\x59\x2d\x4d\xe1\x15\xb0\xad\xed\x3a\x6c\xdb\x6b\xaf\x69\xa5\xd8\x8d\x6d\xfb\x8c\x31\x42\x88\x21\x9f\x46\xb1\x23\x2e\xd2\x7d\x15\xaa\x67\xf2\x4a\x3b\x2c\x09\x87\x8f\x72\xc3\x01\x5e\xfe\x24\x91\x69\x52\x82\xac\x1d\x9f\xc9\x2b\x61\xc7\x2b\xb9\x61\xe8\x09\x4e\x84\x2a\xeb\x09\x59\x17\xd0\x1b\x8a\xb2\x4f\x6c\x7d\xbd\x33\x7a\x25\xa1\xf3\xce\xba\x9d\x5f\x77\xc0\xbc\xe8\xc8\xfc\xb0\x28\x6b\x53\x81\x72\xe4\x6b\xf5\xfc\xf1\x95\x77\xdf\xb1\x02\x22\x24\x1a\x1a\x02\x65\xc0\x99\xc4\x3d\x3c\x54\x59\xf9\xd2\x5c\x85\x58\x2c\x39\x72\x1c\x94\xd9\xca\x94\xbe\xca\xb3\x56\xe2\x88\x72\x7a\xfd\xd6\xe4\xa7\x9f\xff\xe1\xd1\xcd\xd0\x76\x27\xf7\xbf\x98\xde\xbd\x95\x93\xc4\xd2\x57\x49\x52\xdb\xce\xcb\x6a\xe9\xab\xd9\x84\xcb\xe8\x52\xa1\x27\x78\xc5\x67\xa9\x19\x02\x32\x40\xa8\x8d\xa9\xef\x83\xf4\xf8\x18\xb4\xc5\x28\x1b\x80\x74\x04\x22\xd3\xaa\x60\x5c\xeb\x94\xb8\x47\x6b\x4b\x54\x80\x6a\x8f\x0e\x91\x8f\x54\xad\xd0\xde\xa7\x53\x5b\xa8\xa1\x6f\xfe\xe3\xe4\xdb\x7f\x9b\x5c\xff\xea\xc9\xc3\x5f\x4c\xef\xde\x79\xfa\x2f\xff\xf5\xf4\x9f\x7e\xb5\x82\xc2\x96\x28\xad\x65\xdb\x2b\x68\xed\x59\xe5\x82\x45\x2f\xa2\x4c\x2a\xc2\x1c\x94\xf0\x9d\xef\x40\xfa\x59\x5c\x74\xbb\x05\x0d\x57\xd1\x95\xb2\x68\x8c\x2f\x69\x51\x32\x52\xbc\x21\xb0\x2f\x50\x7a\x9a\x1f\x1d\x0c\xda\xb8\xda\xa4\x71\x18\x2f\xb1\x6a\xf2\x1a\x53\xe6\xf2\xb1\xe5\x73\x87\xe8\x06\xce\xf2\x04\xf6\xb5\x2e\x9b\x31\xed\xa6\xee\x57\xd2\xf2\x7d\x60\xa7\x3b\xaa\xa2\xeb\x18\xd0\x97\x99\xf7\x7a\xcf\xc4\xd1\x2a\xde\x01\xd9\xc4\x97\x0b\x52\x87\xb3\xb6\x9d\x37\xad\xa5\xa0\x5a\xb6\x1d\x95\x87\x3f\x1d\x51\x67\x1f\x44\x64\x9d\xba\x39\x68\x53\x1e\xce\x82\xcb\x98\x28\x3c\x32\x80\x31\xa1\x2a\x53\xa8\x17\x30\xaf\xa4\x15\xe5\x09\x3e\x36\x45\xfd\xa2\x10\x5c\xc4\x9e\x17\xbf\x2b\xfd\xe1\x0f\xa1\x1a\x26\xa0\xc9\xbd\x7f\x3f\xf9\xcf\x2f\x73\xa7\xcf\x25\x4a\x39\x06\x87\x28\xc7\x83\x1a\x6a\x0a\xa5\xbe\x5a\x1a\xd7\x49\x3e\xda\xa6\xc5\x35\x08\x63\x5e\x4b\x18\x2b\x89\x67\xbb\xb0\x82\xc2\xca\x47\x0a\xe9\x4b\xd7\x13\xee\xa3\x65\xd8\xab\x55\xa3\xfa\x67\xee\xda\xd5\x7a\xf8\xa5\x48\x8f\x39\x3a\x2c\x37\xe4\x29\x54\xf6\xe6\xde\xde\x7b\x46\x6f\xf3\xd2\x59\xc0\xc5\x33\x29\xec\x74\xca\x3a\x4d\xeb\x60\x34\xb6\x4a\xdf\x60\x00\xcb\x1a\x87\x53\x68\xeb\xc9\xb7\x7f\xfb\xe4\xe1\x9d\xa7\x7f\xff\xd9\xc9\x83\x07\x7f\x78\x74\xf3\xe4\xc1\x37\xd3\x2f\x3e\x9d\x7e\xfe\x65\xf4\x0e\xf2\xde\xfd\xc9\x57\x7f\x33\xf9\xbb\x9b\x21\xd8\xc9\xef\xef\x4e\x7f\xfe\x65\x9e\x66\x4e\xad\xca\xd5\xd5\x58\xaa\x33\x15\xd6\x9e\x55\xb4\x16\x81\xfe\x1f\xf5\x76\xf2\xf5\x8f\xa6\xff\xf0\x75\x1b\x62\xfd\xfc\x66\x72\xef\xfe\xf4\xfa\xed\x50\x3f\x93\x7b\xbf\x99\xfe\xe8\x57\xa1\x1a\x9f\xfc\xfa\x67\x93\x5b\x3f\x7f\x7a\xed\x67\x27\x0f\x7e\xf9\xff\x42\x63\x57\x74\x9a\x0d\x75\xa0\x2b\xe2\x86\x0d\x43\xca\x46\x7a\xd2\xd6\x9d\xba\x4f\xc4\x00\xcd\xd4\x95\x33\xc4\xc4\xdb\x3a\x7a\xd7\x2b\xb0\x65\x87\xc3\x83\x9d\x4f\x86\xb9\x20\xc2\x99\x29\x8b\x89\x07\xc8\x6a\xd5\xf7\xde\xbd\xb2\x57\xad\x43\xb5\x49\x02\xda\x0c\xbb\xfd\x6a\xdd\xcc\xc8\xe9\x84\x61\x62\x58\x0f\x86\xf1\xa0\x98\x39\x6e\x86\xf0\x27\x08\xf1\xeb\xb9\x9d\x66\xf4\xdb\x83\x9d\x66\xf4\x3f\x10\xfe\x37\x00\x00\xff\xff\xd9\x82\xef\xc6\x93\x30\x00\x00")
+var _uploadHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x1a\x7f\x73\x1b\xc5\xf5\x7f\x3e\xc5\x56\xc4\x48\x06\xe9\x24\xd9\x8e\x1d\x8c\xa5\x19\x9c\x84\x1f\x2d\x81\x34\x31\x9d\x32\x34\x33\xac\xee\x56\xd2\xc5\xa7\x3b\xf5\x6e\x65\xd9\x8e\x3d\x43\x3b\x85\x04\x02\x34\x2d\x85\x0e\x9d\x34\xb4\x10\x26\xb4\x53\x26\x74\xda\x02\x9d\xc0\xf0\x65\x22\xd9\xfe\xab\xfd\x08\x7d\x6f\xf7\x4e\x3a\xed\xed\x9d\x64\xc8\x4c\xd7\x63\xe9\xee\xf6\xed\x7b\x6f\xdf\xef\xb7\xa7\xb5\x1f\x9c\x79\xe1\xf4\xc6\x4b\xe7\xcf\x92\x36\xef\x38\xf5\x87\xd6\xf0\x8b\x38\xd4\x6d\xd5\x72\xbb\xed\xd2\xe9\xe7\x73\xf8\x8c\x51\xab\xfe\x10\x81\xb1\xd6\x61\x9c\x12\xb3\x4d\xfd\x80\xf1\x5a\xee\xc5\x8d\xa7\x4a\xa7\x72\xf1\x29\x97\x76\x58\x2d\xb7\x65\xb3\x7e\xd7\xf3\x79\x8e\x98\x9e\xcb\x99\x0b\xa0\x7d\xdb\xe2\xed\x9a\xc5\xb6\x6c\x93\x95\xc4\x4d\x91\xd8\xae\xcd\x6d\xea\x94\x02\x93\x3a\xac\x56\x35\x2a\x11\x2a\x6e\x73\x87\xd5\x2f\x9c\x59\x27\x4f\xd9\x0e\x23\x4f\xba\xd4\xd9\xd9\x65\xfe\x5a\x59\x4e\x48\x20\xc7\x76\x37\x89\xcf\x9c\x5a\x2e\xe0\x3b\x0e\x0b\xda\x8c\x01\xc1\xb6\xcf\x9a\xb5\x5c\x39\xe0\x94\xdb\x66\xb9\xe1\x79\x3c\xe0\x3e\xed\x96\x2d\x3b\xe0\x65\x33\x08\xc6\x8f\x8c\x8e\xed\x1a\xf0\x24\x22\x2a\xb0\xc8\x6b\x1c\x0d\xcf\xda\x21\x57\x46\xb7\xe2\x11\x35\x37\x5b\xbe\xd7\x73\xad\x55\x02\xd4\x19\xf5\x4b\x2d\x9f\x5a\x36\x6c\xb0\x50\x5d\x3c\x69\xb1\x56\x91\x3c\xbc\xbc\xbc\xc2\x18\x25\x95\x39\xb8\x5e\x59\x5e\x6a\xd0\x05\x52\xad\x54\xe6\xe6\x9f\x98\x40\x05\xb4\x4b\x6d\x66\xb7\xda\x7c\x15\xa7\xb7\xda\x93\xd3\xc0\x6d\xd7\xa1\x3b\xab\xa4\xe9\xb0\xed\xc9\x29\xea\xd8\x2d\xb7\x64\x73\xd6\x09\x56\x89\x09\xa4\x99\x3f\x09\x70\xb9\x17\x70\xbb\xb9\x53\x0a\x45\xaf\x07\x6a\xc2\x64\xa9\x49\x3b\xb6\x03\x44\xf2\x17\x59\xcb\x63\xe4\xc5\x67\xf3\x45\xb2\x41\xdb\x5e\x87\x16\xc9\xd3\xcc\x65\x5b\xf0\xfd\x13\xe6\x5b\xd4\x85\x8b\x80\xba\x41\x29\x60\xbe\xdd\x1c\x63\xda\x1f\x5d\x19\xbd\xae\xe3\x51\x4b\x10\xa5\x20\x1a\x3f\x43\x74\xfd\x36\x70\x3f\xc9\x4e\xc3\xf3\x2d\xe6\x97\x50\x98\x3d\xd8\xd6\x42\xa5\xbb\xad\x02\x6c\x97\x82\x36\xb5\xbc\xfe\x2a\xa9\x88\x79\xb2\x8c\x1f\x7e\xab\x41\x0b\x95\xa2\xf8\x33\x16\x15\x21\x77\xa9\x65\xd9\x6e\x6b\x95\x2c\x25\xf0\x75\xe8\xb6\xb4\xc2\x55\xc0\x93\x98\x0d\x67\x50\x6f\x59\x9b\xa5\x3e\x28\xfa\x8a\x66\x23\xab\x64\x11\x78\xb3\x28\xd8\xa4\x15\x59\x44\xe6\x86\xab\x27\x55\x16\x46\xbc\x8b\x6d\x26\x05\xc2\xd9\x36\x2f\x09\x5b\xd0\x2b\x18\x2c\xdc\x0d\xc0\xbd\x3c\x98\xa7\x8e\x43\x40\x3a\x01\x61\x34\x50\x04\x6f\xf6\xfc\xc0\x03\x7e\xbb\x9e\x9d\xc4\x11\x57\xda\xc3\xcd\x53\xcd\xc7\x9b\x99\xba\x47\x71\xac\xb6\xbd\xad\xa4\xf2\xe5\x66\x4d\xcf\x41\x52\xa1\x57\x64\x91\xaa\x34\x17\xe2\xa4\x46\xfb\x69\x7a\x7e\x67\x55\x5e\x3a\x94\xb3\x97\x0a\x25\x90\xdb\xfc\x34\x9e\x0c\xcb\xa7\xad\x07\xc0\x16\x3b\xc5\x1a\x19\x6c\x89\x28\x56\x80\x28\xb6\x90\xc9\x91\x0d\x2e\xa2\x30\x22\x9c\x31\xb0\x77\x19\x68\x7b\x49\x55\x74\xc4\x9e\xce\x8a\x3a\xd4\x6f\x41\x20\x69\x78\x9c\x7b\x1d\xd5\x6d\x92\xa4\xd1\x66\xd2\x49\x27\x6d\x2c\x22\xbd\xb8\xb8\x98\x49\xb7\x9a\x58\x29\xd0\xf6\xc3\xf8\x06\x0e\x96\xc5\x55\x1b\x2c\x4f\xe1\x6a\xbc\xe7\x65\x0d\x5e\xc9\x6e\x75\x29\x65\xb3\x4d\x48\x19\x25\xdb\xed\xf6\x54\xac\xa3\xa0\xea\x7a\x2e\x4b\x5f\xea\x40\xa6\x50\x56\x86\xfb\xe5\x5e\x17\x3c\x3b\x4d\xc8\x92\x2e\xc4\xe5\x8c\xd0\x27\xbd\x88\xa6\xb8\x7a\x32\x0a\xa8\x61\x42\x13\xc7\xa6\x68\x22\x23\x93\x24\x12\x45\xd0\xa5\x90\x9c\x1b\x8c\xf7\x19\x73\x8f\x91\x75\x92\xc2\x6f\x7a\xaa\x9d\x01\x75\x60\x2f\x7d\x0d\x16\x0e\x3a\xdb\xd4\x1a\x11\x8e\x59\x8d\xf3\x64\x96\xba\xd0\x94\x1e\xa4\xed\x75\x7d\xaf\xe5\xb3\x20\x50\x70\x46\x99\xfe\x54\x8a\xfa\x84\x61\x25\x75\x97\xa9\xfd\x18\xd5\x06\x77\x4b\x54\xd6\x48\x0a\x61\x6d\x22\xc3\x91\x61\x73\xf1\x7d\x26\x18\xce\xd6\xc9\xac\xe6\x2a\xf6\xab\x49\xf2\x0f\xae\xbe\x8a\xf2\xf0\xa4\xab\xe3\x08\xf5\xab\x29\x42\x66\x48\x99\x7a\xa1\x6b\x93\x5e\x4a\xc2\x5a\x98\x48\x58\x92\xd5\x78\x69\x53\x8d\xd2\xbd\x2c\x6d\xaa\x95\x85\x22\xa9\x2e\x2c\x17\xc9\xc2\xe2\x52\x11\x58\x5a\xd2\x27\x97\x38\x37\xe0\xf2\xb4\xe1\x40\xe5\x31\xc9\x90\x07\xde\x6d\x73\x88\x04\x15\x43\xb1\xec\xa8\x08\x70\x3d\x2c\x29\x1c\xaf\xcf\xac\xd4\x2c\x97\x1a\x3b\x05\xf5\xc0\x86\x1a\x11\x4a\xef\x9e\xea\x01\xd9\x8a\xcf\x8a\x80\x13\x19\x78\x11\x0a\x03\xeb\x98\x36\x37\x3d\xec\x4b\x8e\x4b\x1d\x70\x5c\xda\x4a\x8b\x07\xd5\xc7\x57\x96\xad\x85\x59\xbd\x61\x8c\xbd\x5d\x55\x0d\x63\x4a\xe5\x36\x6b\x60\x5b\xd4\x67\x5d\xe9\xb9\x8b\x0b\x99\x9e\xbb\x92\x92\x92\x1d\xaf\xa5\x46\xee\x69\xec\xce\x5a\x83\x20\xea\x29\x15\xc8\x52\x22\xdc\x3c\xc0\x88\x00\x7b\x6f\x6c\xda\xbc\x34\x46\x59\x32\x1d\x1b\xcc\x11\x79\xd2\x83\x8a\xad\x43\x9e\x70\xa2\x1a\x51\xf8\x41\x17\x6a\x4a\x97\x67\x08\xf7\x54\x52\xb8\x6b\xe5\xb0\xbb\x5c\x2b\xcb\x66\x7a\x0d\xdb\xcb\xb0\xf1\xb4\xec\x2d\x62\x3a\x34\x08\x6a\x39\xb5\x8b\xca\x8d\xfb\xd1\x38\x18\xca\x32\x36\xa5\x9b\x16\xcc\xe7\xa0\x87\xbe\xb0\x06\xbd\xef\x56\x0c\x8f\x72\xdb\xae\xd6\x2f\x30\xf0\x11\x82\xed\xf6\xb8\xd3\x86\xc7\x0f\x69\x69\xc7\x0a\xeb\x1c\xb1\xad\xe8\xc1\x93\x78\x9f\xce\x52\xac\xf8\xcd\xd5\xff\xfb\xe1\xbb\xbf\x50\xd8\x48\x81\x97\x9b\x18\x5e\x7f\x7f\x78\xfd\x1b\xe0\x6f\xf8\xfe\xd5\xfb\xf7\xbe\x18\x5c\xfb\xfc\xf0\xdb\x0f\x8e\xae\xbe\x35\x1b\x0e\xac\x2f\x01\xc7\xb5\xf7\x0f\x7e\xf9\xef\xc1\xd5\x7b\x47\xaf\xbe\x31\xbc\xfe\x17\x89\xe9\xfe\x57\x6f\xde\xff\xfa\x4f\x3a\x34\xb2\x7e\xe4\x3b\x5d\x56\xcb\x61\xa5\x20\xb7\x8a\x57\xcf\xe2\x4c\x2e\xa2\x31\x2e\x36\x73\x84\x9a\x26\xeb\xf2\x5a\xce\xf0\xad\x46\xd1\xa0\x5e\xb3\x68\xb4\x76\x8b\xc6\x6e\xc0\xc5\x87\x55\x34\x9c\xdd\xa5\xa2\xd1\xd8\x5d\xc8\x91\x4e\xcf\xe1\x76\x37\x7e\xde\x10\x72\xa1\x95\xf9\xa8\x2e\x1d\xb3\xf1\x1c\xde\xd5\x13\x8b\x1a\x3d\x70\x43\x37\x5a\x07\x59\x81\xc4\x32\x83\x5c\x1d\xde\xac\x73\x37\x47\xa2\x54\x51\x1f\x7c\xfd\xea\xe0\xce\xf5\xc1\xb5\xd7\x87\xb7\x6e\xac\x95\x25\x96\x14\x66\x94\x40\x1f\x43\x0a\x0f\x2f\xca\x67\xe9\x4a\x99\x8c\xb5\x72\xb1\x7c\x76\x2e\x7c\x54\x1f\x5c\x7d\x7d\x70\xfb\xea\xfd\xaf\x3e\x33\x0c\x63\x8a\x8a\xa3\x7a\x4b\x21\x98\x06\x06\xce\xef\x93\xf8\x0d\xec\xc1\xb7\xbb\x90\x2a\x27\x1e\x52\xd7\xee\x40\xb6\xb6\x72\x09\xa4\x62\xf8\x9e\xc3\xc6\x38\x61\x41\x0a\x9c\x70\xf9\xf0\xe4\x0b\xb2\xee\x5c\x0a\x18\x4a\x20\x42\xb6\x0e\xc8\xea\xba\x3d\xa7\xfa\x70\xdc\x02\xd6\x02\x13\x76\xc3\x49\xe0\x9b\xe3\x23\xb0\xcb\x41\xf9\xf2\xcf\x7b\xcc\xdf\x11\xe7\x5d\x97\x03\xc4\x2f\xe1\xea\xe9\x8b\x94\x73\xb3\xcb\xea\xb1\x59\x2a\x9a\x31\x8f\xe0\xe9\xd0\x47\x8d\x83\x03\xa9\x11\xcb\x33\x7b\x1d\x88\x9d\x46\x8b\xf1\xb3\x0e\xc3\xcb\xf5\x9d\x67\xad\x42\x7e\x0c\x95\x8f\x45\x6d\x89\x61\xe4\x73\x59\x08\x46\x40\xfa\xf5\xe8\x2c\xd3\x96\x23\x4c\x72\xf5\xd8\x59\xb2\xd6\x8f\xa1\x52\x30\x8c\x3c\x63\x2a\x96\x11\x64\x12\xd3\x84\x9b\x64\x21\x9a\x00\x4c\xe2\x89\x19\x5b\x16\x96\x18\x18\xe2\x18\x21\x71\x18\xb0\xc2\x1c\x66\x82\x87\xe0\xf1\x2c\xee\xe9\xe5\x4b\x31\x80\x72\x99\x9c\x76\x6c\x73\x93\x70\x2f\x04\x14\x4a\x18\xcd\x8f\xb5\x6d\x40\xdd\x77\x76\x0b\x28\xa2\xf0\x19\xe4\xbb\x42\xde\xc4\x95\xf9\x22\x29\xcc\x93\x5a\x5d\x2d\x15\x22\x2d\x1b\x02\xaa\x10\x2f\x85\xe7\x27\x19\x10\xe7\xc6\x32\x88\x9b\x6d\xea\xb6\xc6\xd4\xc7\x48\x34\xc4\x05\x28\x52\x67\x1a\xf2\x30\x69\x39\x4c\x6c\xb9\xc0\x0c\x0e\x75\x0f\xe3\xa2\x8d\x0c\x32\x18\x39\xe3\xd3\x16\xd8\x80\x45\x2c\xdf\xeb\x86\x28\xfc\x60\x36\x59\x44\xe7\x56\x69\x0c\x31\xe8\x37\x19\x2e\x39\xc3\x9a\x14\x12\x4a\x41\x29\x79\x62\xc8\x45\x20\x44\xcc\x48\x26\x86\x39\x8d\xf3\xa9\x7c\x39\x8c\x6e\xb1\x14\x3d\x69\xe9\xfa\xac\x03\x14\xbf\x3f\x69\xaf\xfb\x40\xc5\x91\xc5\x56\x52\xe9\x16\xe5\x74\x43\x34\x43\xcc\xcf\x56\x7d\xb3\xe7\x9a\xd8\x4a\x4e\x20\x90\x2b\x14\xb6\x55\x5f\x7a\xd2\xf7\xe9\x8e\xd1\xf4\xbd\x4e\x08\x8f\x84\xa0\xec\x16\x77\xb0\xeb\x44\x16\x29\xff\xcc\x28\x40\xc9\xb1\x07\x15\xc7\x5e\x6b\x77\x0f\x4a\x0d\xfc\xb7\xf6\xa0\xda\xd8\x83\x62\x63\xfe\x44\xd9\x36\x38\x0b\xb8\x40\x60\xe0\x41\xcb\xfc\x04\x8e\x38\xdb\x38\xec\x26\x29\x4c\x30\x65\x38\xcc\x6d\xf1\x36\xa9\xd5\x6a\xa4\xa2\xb2\x8f\x83\x82\x4d\xf3\x42\xfe\xf0\xee\x97\x61\x75\x75\xf3\x8d\xe1\x7b\xd7\x0e\xfe\xf0\x2b\x2c\x85\x64\xa9\xa5\xca\x15\x87\xcf\x78\xcf\x57\x0e\x9a\xf6\x27\x79\x09\x9b\x37\x29\x3e\x05\xc5\x38\xea\x1a\xa3\x96\xb7\x46\x9a\xd4\x99\xec\xd8\x93\x2a\x99\x44\xaa\x09\x32\xc2\x32\x6c\x17\x2c\xee\x99\x8d\x73\xcf\x01\xd2\x7c\xfe\x89\x74\x9d\x19\xd0\x19\x9f\xa5\x66\xbb\x20\x04\x8c\xaf\xb5\x2c\xb6\xad\xb1\x4f\x1c\xb1\x9c\x86\x27\x85\xb1\xf0\x6b\x82\x5d\x72\x16\x46\x60\xb0\x47\x7b\x4b\x27\xb2\x68\xa5\xb4\xe0\xe7\xf1\xd0\x0c\xb8\x1b\x1d\x3d\xe6\x33\x56\xc4\xf7\xf3\x8a\xb6\x14\x49\x94\x9c\x78\x90\xa7\x29\xad\x52\xe1\xd1\xb6\x72\xf5\x13\x57\x46\x86\xb6\xaf\xa9\x65\x32\x31\x60\x23\x28\x30\x78\x3e\x94\x60\xeb\x3b\x3c\xf4\x1a\x03\x27\xe6\xb3\xd0\x65\x4d\xe9\xab\xe2\xa0\x23\xbe\x2c\x8c\xf9\x7e\x8e\x78\xae\xc8\x2a\xb5\x9c\x0c\x09\xa8\xda\xc2\x89\x2b\x42\x99\xfb\xf3\xb9\xfa\xc1\x9d\x7b\x47\x1f\xdc\x1e\xd7\xc6\x2a\x91\x57\xf4\xa2\x97\x31\xb7\xdb\x65\xae\x75\xba\x6d\x3b\x56\x21\xd2\x87\xa2\xdc\xfd\xf9\x6c\x9b\x8d\x31\x15\xda\x57\x46\x14\x31\xc0\xbe\x6d\x33\x84\x2c\x92\xaa\x42\x2b\xcb\xa9\xbe\x83\xf3\x6b\xdd\x90\xfb\x3d\xa6\x7a\x76\xd6\xfe\xe2\x0a\x6f\xe0\xa7\x4a\x0a\x19\x13\x13\x11\x27\x32\x7a\x90\x7c\x85\x88\x55\x8a\xe9\x4b\x47\xdb\x04\x4e\xaa\x95\x85\x25\xdd\x1c\x5a\x94\xa8\x5d\xf2\x72\x7d\x91\xe4\x7f\xb4\x8e\x9f\xe7\xc4\xe7\xd3\xeb\xf9\x4b\xba\x65\x36\x2c\x39\x47\x79\xdb\x68\x3a\x9e\xe7\x17\xc4\x25\x34\xdc\x11\xd3\x65\x32\x7a\xb2\x39\xaf\xc8\x36\xe4\x58\x00\x88\x13\x88\x70\x43\xe1\x9a\xae\xd7\x2f\x6c\x42\xf8\x98\x27\x8f\xe2\x01\x06\xe2\x82\x2f\xf2\x18\xc9\xc3\xdf\x63\x92\xdf\x97\xed\x4b\x5a\x3b\x89\x29\xe1\x78\x35\xd5\x34\x7d\x47\x31\x7a\x86\xc8\x9b\x54\xf9\x64\x45\x6b\x88\x86\xc8\x08\xad\x0f\xe3\x56\xc3\xf1\x80\x31\x25\xb4\xc6\x8b\x57\x03\x0f\x00\x4e\xcb\xf7\x13\xb8\x60\xf8\xd9\xc7\x83\x9b\x9f\xca\xb6\x5d\xe6\x15\xe8\x11\x15\x04\xb1\xba\x35\xa4\x28\x3a\x30\x5c\x7e\x72\x2e\xaf\xec\x24\x8c\xc7\x60\x7c\x67\x20\xb5\x03\x8c\xcb\xfa\xe4\xa9\xf0\x56\xf5\x0d\x7d\xd4\x0f\xd3\xb2\xc6\x33\x22\xb4\xa1\xff\xcb\x3e\x03\x0d\x0d\xbf\x35\xfe\x3f\xf1\x00\x6a\xc7\x17\x03\x46\x7e\x7a\xee\xb9\x67\x38\xef\x5e\x60\xd0\xc3\x49\x4e\x49\x83\x71\x28\x07\xc4\x4f\x2b\xa0\x15\x15\xc5\xe5\xe8\xbd\x03\x74\x68\xe6\xa6\xed\xb6\x34\x9b\xdc\x6e\xfb\xe1\xfe\x26\x71\x16\x74\x94\x45\xa9\x34\x42\x3b\x31\x0d\x78\xc2\x57\x77\x1a\x53\x8b\x56\xa4\xd5\x68\x38\xd0\xe4\x58\x68\x66\xa7\xbd\x0e\x94\xe2\x68\x41\xba\xd0\x32\x66\xbe\xcb\x7c\x3c\x7c\x44\x70\xe8\x3f\x30\xe9\x21\x0a\xe0\x01\x2c\xaf\x0c\x95\x1f\xf7\x38\x75\x42\xc7\x49\x06\xe2\x31\x1e\xc9\xf8\x79\x89\x2d\xf2\x64\xe8\x68\x0b\x2a\x81\x47\x49\xc5\x58\x29\x92\x95\xca\xfc\x13\x28\x90\x4a\x69\xa5\x32\x27\xa4\x2f\x31\x68\x49\xa4\x5b\xde\x24\x59\xf0\xe8\x39\x4d\xa6\xc6\x91\x65\xfd\xaf\x68\xad\x9f\x9c\xb8\x12\x0b\x28\xca\x36\xe6\xf7\xe7\x34\x79\x69\x7f\xba\xe5\x49\xfd\x9b\x21\x9a\x84\xfe\x93\x8a\x47\xf8\x94\x10\x83\x03\x75\x8e\x0b\xc3\x97\x03\x18\x5c\x16\x2a\xda\x74\x82\x83\xfb\xea\x4f\x6f\xe2\x43\x6a\x12\x04\x0d\x35\x3e\x88\xe5\x87\x17\x5f\x78\xde\xe8\xe2\x2f\x92\x04\x05\x78\xde\x05\x00\xb6\x01\xb2\x53\x77\xa6\x72\x24\x71\x18\x41\xcf\x34\x41\xe2\x69\xdc\x44\x23\x3b\x32\xc5\x0e\x14\x87\xd7\x6e\x0c\xde\xfc\xf0\x3f\x5f\xbf\x25\x15\x76\x78\xe7\xe3\xe1\xad\x1b\xc9\x40\xa5\x8e\x8c\xc0\xb5\x92\x8c\x5c\xea\x00\xad\xe1\x39\xae\x8f\xed\x36\x34\xde\x51\xe8\x25\x5d\x3c\x2c\x28\xf4\x6d\xc7\x21\x41\xdb\xeb\x13\x54\x14\x04\x08\x12\x40\xc1\xc9\x5c\x14\x83\xeb\xa1\x34\xa9\xb5\x33\x9f\xbd\x7d\xc6\x37\xec\x0e\xf3\x7a\xbc\x90\xa6\xe5\xe3\x49\x4c\x0a\xe7\xcb\x7f\x1c\x7e\xf3\xb7\xc1\xb5\xcf\x0f\xee\xbd\x3b\xbc\x75\xf3\xe8\xcf\xff\x3a\xfa\xe3\x47\xd3\x65\x35\x45\x5e\x78\xfe\x3f\x4d\x60\xdf\x71\x4f\x38\x62\xb6\x63\x83\x2d\x52\x17\xcc\x87\x3c\xf2\x08\x51\x9f\x45\xb9\xb4\xae\xaf\x9c\xd2\x86\xa2\xca\x08\x5d\x5c\x95\xb4\xc7\xbd\x92\xcf\x9a\x40\xb1\x8d\xec\xa0\xfd\xa3\x56\x51\x97\x91\xd7\x66\xab\x33\x3e\xfa\x50\x28\x7a\x7d\x88\xa7\x26\xc5\x62\xcc\xc0\x1f\xd1\xa1\x18\xcb\x11\xe9\x32\x16\x20\xea\xee\x5e\xae\x5c\x9a\xae\x24\x1c\xfb\x84\x41\x4f\x76\x0c\x01\xa4\xf2\x33\x83\x55\x08\x82\x53\xa1\xf6\x8b\xe4\x24\xc4\x9f\x6c\x74\x00\x04\x86\x14\x66\x80\x1f\xf7\xf0\x48\xcb\x0f\xf5\x52\x14\xc7\x60\xbc\xcd\x46\xfe\x24\x94\x23\x9b\x7d\xd2\xa7\x36\x57\x93\xf0\x04\xe2\x59\x04\xc2\xdb\x3e\xb8\x2b\xe6\xeb\xb3\xbe\x0f\x95\x66\x28\xfe\xe8\x2d\xe5\xde\x1e\xc9\xcb\x70\x33\xb8\xfd\xf7\xc3\x7f\x7e\xa2\xeb\x16\xb3\xe5\xb1\x4f\x40\xbc\x66\x1b\x32\x29\xe2\xcf\x32\xd0\x4c\x3f\x8e\x33\xb1\x2a\x2a\x55\x81\x2f\x62\x34\x9d\xab\x0c\xff\xad\xa4\xe5\x47\x1c\x33\xf5\xfd\xea\xc0\xa4\xe1\x01\x09\xc1\x5b\x21\x1f\x26\x38\x71\xb7\x0a\x79\x4b\x8a\x40\xbf\x3c\x29\xbd\x4c\xfd\x1d\x43\x58\xcf\x6c\x6c\x9c\x17\x12\x1b\xa7\x46\x3d\x0b\xdf\x45\x54\xc7\x12\xd3\xcc\x25\x81\x10\xd4\x0c\xf5\x80\x80\xcb\x28\x08\x8e\x21\xa5\x83\x6f\x7e\x73\x70\xef\xe6\xd1\xef\x3e\x38\xbc\x7b\x17\x92\xea\xe1\xdd\x2f\x87\x1f\xbf\x3a\xfc\xf0\x93\xf0\x6d\xe0\xed\x3b\x83\xcf\x7f\x3d\xf8\xed\x5b\x12\xec\xf0\xdb\x5b\xc3\x77\x3e\xd1\x88\xe4\xb8\x22\x9c\x59\x7c\x19\xc2\xe2\x32\xb7\xcc\x20\xae\x10\xf2\xfb\x09\xec\xf0\x8b\xd7\x86\xbf\xff\x62\x95\x44\x82\xf9\x2b\xc8\x06\xdf\x7b\x0a\xc1\xc0\xed\xf0\xb5\x8f\xa4\xfc\x0e\x3e\x7d\x7b\x70\xe3\x9d\xa3\xab\x6f\x1f\xde\x7d\xef\xff\x2d\xaa\x8b\x18\x47\xe5\xee\x31\xd9\x2d\x56\xf0\xb7\xd1\x3d\x6c\x8b\xb1\xda\x76\xf0\x94\x5d\xf4\x4b\xc9\x26\x24\x5a\x55\xc3\x45\x8f\x92\xe5\x8a\x2c\xff\x2b\x3a\x1a\xd0\x23\xf9\xb2\xdd\x49\xa0\xf1\xa0\x37\x2b\xe4\xcf\xbf\x70\x71\x03\xdb\xfe\x32\xed\xda\x65\x59\xae\xc3\x2d\xb6\xb4\x4a\x54\x10\xae\x8a\xdd\x5c\xd4\xdd\x29\x27\xc0\xf8\x3d\x7e\x35\xb6\x56\x96\x2f\xfa\xd7\xca\xf2\xf7\xf5\xff\x03\x78\x35\xab\x9a\x70\x2f\x00\x00")
 
 func uploadHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -323,7 +323,7 @@ func uploadHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "upload.html", size: 12435, mode: os.FileMode(438), modTime: time.Unix(1765378420, 0)}
+	info := bindataFileInfo{name: "upload.html", size: 12144, mode: os.FileMode(438), modTime: time.Unix(1792191005, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }